@@ -0,0 +1,65 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package main implements clevis-decrypt-klefki, the decryption half
+// of the klefki pin for clevis.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"git.rgst.io/homelab/klefki/internal/clevis"
+)
+
+func main() {
+	exitCode := 0
+	defer func() { os.Exit(exitCode) }()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if fi, err := os.Stdin.Stat(); len(os.Args) != 1 || (err == nil && fi.Mode()&os.ModeCharDevice != 0) {
+		fmt.Fprintln(os.Stderr, "Usage: clevis decrypt klefki < JWE > PLAINTEXT")
+		exitCode = 2
+		return
+	}
+
+	if err := run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		exitCode = 1
+	}
+}
+
+// run decrypts the JWE on stdin and writes the plaintext to stdout.
+func run(ctx context.Context) error {
+	jwe, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read JWE: %w", err)
+	}
+
+	plaintext, err := clevis.Decrypt(ctx, jwe)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(plaintext)
+	return err
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package main implements clevis-encrypt-klefki, the encryption half
+// of the klefki pin for clevis.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"git.rgst.io/homelab/klefki/internal/clevis"
+	"git.rgst.io/homelab/klefki/pkg/client"
+)
+
+// summary is printed for --summary, which clevis uses to list pins.
+const summary = "Encrypts using a klefki server policy"
+
+// usage is printed when called without input.
+const usage = `Usage: clevis encrypt klefki CONFIG < PLAINTEXT > JWE
+
+` + summary + `
+
+This command blocks until the key of the machine has been released by
+the klefki server, as it would be at boot.
+
+This command uses the following configuration properties:
+
+  url: <string>       The address of the klefki server (REQUIRED)
+
+  key: <string>       The path to the private key of the machine
+                      (default: ` + client.DefaultKeyPath + `)
+
+  identity: <string>  The base64 encoded public key of the klefki server
+                      (default: trusted on first use)
+`
+
+func main() {
+	exitCode := 0
+	defer func() { os.Exit(exitCode) }()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if len(os.Args) == 2 && os.Args[1] == "--summary" {
+		fmt.Println(summary)
+		return
+	}
+
+	if fi, err := os.Stdin.Stat(); len(os.Args) != 2 || (err == nil && fi.Mode()&os.ModeCharDevice != 0) {
+		fmt.Fprint(os.Stderr, usage)
+		exitCode = 2
+		return
+	}
+
+	if err := run(ctx, os.Args[1]); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		exitCode = 1
+	}
+}
+
+// run encrypts stdin with the provided configuration and writes the
+// JWE to stdout.
+func run(ctx context.Context, rawCfg string) error {
+	var cfg clevis.Config
+	if err := json.Unmarshal([]byte(rawCfg), &cfg); err != nil {
+		return fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	plaintext, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read plaintext: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Waiting for %s to release the key of this machine\n", cfg.URL)
+	jwe, err := clevis.Encrypt(ctx, &cfg, plaintext)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(jwe)
+	return err
+}
@@ -0,0 +1,237 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AskPasswordDir is the directory systemd places password requests in,
+// as described by https://systemd.io/PASSWORD_AGENTS/.
+const AskPasswordDir = "/run/systemd/ask-password"
+
+// cryptsetupPrefix is the prefix of the IDs of password requests made
+// by systemd-cryptsetup for LUKS devices.
+const cryptsetupPrefix = "cryptsetup:"
+
+// ask is a password request made through the ask-password protocol.
+type ask struct {
+	// ID identifies what the password is for (e.g.,
+	// cryptsetup:/dev/sda2).
+	ID string
+
+	// Socket is the path of the socket to send the password to.
+	Socket string
+
+	// NotAfter is when the request expires, zero if it doesn't.
+	NotAfter time.Time
+}
+
+// readAsk reads the password request in the provided ask.* file.
+func readAsk(path string) (*ask, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read password request: %w", err)
+	}
+
+	var a ask
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = line[1 : len(line)-1]
+			continue
+		}
+
+		k, v, ok := strings.Cut(line, "=")
+		if !ok || section != "Ask" {
+			continue
+		}
+
+		switch k {
+		case "Id":
+			a.ID = v
+		case "Socket":
+			a.Socket = v
+		case "NotAfter":
+			// NotAfter is in microseconds of CLOCK_MONOTONIC, which
+			// Go doesn't expose, so it's converted relative to now.
+			usec, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || usec == 0 {
+				continue
+			}
+			if left, err := untilMonotonic(time.Duration(usec) * time.Microsecond); err == nil {
+				a.NotAfter = time.Now().Add(left)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse password request: %w", err)
+	}
+
+	if a.Socket == "" {
+		return nil, fmt.Errorf("password request has no socket")
+	}
+	return &a, nil
+}
+
+// answer sends the provided password in response to the request.
+func (a *ask) answer(password []byte) error {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: a.Socket, Net: "unixgram"})
+	if err != nil {
+		return fmt.Errorf("failed to connect to password request socket: %w", err)
+	}
+	defer conn.Close()
+
+	msg := append([]byte("+"), password...)
+	defer clear(msg)
+
+	if _, err := conn.Write(msg); err != nil {
+		return fmt.Errorf("failed to send password: %w", err)
+	}
+	return nil
+}
+
+// agent answers password requests for LUKS devices with the key of the
+// machine, until ctx is done. Other agents, such as the console prompt,
+// keep running in parallel and whichever answers first wins. Each
+// device is only answered once, so that a wrong key doesn't use up the
+// tries of the console prompt.
+type agent struct {
+	cfg *config
+
+	mu       sync.Mutex
+	pending  map[string]context.CancelFunc
+	answered map[string]bool
+	wg       sync.WaitGroup
+}
+
+// runAgent runs the password agent with the provided config until ctx
+// is done.
+func runAgent(ctx context.Context, cfg *config) error {
+	if err := os.MkdirAll(AskPasswordDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", AskPasswordDir, err)
+	}
+
+	events, err := watch(ctx, AskPasswordDir)
+	if err != nil {
+		return err
+	}
+
+	a := &agent{cfg: cfg, pending: make(map[string]context.CancelFunc), answered: make(map[string]bool)}
+	defer a.wg.Wait()
+
+	// Requests made before the agent started are only found by listing
+	// the directory, after watching it so none are missed.
+	entries, err := os.ReadDir(AskPasswordDir)
+	if err != nil {
+		return fmt.Errorf("failed to list password requests: %w", err)
+	}
+	for _, e := range entries {
+		a.handle(ctx, e.Name(), true)
+	}
+
+	for ev := range events {
+		a.handle(ctx, ev.name, ev.exists)
+	}
+	return ctx.Err()
+}
+
+// handle handles the creation or removal of the provided file in the
+// ask-password directory.
+func (a *agent) handle(ctx context.Context, name string, exists bool) {
+	if !strings.HasPrefix(name, "ask.") {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if cancel, ok := a.pending[name]; ok {
+		// Answered by someone else, or replaced.
+		cancel()
+		delete(a.pending, name)
+	}
+	if !exists {
+		return
+	}
+
+	req, err := readAsk(filepath.Join(AskPasswordDir, name))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+		return
+	}
+	if !strings.HasPrefix(req.ID, cryptsetupPrefix) || a.answered[req.ID] {
+		return
+	}
+
+	deadline := req.NotAfter
+	if a.cfg.timeout > 0 {
+		if t := time.Now().Add(a.cfg.timeout); deadline.IsZero() || t.Before(deadline) {
+			deadline = t
+		}
+	}
+
+	askCtx, cancel := context.WithCancel(ctx)
+	if !deadline.IsZero() {
+		askCtx, cancel = context.WithDeadline(ctx, deadline)
+	}
+	a.pending[name] = cancel
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer cancel()
+
+		fmt.Fprintf(os.Stderr, "requesting key for %s\n", req.ID)
+		key, err := unlock(askCtx, a.cfg, a.cfg.secretFor(strings.TrimPrefix(req.ID, cryptsetupPrefix)))
+		if err != nil {
+			// Canceled when answered by someone else.
+			if !errors.Is(askCtx.Err(), context.Canceled) {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", req.ID, err)
+			}
+			return
+		}
+		defer clear(key)
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if askCtx.Err() != nil {
+			return // Answered by someone else in the meantime.
+		}
+		a.answered[req.ID] = true
+
+		if err := req.answer(key); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", req.ID, err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "answered password request for %s\n", req.ID)
+	}()
+}
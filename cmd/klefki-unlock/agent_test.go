@@ -0,0 +1,86 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadAsk(t *testing.T) {
+	tests := []struct {
+		name    string
+		ask     string
+		want    ask
+		wantErr bool
+	}{
+		{
+			name: "cryptsetup",
+			ask: "[Ask]\nPID=123\nSocket=/run/systemd/ask-password/sck.1\nAcceptCached=1\nNotAfter=0\n" +
+				"Message=Please enter passphrase for disk root\nId=cryptsetup:/dev/sda2\n",
+			want: ask{ID: "cryptsetup:/dev/sda2", Socket: "/run/systemd/ask-password/sck.1"},
+		},
+		{
+			name: "other section",
+			ask:  "[Other]\nSocket=/tmp/other\n[Ask]\nSocket=/run/systemd/ask-password/sck.1\n",
+			want: ask{Socket: "/run/systemd/ask-password/sck.1"},
+		},
+		{name: "no socket", ask: "[Ask]\nId=cryptsetup:/dev/sda2\n", wantErr: true},
+		{name: "socket outside section", ask: "Socket=/run/systemd/ask-password/sck.1\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ask.1")
+			if err := os.WriteFile(path, []byte(tt.ask), 0o600); err != nil {
+				t.Fatalf("failed to write request: %v", err)
+			}
+
+			got, err := readAsk(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readAsk() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("readAsk() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswer(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "sck")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+
+	if err := (&ask{Socket: socket}).answer([]byte("key")); err != nil {
+		t.Fatalf("answer() error = %v", err)
+	}
+
+	buf := make([]byte, 16)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatalf("failed to read answer: %v", err)
+	}
+	if got := string(buf[:n]); got != "+key" {
+		t.Errorf("answer = %q, want %q", got, "+key")
+	}
+}
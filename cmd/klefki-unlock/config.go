@@ -0,0 +1,160 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/unlockconfig"
+	"git.rgst.io/homelab/klefki/pkg/client"
+)
+
+// config is the configuration of klefki-unlock, with the values parsed
+// from it.
+type config struct {
+	unlockconfig.Config
+
+	keyring                                     client.Keyring
+	timeout, minBackoff, maxBackoff, keyTimeout time.Duration
+}
+
+// readConfig reads and validates the config at the provided path.
+func readConfig(path string) (*config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg config
+	if err := json.Unmarshal(b, &cfg.Config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	if len(cfg.Servers) == 0 {
+		return nil, fmt.Errorf("config must contain at least one server")
+	}
+	if cfg.Threshold != 0 && (cfg.Threshold < 2 || cfg.Threshold > len(cfg.Servers)) {
+		return nil, fmt.Errorf("threshold must be between 2 and the number of servers (%d), got %d", len(cfg.Servers), cfg.Threshold)
+	}
+	if cfg.Key == "" {
+		cfg.Key = client.DefaultKeyPath
+	}
+
+	for _, d := range []struct {
+		name  string
+		value string
+		def   time.Duration
+		out   *time.Duration
+	}{
+		{"timeout", cfg.Timeout, 0, &cfg.timeout},
+		{"min_backoff", cfg.MinBackoff, time.Second, &cfg.minBackoff},
+		{"max_backoff", cfg.MaxBackoff, 30 * time.Second, &cfg.maxBackoff},
+		{"key_timeout", cfg.KeyTimeout, client.DefaultKeyTimeout, &cfg.keyTimeout},
+	} {
+		*d.out = d.def
+		if d.value == "" {
+			continue
+		}
+
+		*d.out, err = time.ParseDuration(d.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+	}
+	if cfg.minBackoff <= 0 || cfg.maxBackoff < cfg.minBackoff {
+		return nil, fmt.Errorf("min_backoff must be positive and at most max_backoff")
+	}
+
+	if cfg.Keyring != "" {
+		cfg.keyring, err = client.ParseKeyring(cfg.Keyring)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.KeyDescription == "" {
+		cfg.KeyDescription = client.DefaultKeyDescription
+	}
+
+	return &cfg, nil
+}
+
+// secretFor returns the name of the secret unlocking the volume with
+// any of the provided crypttab names or source devices.
+func (cfg *config) secretFor(volumes ...string) string {
+	for _, v := range volumes {
+		if v == "" {
+			continue
+		}
+		if secret, ok := cfg.Volumes[v]; ok {
+			return secret
+		}
+	}
+	return cfg.Secret
+}
+
+// identities returns the decoded identities of the servers, by
+// address. Servers without an identity are omitted.
+func (cfg *config) identities() (map[string]ed25519.PublicKey, error) {
+	identities := make(map[string]ed25519.PublicKey, len(cfg.Servers))
+	for _, s := range cfg.Servers {
+		if s.Identity == "" {
+			continue
+		}
+
+		identity, err := base64.StdEncoding.DecodeString(s.Identity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode identity of %s: %w", s.Address, err)
+		}
+		if len(identity) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("identity of %s must be %d bytes, got %d", s.Address, ed25519.PublicKeySize, len(identity))
+		}
+		identities[s.Address] = identity
+	}
+	return identities, nil
+}
+
+// requestOptions returns the [client.RequestOption]s for the config.
+func (cfg *config) requestOptions() ([]client.RequestOption, error) {
+	var opts []client.RequestOption
+	if cfg.MeasureKernel != "" || cfg.MeasureInitramfs != "" || cfg.MeasureCmdline {
+		cmdlinePath := ""
+		if cfg.MeasureCmdline {
+			cmdlinePath = client.CmdlinePath
+		}
+
+		bm, err := client.MeasureBoot(cfg.MeasureKernel, cfg.MeasureInitramfs, cmdlinePath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithBootMeasurements(bm))
+	}
+
+	if cfg.ReportHardware {
+		hw, err := client.ReadHardware()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithHardwareAttributes(hw))
+	}
+	return opts, nil
+}
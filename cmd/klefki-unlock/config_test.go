@@ -0,0 +1,150 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/unlockconfig"
+	"git.rgst.io/homelab/klefki/pkg/client"
+)
+
+// writeConfig writes the provided config to a temporary file, returning
+// its path.
+func writeConfig(t *testing.T, config string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "unlock.json")
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return path
+}
+
+func TestReadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{name: "minimal", config: `{"servers": [{"address": "10.0.0.1:5300"}]}`},
+		{name: "no servers", config: `{"servers": []}`, wantErr: true},
+		{name: "invalid JSON", config: `{"servers": [`, wantErr: true},
+		{
+			name:   "threshold",
+			config: `{"servers": [{"address": "10.0.0.1:5300"}, {"address": "10.0.0.2:5300"}], "threshold": 2}`,
+		},
+		{name: "threshold of one", config: `{"servers": [{"address": "10.0.0.1:5300"}], "threshold": 1}`, wantErr: true},
+		{name: "threshold above servers", config: `{"servers": [{"address": "10.0.0.1:5300"}], "threshold": 2}`, wantErr: true},
+		{name: "invalid timeout", config: `{"servers": [{"address": "10.0.0.1:5300"}], "timeout": "soon"}`, wantErr: true},
+		{name: "keyring", config: `{"servers": [{"address": "10.0.0.1:5300"}], "keyring": "session"}`},
+		{name: "unknown keyring", config: `{"servers": [{"address": "10.0.0.1:5300"}], "keyring": "thread"}`, wantErr: true},
+		{
+			name:    "min backoff above max",
+			config:  `{"servers": [{"address": "10.0.0.1:5300"}], "min_backoff": "1m", "max_backoff": "1s"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := readConfig(writeConfig(t, tt.config)); (err != nil) != tt.wantErr {
+				t.Errorf("readConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadConfigDefaults(t *testing.T) {
+	cfg, err := readConfig(writeConfig(t, `{"servers": [{"address": "10.0.0.1:5300"}], "timeout": "10m"}`))
+	if err != nil {
+		t.Fatalf("readConfig() error = %v", err)
+	}
+
+	if cfg.Key != client.DefaultKeyPath {
+		t.Errorf("Key = %q, want %q", cfg.Key, client.DefaultKeyPath)
+	}
+	if cfg.timeout != 10*time.Minute || cfg.minBackoff != time.Second || cfg.maxBackoff != 30*time.Second {
+		t.Errorf("timeout, backoff = %v, %v-%v, want 10m0s, 1s-30s", cfg.timeout, cfg.minBackoff, cfg.maxBackoff)
+	}
+	if cfg.keyring != "" || cfg.KeyDescription != client.DefaultKeyDescription || cfg.keyTimeout != client.DefaultKeyTimeout {
+		t.Errorf("keyring = %q %q %v, want none with the defaults", cfg.keyring, cfg.KeyDescription, cfg.keyTimeout)
+	}
+}
+
+func TestIdentities(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		identity string
+		want     int
+		wantErr  bool
+	}{
+		{name: "pinned", identity: base64.StdEncoding.EncodeToString(pub), want: 1},
+		{name: "not pinned", identity: "", want: 0},
+		{name: "invalid base64", identity: "not base64", wantErr: true},
+		{name: "wrong size", identity: base64.StdEncoding.EncodeToString(pub[:16]), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config{Config: unlockconfig.Config{
+				Servers: []unlockconfig.Server{{Address: "10.0.0.1:5300", Identity: tt.identity}},
+			}}
+			got, err := cfg.identities()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("identities() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.want {
+				t.Errorf("identities() = %d identities, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretFor(t *testing.T) {
+	cfg := &config{Config: unlockconfig.Config{
+		Secret:  "root",
+		Volumes: map[string]string{"tank": "tank", "/dev/sdb1": "backup"},
+	}}
+
+	tests := []struct {
+		name    string
+		volumes []string
+		want    string
+	}{
+		{name: "by name", volumes: []string{"tank", "/dev/sda1"}, want: "tank"},
+		{name: "by device", volumes: []string{"data", "/dev/sdb1"}, want: "backup"},
+		{name: "unmapped", volumes: []string{"cryptroot", "/dev/sda2"}, want: "root"},
+		{name: "unknown", volumes: []string{"", ""}, want: "root"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.secretFor(tt.volumes...); got != tt.want {
+				t.Errorf("secretFor(%v) = %q, want %q", tt.volumes, got, tt.want)
+			}
+		})
+	}
+}
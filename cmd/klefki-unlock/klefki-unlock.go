@@ -0,0 +1,165 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package main implements klefki-unlock, a small client for use in
+// an initramfs that requests the key of the machine from klefki and
+// prints it, such as a crypttab keyscript.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"git.rgst.io/homelab/klefki/internal/unlockconfig"
+	"git.rgst.io/homelab/klefki/pkg/client"
+)
+
+// Exit codes returned by klefki-unlock, so that scripts can branch on
+// them.
+const (
+	// exitOK is returned when the key was printed.
+	exitOK = 0
+
+	// exitError is returned when the config or machine key is invalid
+	// or unreadable, or on any other error.
+	exitError = 1
+
+	// exitUsage is returned when the arguments are invalid.
+	exitUsage = 2
+
+	// exitTimeout is returned when the timeout passed before the key
+	// was released.
+	exitTimeout = 3
+
+	// exitUnverified is returned when a server's response wasn't signed
+	// by its pinned identity.
+	exitUnverified = 4
+
+	// exitRefused is returned when a server refused the machine, e.g.,
+	// because it was disabled or has expired.
+	exitRefused = 5
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// run runs klefki-unlock and returns its exit code.
+func run() int {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), `Usage: %[1]s [config]
+       %[1]s agent [config]
+
+Requests the key of this machine from klefki and prints it to stdout,
+or adds it to the kernel keyring if configured.
+The config is read from %[2]s if not provided, or if
+"none" as passed by crypttab when used as a keyscript. Machines with
+multiple secrets get the one configured for the volume in
+CRYPTTAB_NAME or CRYPTTAB_SOURCE, if any.
+
+With agent, runs as a systemd password agent instead, answering the
+password requests of LUKS devices with the key until interrupted.
+
+Exit codes:
+  %[3]d  the key was printed
+  %[4]d  the config or machine key is invalid, or another error occurred
+  %[5]d  the arguments are invalid
+  %[6]d  the timeout passed before the key was released
+  %[7]d  a server's response wasn't signed by its pinned identity
+  %[8]d  a server refused the machine, e.g., it was disabled or has expired
+`, os.Args[0], unlockconfig.DefaultPath, exitOK, exitError, exitUsage, exitTimeout, exitUnverified, exitRefused)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	runAsAgent := len(args) != 0 && args[0] == "agent"
+	if runAsAgent {
+		args = args[1:]
+	}
+	if len(args) > 1 {
+		flag.Usage()
+		return exitUsage
+	}
+
+	configPath := ""
+	if len(args) == 1 {
+		configPath = args[0]
+	}
+	if configPath == "" || configPath == "none" {
+		configPath = unlockconfig.DefaultPath
+	}
+
+	cfg, err := readConfig(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return exitError
+	}
+
+	if runAsAgent {
+		if err := runAgent(ctx, cfg); err != nil && !errors.Is(err, context.Canceled) {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return exitError
+		}
+		return exitOK
+	}
+
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+
+	// Keyscripts are told which volume they unlock by crypttab.
+	key, err := unlock(ctx, cfg, cfg.secretFor(os.Getenv("CRYPTTAB_NAME"), os.Getenv("CRYPTTAB_SOURCE")))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		switch {
+		case errors.Is(err, client.ErrUnverifiedResponse):
+			return exitUnverified
+		case errors.Is(err, client.ErrRefused):
+			return exitRefused
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			return exitTimeout
+		}
+		return exitError
+	}
+	defer clear(key)
+
+	if cfg.keyring != "" {
+		if _, err := client.AddToKeyring(key, cfg.keyring, cfg.KeyDescription, cfg.keyTimeout); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return exitError
+		}
+		return exitOK
+	}
+
+	// Nothing else is written to stdout, as it is used as the key.
+	if _, err := os.Stdout.Write(key); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write key: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
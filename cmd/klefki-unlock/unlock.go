@@ -0,0 +1,109 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/pkg/client"
+)
+
+// unlock requests the secret of the machine with the provided name, or
+// its default one if empty, from the servers in the provided config
+// until it is released, a response fails verification, a server
+// refuses the machine or ctx is done.
+func unlock(ctx context.Context, cfg *config, secret string) ([]byte, error) {
+	pk, err := client.ReadPrivateKey(cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := cfg.identities()
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := cfg.requestOptions()
+	if err != nil {
+		return nil, err
+	}
+	if secret != "" {
+		opts = append(opts, client.WithSecret(secret))
+	}
+
+	// Split keys are rebuilt from the shares of every server instead.
+	if cfg.Threshold != 0 {
+		addresses := make([]string, 0, len(cfg.Servers))
+		for _, s := range cfg.Servers {
+			addresses = append(addresses, s.Address)
+		}
+
+		opts = append(opts, client.WithServerIdentities(identities))
+		return client.GetKeyFromServers(ctx, addresses, cfg.Threshold, pk, cfg.minBackoff, opts...)
+	}
+
+	kcs := make([]pbgrpcv1.KlefkiServiceClient, 0, len(cfg.Servers))
+	for _, s := range cfg.Servers {
+		kc, kcclose, err := client.Dial(s.Address)
+		if err != nil {
+			return nil, err
+		}
+		defer kcclose() //nolint:errcheck // Why: Best effort
+		kcs = append(kcs, kc)
+	}
+
+	for attempt := 0; ; attempt++ {
+		for i, s := range cfg.Servers {
+			key, err := client.GetKey(ctx, kcs[i], pk, append(opts, client.WithServerIdentity(identities[s.Address]))...)
+			if err == nil {
+				return key, nil
+			}
+			if errors.Is(err, client.ErrUnverifiedResponse) || errors.Is(err, client.ErrRefused) || ctx.Err() != nil {
+				return nil, fmt.Errorf("%s: %w", s.Address, err)
+			}
+			fmt.Fprintf(os.Stderr, "%s: %v\n", s.Address, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("gave up waiting for the key: %w", ctx.Err())
+		case <-time.After(backoff(cfg, attempt)):
+		}
+	}
+}
+
+// backoff returns how long to wait before the provided attempt. It
+// doubles with every attempt, from the minimum to the maximum backoff
+// of the config, and is jittered so that machines booting together
+// don't ask in lockstep.
+func backoff(cfg *config, attempt int) time.Duration {
+	d := cfg.maxBackoff
+	if attempt < 32 {
+		d = min(cfg.minBackoff<<attempt, cfg.maxBackoff)
+	}
+	if d <= 0 { // Overflowed.
+		d = cfg.maxBackoff
+	}
+	return d/2 + rand.N(d/2+1) //nolint:gosec // Why: Only used for jitter.
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// event is a file created in or removed from a watched directory.
+type event struct {
+	name   string
+	exists bool
+}
+
+// watch returns the files created in or removed from the provided
+// directory until ctx is done, using inotify.
+func watch(ctx context.Context, dir string) (<-chan event, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inotify instance: %w", err)
+	}
+
+	// Password requests are written to a temporary file and then moved
+	// into place, so both moves and writes are watched.
+	mask := uint32(unix.IN_CLOSE_WRITE | unix.IN_MOVED_TO | unix.IN_DELETE | unix.IN_MOVED_FROM)
+	if _, err := unix.InotifyAddWatch(fd, dir, mask); err != nil {
+		unix.Close(fd) //nolint:errcheck // Why: Best effort
+		return nil, fmt.Errorf("failed to watch %s: %w", dir, err)
+	}
+
+	// Wrapping the non-blocking fd in an os.File makes reads use the
+	// runtime poller, so closing it unblocks them.
+	f := os.NewFile(uintptr(fd), "inotify")
+	go func() {
+		<-ctx.Done()
+		f.Close() //nolint:errcheck // Why: Best effort
+	}()
+
+	events := make(chan event)
+	go func() {
+		defer close(events)
+
+		buf := make([]byte, 4096)
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				if ctx.Err() == nil {
+					fmt.Fprintf(os.Stderr, "failed to read inotify events: %v\n", err)
+				}
+				return
+			}
+
+			for off := 0; off+unix.SizeofInotifyEvent <= n; {
+				raw := (*unix.InotifyEvent)(unsafe.Pointer(&buf[off])) //nolint:gosec // Why: Layout defined by the kernel.
+				nameStart := off + unix.SizeofInotifyEvent
+				off = nameStart + int(raw.Len)
+				if off > n {
+					break
+				}
+
+				name := string(bytesBeforeNUL(buf[nameStart:off]))
+				exists := raw.Mask&(unix.IN_CLOSE_WRITE|unix.IN_MOVED_TO) != 0
+				select {
+				case events <- event{name, exists}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
+
+// bytesBeforeNUL returns b up to the first NUL byte, which pads the
+// names of inotify events.
+func bytesBeforeNUL(b []byte) []byte {
+	for i, c := range b {
+		if c == 0 {
+			return b[:i]
+		}
+	}
+	return b
+}
+
+// untilMonotonic returns how long it is until the provided time on
+// CLOCK_MONOTONIC.
+func untilMonotonic(t time.Duration) (time.Duration, error) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return 0, fmt.Errorf("failed to get monotonic time: %w", err)
+	}
+	return t - time.Duration(ts.Nano()), nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+//go:build !linux
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// errUnsupported is returned by the password agent on platforms other
+// than Linux, where systemd's ask-password protocol doesn't exist.
+var errUnsupported = errors.New("the password agent is only supported on Linux")
+
+// event is a file created in or removed from a watched directory.
+type event struct {
+	name   string
+	exists bool
+}
+
+// watch is not supported on this platform.
+func watch(_ context.Context, _ string) (<-chan event, error) {
+	return nil, errUnsupported
+}
+
+// untilMonotonic is not supported on this platform.
+func untilMonotonic(_ time.Duration) (time.Duration, error) {
+	return 0, errUnsupported
+}
@@ -20,11 +20,17 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
+	"strings"
 
+	"git.rgst.io/homelab/klefki/internal/credhelper"
+	"git.rgst.io/homelab/klefki/internal/registry"
 	"git.rgst.io/homelab/klefki/internal/server"
+	"git.rgst.io/homelab/klefki/internal/vaultkv"
 )
 
 func main() {
@@ -34,7 +40,61 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
 	defer cancel()
 
-	s := (server.Server{})
+	sealAfter := flag.Duration("seal-after", 0, "seal the vault again this long after it was unsealed, 0 to never seal automatically")
+	alertWebhook := flag.String("alert-webhook", "", "URL to post alerts for operators to as JSON, alerts are always logged")
+	tangAddress := flag.String("tang-address", "", "address to serve the Tang protocol on (e.g., :8080), disabled if empty")
+	tangAllow := flag.String("tang-allow", "", "comma separated CIDRs Tang recovery is allowed from, all if empty")
+	keyRotationGrace := flag.Duration("key-rotation-grace", registry.DefaultAliasGrace, "how long the key a machine rotated is still accepted")
+	maxKeySize := flag.Int("max-key-size", server.DefaultMaxKeySize, "maximum size, in bytes, of submitted keys once encrypted")
+	vaultKVPath := flag.String("vault-kv-path", "",
+		"Vault KV v2 path template to read the keys of machines unlocked automatically from (e.g., "+vaultkv.DefaultPath+
+			"), disabled if empty, configured through VAULT_* environment variables")
+	credentialHelper := flag.String("credential-helper", "",
+		"name of the credential helper (klefki-credential-<name>) to read the keys of machines unlocked automatically from, disabled if empty")
+	credentialHelperTimeout := flag.Duration("credential-helper-timeout", credhelper.DefaultTimeout, "how long the credential helper has to respond")
+	flag.Parse()
+
+	var tangAllowed []*net.IPNet
+	for cidr := range strings.SplitSeq(*tangAllow, ",") {
+		if cidr == "" {
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid --tang-allow: %v\n", err)
+			exitCode = 1
+			return
+		}
+		tangAllowed = append(tangAllowed, n)
+	}
+
+	var kv *vaultkv.Client
+	if *vaultKVPath != "" {
+		var err error
+		kv, err = vaultkv.New(vaultkv.ConfigFromEnv(*vaultKVPath))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid --vault-kv-path: %v\n", err)
+			exitCode = 1
+			return
+		}
+	}
+
+	var helper *credhelper.Helper
+	if *credentialHelper != "" {
+		helper = &credhelper.Helper{Name: *credentialHelper, Timeout: *credentialHelperTimeout}
+	}
+
+	s := (server.Server{
+		SealAfter:        *sealAfter,
+		AlertWebhook:     *alertWebhook,
+		TangAddress:      *tangAddress,
+		TangAllowed:      tangAllowed,
+		KeyRotationGrace: *keyRotationGrace,
+		MaxKeySize:       *maxKeySize,
+		VaultKV:          kv,
+		CredentialHelper: helper,
+	})
 	go func() {
 		if err := s.Run(ctx); err != nil {
 			fmt.Fprintf(os.Stderr, "failed to start server: %v\n", err)
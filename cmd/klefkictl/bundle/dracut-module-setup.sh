@@ -0,0 +1,27 @@
+#!/bin/bash
+# dracut module installing klefki-unlock as a systemd password agent,
+# answering the passphrase prompts of LUKS devices with the key released
+# by klefki. Generated by klefkictl bundle.
+
+check() {
+	require_binaries klefki-unlock || return 1
+	return 255
+}
+
+depends() {
+	echo network systemd
+	return 0
+}
+
+install() {
+	inst_binary klefki-unlock /usr/bin/klefki-unlock
+	inst_simple /etc/klefki/unlock.json
+	inst_simple -m 0600 /etc/klefki/machine.key
+	inst_simple "$moddir/klefki-agent.service" "$systemdsystemunitdir/klefki-agent.service"
+	$SYSTEMCTL -q --root "$initdir" enable klefki-agent.service
+
+	# klefki is reached over the network, which dracut only brings up
+	# when asked to.
+	mkdir -p "$initdir/etc/cmdline.d"
+	echo "rd.neednet=1" > "$initdir/etc/cmdline.d/90klefki.conf"
+}
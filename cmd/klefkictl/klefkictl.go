@@ -37,8 +37,27 @@ func main() {
 	rootCmd.AddCommand(
 		newNewCommand(),
 		newListCommand(),
+		newShowCommand(),
 		newDeleteCommand(),
+		newUpdateCommand(),
+		newEnableCommand(),
+		newDisableCommand(),
+		newQuarantineCommand(),
+		newOperatorsCommand(),
+		newShamirCommand(),
+		newVaultCommand(),
+		newPolicyCommand(),
+		newWindowsCommand(),
+		newGroupsCommand(),
+		newPassphrasesCommand(),
+		newMeasurementsCommand(),
+		newHardwareCommand(),
+		newTangCommand(),
+		newAuditCommand(),
+		newBundleCommand(),
+		newEnrollCommand(),
 		newRequestsCommand(),
+		newApproveConsoleCommand(),
 	)
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		fmt.Fprintln(os.Stderr, err)
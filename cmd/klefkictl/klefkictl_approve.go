@@ -0,0 +1,662 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/pkg/client"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// newApproveConsoleCommand creates an approve [cobra.Command], which
+// runs the interactive approval console.
+func newApproveConsoleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Watch pending sessions and submit, approve or deny them interactively",
+		Long: "Run a terminal UI watching the machines waiting for their key live. Pick a\n" +
+			"machine to see its details, then submit its passphrase, fetched from\n" +
+			"--passphrase-source or typed, approve, deny or cancel its session, all from\n" +
+			"one screen. Approving, denying and cancelling require --operator-key.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			source, err := passphraseSourceFromFlags(cmd, false)
+			if err != nil {
+				return err
+			}
+			// The console owns the terminal, so passphrases can't be
+			// prompted for while it runs.
+			if err := prepareConsoleSources(source); err != nil {
+				return err
+			}
+
+			var pk ed25519.PrivateKey
+			var operatorID string
+			if operatorKeyPath := cmd.Flag("operator-key").Value.String(); operatorKeyPath != "" {
+				pk, operatorID, err = readOperatorKey(operatorKeyPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return err
+			}
+
+			kc, kcclose, err := client.Dial(cmd.Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			req := &pbgrpcv1.ListSessionsRequest{}
+			req.SetGroup(cmd.Flag("group").Value.String())
+			req.SetSelector(cmd.Flag("selector").Value.String())
+
+			m := &approveModel{
+				ctx:        cmd.Context(),
+				kc:         kc,
+				req:        req,
+				interval:   interval,
+				source:     source,
+				pk:         pk,
+				operatorID: operatorID,
+			}
+			_, err = tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(cmd.Context())).Run()
+			clear(m.input)
+			if errors.Is(err, tea.ErrProgramKilled) {
+				return nil
+			}
+			return err
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("hostname", "127.0.0.1:5300", "hostname of the klefki server to connect to")
+	flags.String("operator-key", "", "path to operator private key, to approve, deny and cancel sessions")
+	flags.String("group", "", "only watch machines in this group")
+	flags.StringP("selector", "l", "", "only watch machines whose labels match this selector (e.g., role=storage)")
+	flags.Duration("interval", 2*time.Second, "how often to refresh the pending sessions")
+	flags.StringSlice("passphrase-source", nil,
+		"sources to fetch passphrases from, in order: file:<path>, list:<path>, store[:<path>], vault[:<path>] or helper:<name>")
+	return cmd
+}
+
+// prepareConsoleSources returns an error if the provided passphrase
+// source prompts, and unlocks the passphrase stores in it.
+func prepareConsoleSources(source PassphraseSource) error {
+	switch s := source.(type) {
+	case sourceChain:
+		for _, source := range s {
+			if err := prepareConsoleSources(source); err != nil {
+				return err
+			}
+		}
+	case ttySource, *stdinSource:
+		return fmt.Errorf("the tty and stdin passphrase sources can't be used by the console, passphrases are typed in it instead")
+	case *storeSource:
+		return s.unlock()
+	}
+	return nil
+}
+
+// approveView is a screen of the approval console.
+type approveView int
+
+const (
+	// viewList lists the pending sessions.
+	viewList approveView = iota
+
+	// viewDetails shows the details of the selected machine.
+	viewDetails
+
+	// viewPassphrase reads the passphrase to submit.
+	viewPassphrase
+
+	// viewConfirm asks to confirm an action.
+	viewConfirm
+)
+
+// sessionsMsg is the result of listing the pending sessions.
+type sessionsMsg struct {
+	machines []*pbgrpcv1.Machine
+	err      error
+}
+
+// tickMsg is sent every refresh interval.
+type tickMsg time.Time
+
+// actionMsg is the result of an action on a session.
+type actionMsg struct {
+	status string
+	err    error
+
+	// submitted is whether a key was submitted, so that the next secret
+	// waiting for its key is selected.
+	submitted bool
+}
+
+// fetchedMsg is the result of fetching a passphrase from the
+// passphrase sources.
+type fetchedMsg struct {
+	passphrase []byte
+	err        error
+}
+
+// Styles of the approval console.
+var (
+	consoleTitle    = lipgloss.NewStyle().Bold(true)
+	consoleSelected = lipgloss.NewStyle().Reverse(true)
+	consoleHelp     = lipgloss.NewStyle().Faint(true)
+	consoleError    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	consoleStatus   = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+)
+
+// approveModel is the [tea.Model] of the approval console.
+type approveModel struct {
+	// ctx is the context of the command, as actions run outside of
+	// Update.
+	ctx        context.Context
+	kc         pbgrpcv1.KlefkiServiceClient
+	req        *pbgrpcv1.ListSessionsRequest
+	interval   time.Duration
+	source     PassphraseSource
+	pk         ed25519.PrivateKey
+	operatorID string
+
+	// machines are the machines with a pending session, sorted by name,
+	// as of refreshedAt.
+	machines    []*pbgrpcv1.Machine
+	refreshedAt time.Time
+	refreshErr  error
+
+	view approveView
+
+	// cursor is the index of the highlighted machine in the list.
+	cursor int
+
+	// selected is the ID of the machine whose details are shown, and
+	// secret the index of the selected secret of it.
+	selected string
+	secret   int
+
+	// input is the passphrase being typed.
+	input []byte
+
+	// prompt and onConfirm are the question asked to confirm an action,
+	// and the action.
+	prompt    string
+	onConfirm tea.Cmd
+
+	// status is the result of the last action, and busy whether one is
+	// in progress.
+	status    string
+	statusErr bool
+	busy      bool
+
+	// advance is whether to select the next secret waiting for its key
+	// once the sessions are refreshed.
+	advance bool
+}
+
+// Init implements [tea.Model].
+func (m *approveModel) Init() tea.Cmd {
+	return tea.Batch(m.refresh(), m.tick())
+}
+
+// refresh lists the pending sessions.
+func (m *approveModel) refresh() tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.kc.ListSessions(m.ctx, m.req)
+		if err != nil {
+			return sessionsMsg{err: fmt.Errorf("failed to list sessions: %w", err)}
+		}
+		ms := resp.GetMachines()
+		slices.SortFunc(ms, func(a, b *pbgrpcv1.Machine) int { return strings.Compare(a.GetName(), b.GetName()) })
+		return sessionsMsg{machines: ms}
+	}
+}
+
+// tick sends a [tickMsg] after the refresh interval.
+func (m *approveModel) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+// machine returns the selected machine, or nil if its session ended.
+func (m *approveModel) machine() *pbgrpcv1.Machine {
+	for _, ms := range m.machines {
+		if ms.GetId() == m.selected {
+			return ms
+		}
+	}
+	return nil
+}
+
+// selectedSecret returns the name of the selected secret of the
+// provided machine.
+func (m *approveModel) selectedSecret(ms *pbgrpcv1.Machine) string {
+	secs := ms.GetSecrets()
+	if len(secs) == 0 {
+		return ""
+	}
+	return secs[m.secret%len(secs)].GetName()
+}
+
+// setStatus sets the status line to the provided result of an action.
+func (m *approveModel) setStatus(status string, err error) {
+	m.status, m.statusErr = status, err != nil
+	if err != nil {
+		m.status = err.Error()
+	}
+}
+
+// Update implements [tea.Model].
+func (m *approveModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case sessionsMsg:
+		m.refreshedAt, m.refreshErr = time.Now(), msg.err
+		if msg.err == nil {
+			m.machines = msg.machines
+		}
+		m.cursor = max(min(m.cursor, len(m.machines)-1), 0)
+		if ms := m.machine(); ms != nil && m.advance {
+			m.selectWaiting(ms)
+		}
+		m.advance = false
+		// The result of the action that ended the session, if any, is
+		// kept.
+		if m.view != viewList && m.machine() == nil {
+			m.leave()
+			m.view = viewList
+			if m.status == "" {
+				m.setStatus("The session of the machine ended", nil)
+			}
+		}
+		return m, nil
+	case tickMsg:
+		return m, tea.Batch(m.refresh(), m.tick())
+	case actionMsg:
+		m.busy, m.advance = false, msg.submitted
+		m.setStatus(msg.status, msg.err)
+		return m, m.refresh()
+	case fetchedMsg:
+		return m.fetched(msg)
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		switch m.view {
+		case viewList:
+			return m.updateList(msg)
+		case viewDetails:
+			return m.updateDetails(msg)
+		case viewPassphrase:
+			return m.updatePassphrase(msg)
+		case viewConfirm:
+			return m.updateConfirm(msg)
+		}
+	}
+	return m, nil
+}
+
+// leave drops the state of the current screen.
+func (m *approveModel) leave() {
+	clear(m.input)
+	m.input = nil
+	m.prompt, m.onConfirm = "", nil
+}
+
+// updateList handles keys on the list of pending sessions.
+func (m *approveModel) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		return m, tea.Quit
+	case "up", "k":
+		m.cursor = max(m.cursor-1, 0)
+	case "down", "j":
+		m.cursor = max(min(m.cursor+1, len(m.machines)-1), 0)
+	case "r":
+		return m, m.refresh()
+	case "enter":
+		if len(m.machines) == 0 {
+			return m, nil
+		}
+		ms := m.machines[m.cursor]
+		m.selected, m.view = ms.GetId(), viewDetails
+		m.selectWaiting(ms)
+		m.setStatus("", nil)
+	}
+	return m, nil
+}
+
+// selectWaiting selects the first secret of the provided machine still
+// waiting for its key, if any.
+func (m *approveModel) selectWaiting(ms *pbgrpcv1.Machine) {
+	m.secret = 0
+	for i, sec := range ms.GetSecrets() {
+		if secretStatus(ms, sec) == "waiting" {
+			m.secret = i
+			return
+		}
+	}
+}
+
+// updateDetails handles keys on the details of the selected machine.
+func (m *approveModel) updateDetails(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	ms := m.machine()
+	if ms == nil || m.busy {
+		if msg.String() == "esc" || msg.String() == "q" {
+			m.view = viewList
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.view = viewList
+	case "tab":
+		m.secret = (m.secret + 1) % max(len(ms.GetSecrets()), 1)
+	case "s", "t":
+		if ms.GetShareThreshold() > 0 {
+			m.setStatus("", fmt.Errorf("%s uses split keys, submit its shares with 'klefkictl requests submitshare'", ms.GetName()))
+			return m, nil
+		}
+		if ms.GetDeniedBy() != "" {
+			m.setStatus("", fmt.Errorf("the session of %s was denied, cancel it first", ms.GetName()))
+			return m, nil
+		}
+		if secs := ms.GetSecrets(); len(secs) != 0 && secs[m.secret%len(secs)].GetReleased() {
+			m.setStatus("", fmt.Errorf("secret %q of %s was already released", m.selectedSecret(ms), ms.GetName()))
+			return m, nil
+		}
+		if msg.String() == "t" {
+			m.view = viewPassphrase
+			return m, nil
+		}
+		m.busy = true
+		m.setStatus("Fetching passphrase...", nil)
+		return m, m.fetch(ms, m.selectedSecret(ms))
+	case "a":
+		return m.confirm(fmt.Sprintf("Approve the session of %s?", ms.GetName()), func(ctx context.Context) (string, error) {
+			resp, err := approveSession(ctx, m.kc, m.pk, m.operatorID, ms.GetId())
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Approved %s (%d/%d)", ms.GetName(), resp.GetApprovals(), resp.GetRequiredApprovals()), nil
+		})
+	case "d":
+		return m.confirm(fmt.Sprintf("Deny the session of %s? It's refused until the session is cancelled.", ms.GetName()),
+			func(ctx context.Context) (string, error) {
+				return "Denied " + ms.GetName(), denySession(ctx, m.kc, m.pk, m.operatorID, ms.GetId())
+			})
+	case "c":
+		return m.confirm(fmt.Sprintf("Cancel the session of %s, dropping its submitted keys and approvals?", ms.GetName()),
+			func(ctx context.Context) (string, error) {
+				return "Cancelled the session of " + ms.GetName(), cancelSession(ctx, m.kc, m.pk, m.operatorID, ms.GetId())
+			})
+	}
+	return m, nil
+}
+
+// confirm asks to confirm the provided operator action.
+func (m *approveModel) confirm(prompt string, action func(ctx context.Context) (string, error)) (tea.Model, tea.Cmd) {
+	if m.pk == nil {
+		m.setStatus("", fmt.Errorf("an --operator-key is required to approve, deny and cancel sessions"))
+		return m, nil
+	}
+
+	m.view, m.prompt = viewConfirm, prompt
+	m.onConfirm = func() tea.Msg {
+		status, err := action(m.ctx)
+		return actionMsg{status: status, err: err}
+	}
+	return m, nil
+}
+
+// fetch fetches the passphrase of the provided secret of the provided
+// machine from the passphrase sources.
+func (m *approveModel) fetch(ms *pbgrpcv1.Machine, secret string) tea.Cmd {
+	return func() tea.Msg {
+		passphrase, err := m.source.Passphrase(ms, secret)
+		return fetchedMsg{passphrase: passphrase, err: err}
+	}
+}
+
+// fetched asks to confirm submitting the fetched passphrase, or for the
+// passphrase to be typed if the sources don't hold it.
+func (m *approveModel) fetched(msg fetchedMsg) (tea.Model, tea.Cmd) {
+	m.busy = false
+	ms := m.machine()
+	if m.view != viewDetails || ms == nil {
+		clear(msg.passphrase)
+		return m, nil
+	}
+
+	switch {
+	case errors.Is(msg.err, errNoPassphrase):
+		m.view = viewPassphrase
+		m.setStatus("No passphrase found, type it instead", nil)
+		return m, nil
+	case msg.err != nil:
+		m.setStatus("", msg.err)
+		return m, nil
+	}
+
+	m.setStatus("", nil)
+	secret := m.selectedSecret(ms)
+	m.view = viewConfirm
+	m.prompt = fmt.Sprintf("Submit the fetched passphrase for secret %q of %s?", secret, ms.GetName())
+	m.onConfirm = m.submit(ms, secret, msg.passphrase)
+	return m, nil
+}
+
+// submit submits the provided passphrase for the provided secret of the
+// provided machine, clearing it once done.
+func (m *approveModel) submit(ms *pbgrpcv1.Machine, secret string, passphrase []byte) tea.Cmd {
+	return func() tea.Msg {
+		defer clear(passphrase)
+		if err := submitKeyTo(m.ctx, m.kc, ms, secret, passphrase, 0); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to submit key: %w", err)}
+		}
+		return actionMsg{status: fmt.Sprintf("Submitted secret %q of %s", secret, ms.GetName()), submitted: true}
+	}
+}
+
+// updatePassphrase handles keys while the passphrase is typed.
+func (m *approveModel) updatePassphrase(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type { //nolint:exhaustive // Why: Other keys are ignored.
+	case tea.KeyEsc:
+		m.leave()
+		m.view = viewDetails
+	case tea.KeyBackspace:
+		if len(m.input) != 0 {
+			_, size := utf8.DecodeLastRune(m.input)
+			clear(m.input[len(m.input)-size:])
+			m.input = m.input[:len(m.input)-size]
+		}
+	case tea.KeyEnter:
+		ms := m.machine()
+		if ms == nil || len(m.input) == 0 {
+			return m, nil
+		}
+		passphrase := m.input
+		m.input = nil
+		m.view, m.busy = viewDetails, true
+		m.setStatus("Submitting...", nil)
+		return m, m.submit(ms, m.selectedSecret(ms), passphrase)
+	case tea.KeyRunes, tea.KeySpace:
+		m.input = append(m.input, string(msg.Runes)...)
+	}
+	return m, nil
+}
+
+// updateConfirm handles keys while an action is to be confirmed.
+func (m *approveModel) updateConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		action := m.onConfirm
+		m.prompt, m.onConfirm = "", nil
+		m.view, m.busy = viewDetails, true
+		m.setStatus("Working...", nil)
+		return m, action
+	case "n", "N", "esc", "q":
+		m.leave()
+		m.view = viewDetails
+		m.setStatus("Cancelled", nil)
+	}
+	return m, nil
+}
+
+// View implements [tea.Model].
+func (m *approveModel) View() string {
+	var b strings.Builder
+	b.WriteString(consoleTitle.Render("klefki approval console"))
+	if !m.refreshedAt.IsZero() {
+		fmt.Fprintf(&b, "  %d pending, refreshed %s", len(m.machines), m.refreshedAt.Format(time.TimeOnly))
+	}
+	b.WriteString("\n\n")
+
+	switch m.view {
+	case viewList:
+		m.viewList(&b)
+	case viewDetails, viewPassphrase, viewConfirm:
+		m.viewDetails(&b)
+	}
+
+	if m.refreshErr != nil {
+		b.WriteString("\n" + consoleError.Render(m.refreshErr.Error()) + "\n")
+	}
+	if m.status != "" {
+		style := consoleStatus
+		if m.statusErr {
+			style = consoleError
+		}
+		b.WriteString("\n" + style.Render(m.status) + "\n")
+	}
+	return b.String()
+}
+
+// viewList renders the list of pending sessions.
+func (m *approveModel) viewList(b *strings.Builder) {
+	if len(m.machines) == 0 {
+		b.WriteString("No machines are waiting for their key.\n")
+	} else {
+		var table strings.Builder
+		tw := tabwriter.NewWriter(&table, 2, 2, 2, ' ', 0)
+		fmt.Fprint(tw, "NAME\tLABELS\tPEER\tWAITING\tSECRETS\tAPPROVALS\n")
+		for _, ms := range m.machines {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", ms.GetName(), formatLabels(ms.GetLabels()), ms.GetPeerAddress(),
+				waitingFor(ms), sessionStatus(ms), fmt.Sprintf("%d/%d", len(ms.GetApprovals()), ms.GetRequiredApprovals()))
+		}
+		tw.Flush() //nolint:errcheck // Why: Writes to a strings.Builder.
+
+		for i, line := range strings.Split(strings.TrimSuffix(table.String(), "\n"), "\n") {
+			switch {
+			case i == 0:
+				line = consoleTitle.Render(line)
+			case i-1 == m.cursor:
+				line = consoleSelected.Render(line)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	b.WriteString("\n" + consoleHelp.Render("↑/↓ select • enter details • r refresh • q quit") + "\n")
+}
+
+// viewDetails renders the details of the selected machine, and the
+// passphrase or confirmation prompt.
+func (m *approveModel) viewDetails(b *strings.Builder) {
+	ms := m.machine()
+	if ms == nil {
+		b.WriteString("The session of the machine ended.\n")
+		return
+	}
+
+	tw := tabwriter.NewWriter(b, 2, 2, 2, ' ', 0)
+	fmt.Fprintf(tw, "Name:\t%s\n", ms.GetName())
+	fmt.Fprintf(tw, "ID:\t%s\n", ms.GetId())
+	fmt.Fprintf(tw, "Fingerprint:\t%s\n", ms.GetFingerprint())
+	if ms.GetDescription() != "" {
+		fmt.Fprintf(tw, "Description:\t%s\n", ms.GetDescription())
+	}
+	fmt.Fprintf(tw, "Labels:\t%s\n", formatLabels(ms.GetLabels()))
+	fmt.Fprintf(tw, "Peer:\t%s\n", ms.GetPeerAddress())
+	lastAsked := ms.GetLastAsked()
+	if t, err := time.Parse(time.RFC3339Nano, lastAsked); err == nil {
+		lastAsked = formatTime(&t)
+	}
+	fmt.Fprintf(tw, "Waiting:\t%s (last asked %s)\n", waitingFor(ms), lastAsked)
+
+	approvers := make([]string, 0, len(ms.GetApprovals()))
+	for _, a := range ms.GetApprovals() {
+		approvers = append(approvers, a.GetOperatorName())
+	}
+	approvals := fmt.Sprintf("%d/%d", len(ms.GetApprovals()), ms.GetRequiredApprovals())
+	if len(approvers) != 0 {
+		approvals += " (" + strings.Join(approvers, ", ") + ")"
+	}
+	fmt.Fprintf(tw, "Approvals:\t%s\n", approvals)
+	if ms.GetDeniedBy() != "" {
+		fmt.Fprintf(tw, "Denied by:\t%s\n", ms.GetDeniedBy())
+	}
+	tw.Flush() //nolint:errcheck // Why: Writes to a strings.Builder.
+
+	b.WriteString("\nSecrets:\n")
+	secret := m.selectedSecret(ms)
+	for _, sec := range ms.GetSecrets() {
+		line := fmt.Sprintf("  %s: %s", sec.GetName(), secretStatus(ms, sec))
+		if sec.GetName() == secret {
+			line = consoleSelected.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n")
+
+	switch m.view { //nolint:exhaustive // Why: Only the details have prompts.
+	case viewPassphrase:
+		fmt.Fprintf(b, "Passphrase for secret %q: %s\n", secret, strings.Repeat("*", utf8.RuneCount(m.input)))
+		b.WriteString("\n" + consoleHelp.Render("enter submit • esc back") + "\n")
+	case viewConfirm:
+		b.WriteString(consoleTitle.Render(m.prompt) + " [y/n]\n")
+	default:
+		b.WriteString(consoleHelp.Render("tab next secret • s submit (fetch) • t type passphrase • "+
+			"a approve • d deny • c cancel session • esc back") + "\n")
+	}
+}
+
+// waitingFor returns how long the provided machine has been waiting for
+// its key.
+func waitingFor(ms *pbgrpcv1.Machine) string {
+	startedAt, err := time.Parse(time.RFC3339Nano, ms.GetStartedAt())
+	if err != nil {
+		return "unknown"
+	}
+	return time.Since(startedAt).Round(time.Second).String()
+}
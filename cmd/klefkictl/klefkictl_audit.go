@@ -0,0 +1,85 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newAuditCommand creates an audit [cobra.Command]
+func newAuditCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Show the audit log, most recent events first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			flags := cmd.Flags()
+			limit, err := flags.GetInt("limit")
+			if err != nil {
+				return err
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			q := dbc.AuditEvent.Query().
+				Order(ent.Desc(auditevent.FieldCreatedAt), ent.Desc(auditevent.FieldID)).
+				Limit(limit)
+			if machineID := cmd.Flag("machine").Value.String(); machineID != "" {
+				// Events about deleted machines can only be found by their ID.
+				if m, err := registry.Lookup(cmd.Context(), dbc, machineID); err == nil {
+					machineID = m.ID
+				}
+				q.Where(auditevent.MachineID(machineID))
+			}
+
+			events, err := q.All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "TIME\tTYPE\tMACHINE\tOPERATOR\tDETAILS\n")
+			for _, e := range events {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.CreatedAt.Local().Format(time.RFC3339),
+					e.Type, e.MachineID, e.OperatorID, e.Details)
+			}
+			return tw.Flush()
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("machine", "", "only show events about the machine with this ID, name or fingerprint")
+	flags.Int("limit", 50, "maximum number of events to show")
+	return cmd
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+// bulkAction is what bulk approve does for a single machine.
+type bulkAction struct {
+	// machine is the machine, as returned by ListSessions.
+	machine *pbgrpcv1.Machine
+
+	// secrets are the names of the secrets to submit, which weren't
+	// submitted yet, with passphrases holding their passphrases.
+	secrets     []string
+	passphrases [][]byte
+
+	// missing are the names of the secrets that weren't submitted yet,
+	// but have no passphrase.
+	missing []string
+
+	// approve is whether to approve the session of the machine.
+	approve bool
+
+	// skip is why nothing is done for the machine, if set.
+	skip string
+}
+
+// String returns a human readable description of the action.
+func (a *bulkAction) String() string {
+	if a.skip != "" {
+		return "skip: " + a.skip
+	}
+
+	var actions []string
+	if len(a.secrets) != 0 {
+		actions = append(actions, "submit "+strings.Join(a.secrets, ","))
+	}
+	if a.approve {
+		actions = append(actions, "approve")
+	}
+	if len(a.missing) != 0 {
+		actions = append(actions, "no passphrase for "+strings.Join(a.missing, ","))
+	}
+	return strings.Join(actions, ", ")
+}
+
+// newBulkApproveCommand creates a bulkapprove [cobra.Command]
+func newBulkApproveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulkapprove",
+		Short: "Submit the keys of every waiting machine in a group at once",
+		Long: "Submit the keys of every machine in a group, or matching a label selector,\n" +
+			"that is waiting for its key, e.g., after a power cut. Passphrases are taken\n" +
+			"from --passphrases, then --passphrase-source (by default, the passphrase\n" +
+			"store, if one exists). With --operator-key, the sessions are approved as well.\n" +
+			"What will be done is shown, and must be confirmed, before anything is.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			flags := cmd.Flags()
+			yes, err := flags.GetBool("yes")
+			if err != nil {
+				return err
+			}
+
+			source, err := passphraseSourceFromFlags(cmd, false)
+			if err != nil {
+				return err
+			}
+			if path := cmd.Flag("passphrases").Value.String(); path != "" {
+				ls, err := readListSource(path)
+				if err != nil {
+					return err
+				}
+				source = sourceChain{ls, source}
+			}
+
+			var pk ed25519.PrivateKey
+			var operatorID string
+			if operatorKeyPath := cmd.Flag("operator-key").Value.String(); operatorKeyPath != "" {
+				pk, operatorID, err = readOperatorKey(operatorKeyPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			req := &pbgrpcv1.ListSessionsRequest{}
+			req.SetGroup(cmd.Flag("group").Value.String())
+			req.SetSelector(cmd.Flag("selector").Value.String())
+			resp, err := kc.ListSessions(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to list sessions: %w", err)
+			}
+
+			actions, err := planBulkApprove(resp.GetMachines(), source, pk != nil)
+			defer func() {
+				for _, a := range actions {
+					for _, passphrase := range a.passphrases {
+						clear(passphrase)
+					}
+				}
+			}()
+			if err != nil {
+				return err
+			}
+
+			pending := 0
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "NAME\tID\tACTION\n")
+			for _, a := range actions {
+				if a.skip == "" {
+					pending++
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", a.machine.GetName(), a.machine.GetId(), a)
+			}
+			if err := tw.Flush(); err != nil {
+				return err
+			}
+			if pending == 0 {
+				fmt.Println("Nothing to do")
+				return nil
+			}
+
+			if !yes {
+				fmt.Printf("Continue with %d machines? [y/N] ", pending)
+				answer, _ := bufio.NewReader(os.Stdin).ReadString('\n') //nolint:errcheck // Why: Treated as no.
+				if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+					return fmt.Errorf("aborted")
+				}
+			}
+
+			failed := 0
+			tw = tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "NAME\tID\tRESULT\n")
+			for _, a := range actions {
+				if a.skip != "" {
+					continue
+				}
+
+				result := "ok"
+				for i, secret := range a.secrets {
+					if err := submitKeyTo(cmd.Context(), kc, a.machine, secret, a.passphrases[i], 0); err != nil {
+						result = fmt.Sprintf("failed to submit %s: %v", secret, err)
+						break
+					}
+				}
+				if a.approve && result == "ok" {
+					resp, err := approveSession(cmd.Context(), kc, pk, operatorID, a.machine.GetId())
+					if err != nil {
+						result = err.Error()
+					} else {
+						result = fmt.Sprintf("ok, approved (%d/%d)", resp.GetApprovals(), resp.GetRequiredApprovals())
+					}
+				}
+				if !strings.HasPrefix(result, "ok") {
+					failed++
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", a.machine.GetName(), a.machine.GetId(), result)
+			}
+			if err := tw.Flush(); err != nil {
+				return err
+			}
+			if failed != 0 {
+				return fmt.Errorf("failed for %d of %d machines", failed, pending)
+			}
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("group", "", "group of the machines to submit the keys of")
+	flags.StringP("selector", "l", "", "label selector of the machines to submit the keys of (e.g., role=storage)")
+	flags.String("passphrases", "", "path to a file of '<machine>[@<secret>] <passphrase>' lines, by ID, name or fingerprint")
+	flags.String("operator-key", "", "path to operator private key, to also approve the sessions")
+	flags.BoolP("yes", "y", false, "don't ask for confirmation")
+	flags.StringSlice("passphrase-source", nil,
+		"sources to read passphrases from, in order, after --passphrases: file:<path>, list:<path>, store[:<path>], "+
+			"vault[:<path>] or helper:<name>")
+	cmd.MarkFlagsOneRequired("group", "selector")
+	return cmd
+}
+
+// planBulkApprove returns what bulk approve does for each of the
+// provided machines waiting for their key.
+func planBulkApprove(ms []*pbgrpcv1.Machine, source PassphraseSource, approve bool) ([]*bulkAction, error) {
+	actions := make([]*bulkAction, 0, len(ms))
+	for _, m := range ms {
+		a := &bulkAction{machine: m}
+		actions = append(actions, a)
+
+		// Split keys are submitted share by share, with submitshare.
+		if m.GetShareThreshold() > 0 || m.GetShareIndex() > 0 {
+			a.skip = "key is split into shares"
+			continue
+		}
+
+		for _, sec := range m.GetSecrets() {
+			if sec.GetKeySubmitted() || sec.GetReleased() {
+				continue
+			}
+
+			passphrase, err := source.Passphrase(m, sec.GetName())
+			if errors.Is(err, errNoPassphrase) {
+				a.missing = append(a.missing, sec.GetName())
+				continue
+			}
+			if err != nil {
+				return actions, err
+			}
+			a.secrets = append(a.secrets, sec.GetName())
+			a.passphrases = append(a.passphrases, passphrase)
+		}
+
+		a.approve = approve && len(m.GetApprovals()) < int(m.GetRequiredApprovals())
+		if len(a.secrets) == 0 && !a.approve {
+			a.skip = "keys already submitted"
+			if len(a.missing) != 0 {
+				a.skip = "no passphrase for " + strings.Join(a.missing, ",")
+			}
+		}
+	}
+	return actions, nil
+}
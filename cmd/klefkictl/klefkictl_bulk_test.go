@@ -0,0 +1,103 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"testing"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// newSecretStatus returns the status of a secret, as returned by
+// ListSessions.
+func newSecretStatus(name string, submitted bool) *pbgrpcv1.SecretStatus {
+	return pbgrpcv1.SecretStatus_builder{Name: proto.String(name), KeySubmitted: proto.Bool(submitted)}.Build()
+}
+
+func TestPlanBulkApprove(t *testing.T) {
+	source := listSource{"id1": []byte("pp1"), "nas2": []byte("pp2"), "nas2@tank": []byte("pp3")}
+	waiting := []*pbgrpcv1.SecretStatus{newSecretStatus("default", false)}
+
+	tests := []struct {
+		name    string
+		machine *pbgrpcv1.Machine
+		approve bool
+		want    string
+	}{
+		{name: "by ID", machine: pbgrpcv1.Machine_builder{Id: proto.String("id1"), Secrets: waiting}.Build(), want: "submit default"},
+		{
+			name: "named secrets",
+			machine: pbgrpcv1.Machine_builder{Id: proto.String("id2"), Name: proto.String("nas2"), Secrets: []*pbgrpcv1.SecretStatus{
+				newSecretStatus("root", true), newSecretStatus("tank", false), newSecretStatus("backup", false),
+			}}.Build(),
+			want: "submit tank, no passphrase for backup",
+		},
+		{
+			name:    "approve",
+			machine: pbgrpcv1.Machine_builder{Id: proto.String("id1"), Secrets: waiting, RequiredApprovals: proto.Int32(1)}.Build(),
+			approve: true,
+			want:    "submit default, approve",
+		},
+		{
+			name: "already approved",
+			machine: pbgrpcv1.Machine_builder{
+				Id: proto.String("id1"), Secrets: waiting, RequiredApprovals: proto.Int32(1), Approvals: []*pbgrpcv1.Approval{{}},
+			}.Build(),
+			approve: true,
+			want:    "submit default",
+		},
+		{
+			name: "already submitted",
+			machine: pbgrpcv1.Machine_builder{
+				Id: proto.String("id3"), Secrets: []*pbgrpcv1.SecretStatus{newSecretStatus("default", true)}, RequiredApprovals: proto.Int32(1),
+			}.Build(),
+			approve: true,
+			want:    "approve",
+		},
+		{
+			name:    "nothing to do",
+			machine: pbgrpcv1.Machine_builder{Id: proto.String("id3"), Secrets: []*pbgrpcv1.SecretStatus{newSecretStatus("default", true)}}.Build(),
+			want:    "skip: keys already submitted",
+		},
+		{
+			name:    "no passphrase",
+			machine: pbgrpcv1.Machine_builder{Id: proto.String("id3"), Name: proto.String("web1"), Secrets: waiting}.Build(),
+			want:    "skip: no passphrase for default",
+		},
+		{
+			name:    "split key",
+			machine: pbgrpcv1.Machine_builder{Id: proto.String("id1"), Secrets: waiting, ShareThreshold: proto.Int32(2)}.Build(),
+			want:    "skip: key is split into shares",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions, err := planBulkApprove([]*pbgrpcv1.Machine{tt.machine}, source, tt.approve)
+			if err != nil {
+				t.Fatalf("planBulkApprove() error = %v", err)
+			}
+			if len(actions) != 1 {
+				t.Fatalf("planBulkApprove() returned %d actions, want 1", len(actions))
+			}
+			if got := actions[0].String(); got != tt.want {
+				t.Errorf("planBulkApprove() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"embed"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/internal/server"
+	"git.rgst.io/homelab/klefki/internal/unlockconfig"
+	"git.rgst.io/homelab/klefki/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+// bundleFS contains the files of initramfs integration bundles.
+//
+//go:embed bundle
+var bundleFS embed.FS
+
+// bundleFile is a file in an initramfs integration bundle.
+type bundleFile struct {
+	path     string
+	mode     fs.FileMode
+	contents []byte
+}
+
+// bundleData is the data the templates of a bundle are rendered with.
+type bundleData struct {
+	Name       string
+	ID         string
+	Servers    []string
+	LUKSName   string
+	LUKSDevice string
+
+	// HasKey is whether the bundle contains the private key of the
+	// machine, instead of using the one already on the host.
+	HasKey bool
+}
+
+// newBundleCommand creates a bundle [cobra.Command]
+func newBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle <machine>",
+		Short: "Generate an initramfs integration bundle for a known machine",
+		Long: "Generate an initramfs integration bundle for a known machine, containing\n" +
+			"the config of klefki-unlock, crypttab entries and the integrations for\n" +
+			"initramfs-tools and dracut. Written as a directory, or as a tarball if\n" +
+			"--output ends in .tar.gz.\n\n" +
+			"The private key of the machine is included if --priv-key is provided.\n" +
+			"Otherwise, the integrations use the key already on the host at\n" +
+			client.DefaultKeyPath + ", e.g., for machines that generated it themselves.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			serverFlags, err := flags.GetStringSlice("server")
+			if err != nil {
+				return err
+			}
+			threshold, err := flags.GetInt("threshold")
+			if err != nil {
+				return err
+			}
+			reportHardware, err := flags.GetBool("report-hardware")
+			if err != nil {
+				return err
+			}
+			if len(serverFlags) == 0 {
+				return fmt.Errorf("at least one --server is required")
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			var privKeyByt []byte
+			if privKeyPath := cmd.Flag("priv-key").Value.String(); privKeyPath != "" {
+				privKeyByt, err = os.ReadFile(privKeyPath)
+				if err != nil {
+					return err
+				}
+
+				pk, err := machines.DecodePrivateKey(privKeyByt)
+				if err != nil {
+					return err
+				}
+				if !pk.Public().(ed25519.PublicKey).Equal(ed25519.PublicKey(m.PublicKey)) {
+					return fmt.Errorf("--priv-key is not the private key of machine %s", m.ID)
+				}
+			}
+
+			identity, err := localIdentity()
+			if err != nil {
+				return err
+			}
+
+			cfg := unlockconfig.Config{Threshold: threshold, Key: client.DefaultKeyPath, ReportHardware: reportHardware}
+			data := bundleData{
+				Name:       m.Name,
+				ID:         m.ID,
+				LUKSName:   cmd.Flag("luks-name").Value.String(),
+				LUKSDevice: cmd.Flag("luks-device").Value.String(),
+				HasKey:     privKeyByt != nil,
+			}
+			for _, s := range serverFlags {
+				address, id, ok := strings.Cut(s, "=")
+				if !ok {
+					id = identity
+				}
+				cfg.Servers = append(cfg.Servers, unlockconfig.Server{Address: address, Identity: id})
+				data.Servers = append(data.Servers, address)
+			}
+
+			cfgByt, err := json.MarshalIndent(cfg, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode klefki-unlock config: %w", err)
+			}
+
+			files, err := bundleFiles(&data)
+			if err != nil {
+				return err
+			}
+			files = append(files, bundleFile{strings.TrimPrefix(unlockconfig.DefaultPath, "/"), 0o644, append(cfgByt, '\n')})
+			if privKeyByt != nil {
+				files = append(files, bundleFile{strings.TrimPrefix(client.DefaultKeyPath, "/"), 0o600, privKeyByt})
+			}
+
+			output := cmd.Flag("output").Value.String()
+			if output == "" {
+				output = "klefki-" + m.Name
+			}
+			if strings.HasSuffix(output, ".tar.gz") || strings.HasSuffix(output, ".tgz") {
+				err = writeBundleTarball(output, files)
+			} else {
+				err = writeBundleDir(output, files)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Wrote bundle to", output)
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("priv-key", "", "path to the private key of the machine to include, if it isn't on the host already")
+	flags.StringSlice("server", nil, "address of a klefki server for the machine to use, or address=identity for servers other than this one")
+	flags.Int("threshold", 0, "number of key shares required from --server to rebuild the key, if it's split across them")
+	flags.Bool("report-hardware", false, "report the hardware attributes of the machine")
+	flags.String("luks-name", "cryptroot", "name of the unlocked device in the crypttab entries")
+	flags.String("luks-device", "UUID=<uuid>", "LUKS device in the crypttab entries")
+	flags.StringP("output", "o", "", "directory, or .tar.gz file, to write the bundle to (default klefki-<name>)")
+	return cmd
+}
+
+// localIdentity returns the base64 encoded identity of the server
+// sharing the DB of klefkictl.
+func localIdentity() (string, error) {
+	b, err := os.ReadFile(server.IdentityPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("server identity not found at %s, start the server first", server.IdentityPath)
+		}
+		return "", fmt.Errorf("failed to read server identity: %w", err)
+	}
+
+	identity, err := machines.DecodePrivateKey(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(identity.Public().(ed25519.PublicKey)), nil
+}
+
+// bundleFiles renders the embedded files of a bundle.
+func bundleFiles(data *bundleData) ([]bundleFile, error) {
+	files := []struct {
+		src, dst string
+		mode     fs.FileMode
+	}{
+		{"README.md.tmpl", "README.md", 0o644},
+		{"crypttab.tmpl", "etc/crypttab.klefki", 0o644},
+		{"initramfs-tools-hook", "etc/initramfs-tools/hooks/klefki", 0o755},
+		{"initramfs-tools-premount", "etc/initramfs-tools/scripts/init-premount/klefki", 0o755},
+		{"dracut-module-setup.sh", "usr/lib/dracut/modules.d/90klefki/module-setup.sh", 0o755},
+		{"klefki-agent.service", "usr/lib/dracut/modules.d/90klefki/klefki-agent.service", 0o644},
+	}
+
+	rendered := make([]bundleFile, 0, len(files))
+	for _, f := range files {
+		contents, err := bundleFS.ReadFile("bundle/" + f.src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", f.src, err)
+		}
+
+		if strings.HasSuffix(f.src, ".tmpl") {
+			tmpl, err := template.New(f.src).Parse(string(contents))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", f.src, err)
+			}
+
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return nil, fmt.Errorf("failed to render %s: %w", f.src, err)
+			}
+			contents = buf.Bytes()
+		}
+		rendered = append(rendered, bundleFile{f.dst, f.mode, contents})
+	}
+	return rendered, nil
+}
+
+// writeBundleDir writes the provided files to a new directory at the
+// provided path.
+func writeBundleDir(path string, files []bundleFile) error {
+	if err := os.Mkdir(path, 0o700); err != nil {
+		return fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+
+	for _, f := range files {
+		dst := filepath.Join(path, filepath.FromSlash(f.path))
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", f.path, err)
+		}
+		if err := os.WriteFile(dst, f.contents, f.mode); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.path, err)
+		}
+		// WriteFile is subject to the umask.
+		if err := os.Chmod(dst, f.mode); err != nil {
+			return fmt.Errorf("failed to set permissions of %s: %w", f.path, err)
+		}
+	}
+	return nil
+}
+
+// writeBundleTarball writes the provided files to a new gzipped
+// tarball at the provided path.
+func writeBundleTarball(path string, files []bundleFile) (retErr error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = fmt.Errorf("failed to write bundle: %w", err)
+		}
+	}()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+	for _, bf := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     bf.path,
+			Mode:     int64(bf.mode),
+			Size:     int64(len(bf.contents)),
+			ModTime:  now,
+		}); err != nil {
+			return fmt.Errorf("failed to write %s: %w", bf.path, err)
+		}
+		if _, err := tw.Write(bf.contents); err != nil {
+			return fmt.Errorf("failed to write %s: %w", bf.path, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return nil
+}
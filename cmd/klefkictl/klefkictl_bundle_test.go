@@ -0,0 +1,74 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBundleFiles(t *testing.T) {
+	for _, hasKey := range []bool{true, false} {
+		files, err := bundleFiles(&bundleData{
+			Name:       "nas1",
+			ID:         "fingerprint",
+			Servers:    []string{"10.0.0.1:5300"},
+			LUKSName:   "cryptroot",
+			LUKSDevice: "UUID=1234",
+			HasKey:     hasKey,
+		})
+		if err != nil {
+			t.Fatalf("bundleFiles() error = %v", err)
+		}
+
+		rendered := make(map[string]string)
+		for _, f := range files {
+			rendered[f.path] = string(f.contents)
+		}
+		if !strings.Contains(rendered["etc/crypttab.klefki"], "cryptroot UUID=1234 /etc/klefki/unlock.json") {
+			t.Errorf("crypttab = %q, want the LUKS device", rendered["etc/crypttab.klefki"])
+		}
+
+		// Bundles without the key must not tell to delete it.
+		if got := strings.Contains(rendered["README.md"], "Delete this bundle"); got != hasKey {
+			t.Errorf("README mentions the included key = %v, want %v", got, hasKey)
+		}
+	}
+}
+
+func TestWriteBundleDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "bundle")
+	if err := writeBundleDir(dir, []bundleFile{{"etc/klefki/machine.key", 0o600, []byte("key")}}); err != nil {
+		t.Fatalf("writeBundleDir() error = %v", err)
+	}
+
+	fi, err := os.Stat(filepath.Join(dir, "etc", "klefki", "machine.key"))
+	if err != nil {
+		t.Fatalf("failed to stat key: %v", err)
+	}
+	if fi.Mode().Perm() != 0o600 {
+		t.Errorf("key mode = %v, want %v", fi.Mode().Perm(), os.FileMode(0o600))
+	}
+
+	// Existing bundles aren't overwritten.
+	if err := writeBundleDir(dir, nil); err == nil {
+		t.Error("writeBundleDir() error = nil for an existing directory, want error")
+	}
+}
@@ -21,14 +21,16 @@ import (
 	"fmt"
 
 	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/registry"
 	"github.com/spf13/cobra"
 )
 
 // newDeleteCommand creates a dekete [cobra.Command]
 func newDeleteCommand() *cobra.Command {
 	return &cobra.Command{
-		Use:   "delete <fingerprint>",
-		Short: "Delete a known machine by fingerprint",
+		Use:   "delete <machine>",
+		Short: "Delete a known machine by ID, name or fingerprint",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			dbc, err := db.New(cmd.Context())
@@ -37,7 +39,16 @@ func newDeleteCommand() *cobra.Command {
 			}
 			defer dbc.Close()
 
-			return dbc.Machine.DeleteOneID(args[0]).Exec(cmd.Context())
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			if _, err := dbc.MachineAlias.Delete().Where(machinealias.MachineID(m.ID)).
+				Exec(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to delete aliases: %w", err)
+			}
+			return dbc.Machine.DeleteOne(m).Exec(cmd.Context())
 		},
 	}
 }
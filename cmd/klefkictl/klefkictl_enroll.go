@@ -0,0 +1,249 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/enrollment"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newEnrollCommand creates an enroll [cobra.Command]
+func newEnrollCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enroll",
+		Short: "Manage machines enrolling themselves with one-time tokens",
+		Long: "Manage machines enrolling themselves with one-time tokens. Machines generate\n" +
+			"their own private key and enroll with a token through 'klefkictl requests\n" +
+			"enroll', so that the private key never leaves the machine.",
+	}
+	cmd.AddCommand(
+		newEnrollTokenCommand(),
+		newEnrollListCommand(),
+		newEnrollDeleteCommand(),
+		newEnrollPendingCommand(),
+		newEnrollConfirmCommand(),
+	)
+	return cmd
+}
+
+// newEnrollTokenCommand creates an enroll token [cobra.Command]
+func newEnrollTokenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token <machineName>",
+		Short: "Create a one-time token for a new machine to enroll itself with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			ttl, err := flags.GetDuration("ttl")
+			if err != nil {
+				return err
+			}
+			confirm, err := flags.GetBool("confirm")
+			if err != nil {
+				return err
+			}
+			labels, err := flags.GetStringToString("label")
+			if err != nil {
+				return err
+			}
+			requiredApprovals, err := flags.GetInt("required-approvals")
+			if err != nil {
+				return err
+			}
+			if ttl <= 0 {
+				return fmt.Errorf("--ttl must be positive")
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			exists, err := dbc.Machine.Query().Where(machine.Name(args[0])).Exist(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if exists {
+				return fmt.Errorf("machine %q already exists", args[0])
+			}
+
+			token, err := enrollment.CreateToken(cmd.Context(), dbc, args[0], ttl, &enrollment.Options{
+				Labels:              labels,
+				RequiredApprovals:   requiredApprovals,
+				RequireConfirmation: confirm,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Token:", token)
+			fmt.Println("Expires:", time.Now().Add(ttl).Local().Format(time.RFC3339))
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.Duration("ttl", time.Hour, "how long the token can be used for")
+	flags.Bool("confirm", false, "require the machine to be confirmed through 'enroll confirm' before it can request keys")
+	flags.StringToString("label", nil, "labels of the machine, used by policies (e.g., --label role=storage)")
+	flags.Int("required-approvals", 0, "number of distinct operators that must approve a session before its key is released")
+	return cmd
+}
+
+// newEnrollListCommand creates an enroll list [cobra.Command]
+func newEnrollListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all enrollment tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			ts, err := dbc.EnrollmentToken.Query().All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(ts) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			now := time.Now()
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "ID\tMACHINE\tEXPIRES\tCONFIRM\tSTATUS\n")
+			for _, t := range ts {
+				status := "unused"
+				switch {
+				case t.UsedAt != nil:
+					status = "used by " + t.MachineID
+				case now.After(t.ExpiresAt):
+					status = "expired"
+				}
+
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%t\t%s\n", t.ID, t.MachineName,
+					t.ExpiresAt.Local().Format(time.RFC3339), t.RequireConfirmation, status)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newEnrollDeleteCommand creates an enroll delete [cobra.Command]
+func newEnrollDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an enrollment token, revoking it if unused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid token ID %q: %w", args[0], err)
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			return dbc.EnrollmentToken.DeleteOneID(id).Exec(cmd.Context())
+		},
+	}
+}
+
+// newEnrollPendingCommand creates an enroll pending [cobra.Command]
+func newEnrollPendingCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending",
+		Short: "List machines that enrolled themselves and await confirmation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			ms, err := dbc.Machine.Query().Where(machine.PendingConfirmation(true)).All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(ms) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "ID\tFINGERPRINT\tNAME\tLABELS\n")
+			for _, m := range ms {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", m.ID, m.Fingerprint, m.Name, formatLabels(m.Labels))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newEnrollConfirmCommand creates an enroll confirm [cobra.Command]
+func newEnrollConfirmCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "confirm <machine>",
+		Short: "Confirm a machine that enrolled itself, allowing it to request keys",
+		Long: "Confirm a machine that enrolled itself, allowing it to request keys. Compare\n" +
+			"the fingerprint with the one printed on the machine when it enrolled first.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+			if !m.PendingConfirmation {
+				return fmt.Errorf("machine %q is not awaiting confirmation", m.ID)
+			}
+
+			if err := m.Update().SetPendingConfirmation(false).Exec(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to write to DB: %w", err)
+			}
+
+			return audit.Record(cmd.Context(), dbc, &audit.Event{
+				Type:      audit.EventMachineConfirmed,
+				MachineID: m.ID,
+				Details:   fmt.Sprintf("confirmed %q", m.Name),
+			})
+		},
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/groups"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newGroupsCommand creates a groups [cobra.Command]
+func newGroupsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Manage named groups of machines, e.g., to submit their keys at once",
+		Long: "Manage named groups of machines, e.g., to submit their keys at once with\n" +
+			"'klefkictl requests bulkapprove'. Machines are in a group when added to it\n" +
+			"explicitly, or when their labels match the selector of the group.",
+	}
+	cmd.AddCommand(
+		newGroupsCreateCommand(),
+		newGroupsUpdateCommand(),
+		newGroupsListCommand(),
+		newGroupsShowCommand(),
+		newGroupsDeleteCommand(),
+	)
+	return cmd
+}
+
+// newGroupsCreateCommand creates a groups create [cobra.Command]
+func newGroupsCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			refs, err := flags.GetStringSlice("machine")
+			if err != nil {
+				return err
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			machineIDs, err := lookupMachineIDs(cmd, dbc, refs)
+			if err != nil {
+				return err
+			}
+
+			return dbc.MachineGroup.Create().SetName(args[0]).
+				SetDescription(cmd.Flag("description").Value.String()).
+				SetMachineIds(machineIDs).
+				SetSelector(cmd.Flag("selector").Value.String()).
+				Exec(cmd.Context())
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("description", "", "free-form description of the group")
+	flags.StringSlice("machine", nil, "IDs, names or fingerprints of the machines in the group")
+	flags.StringP("selector", "l", "", "label selector of the machines in the group (e.g., role=storage)")
+	return cmd
+}
+
+// newGroupsUpdateCommand creates a groups update [cobra.Command]
+func newGroupsUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <name>",
+		Short: "Update a group, e.g., adding or removing machines",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			addRefs, err := flags.GetStringSlice("add-machine")
+			if err != nil {
+				return err
+			}
+			removeRefs, err := flags.GetStringSlice("remove-machine")
+			if err != nil {
+				return err
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			g, err := groups.Get(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+			upd := g.Update()
+
+			if flags.Changed("description") {
+				upd.SetDescription(cmd.Flag("description").Value.String())
+			}
+			if flags.Changed("selector") {
+				upd.SetSelector(cmd.Flag("selector").Value.String())
+			}
+			if len(addRefs) != 0 || len(removeRefs) != 0 {
+				add, err := lookupMachineIDs(cmd, dbc, addRefs)
+				if err != nil {
+					return err
+				}
+				remove, err := lookupMachineIDs(cmd, dbc, removeRefs)
+				if err != nil {
+					return err
+				}
+
+				machineIDs := slices.DeleteFunc(slices.Clone(g.MachineIds), func(id string) bool {
+					return slices.Contains(remove, id)
+				})
+				for _, id := range add {
+					if !slices.Contains(machineIDs, id) {
+						machineIDs = append(machineIDs, id)
+					}
+				}
+				upd.SetMachineIds(machineIDs)
+			}
+
+			return upd.Exec(cmd.Context())
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("description", "", "free-form description of the group")
+	flags.StringSlice("add-machine", nil, "IDs, names or fingerprints of machines to add to the group")
+	flags.StringSlice("remove-machine", nil, "IDs, names or fingerprints of machines to remove from the group")
+	flags.StringP("selector", "l", "", "label selector of the machines in the group, empty to remove it")
+	return cmd
+}
+
+// newGroupsListCommand creates a groups list [cobra.Command]
+func newGroupsListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			gs, err := dbc.MachineGroup.Query().Order(ent.Asc(machinegroup.FieldName)).All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(gs) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "NAME\tMEMBERS\tSELECTOR\tDESCRIPTION\n")
+			for _, g := range gs {
+				members, err := groups.Members(cmd.Context(), dbc, g)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", g.Name, len(members), g.Selector, g.Description)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newGroupsShowCommand creates a groups show [cobra.Command]
+func newGroupsShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <name>",
+		Short: "Show the machines in a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			g, err := groups.Get(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			members, err := groups.Members(cmd.Context(), dbc, g)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Name:", g.Name)
+			fmt.Println("Description:", g.Description)
+			fmt.Println("Machines:", strings.Join(g.MachineIds, ","))
+			fmt.Println("Selector:", g.Selector)
+			fmt.Println("Members:")
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "  NAME\tID\tSTATE\tLABELS\n")
+			for _, m := range members {
+				fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", m.Name, m.ID, m.State, formatLabels(m.Labels))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newGroupsDeleteCommand creates a groups delete [cobra.Command]
+func newGroupsDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a group by name, keeping its machines",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			deleted, err := dbc.MachineGroup.Delete().
+				Where(machinegroup.Name(args[0])).Exec(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if deleted == 0 {
+				return fmt.Errorf("group %q not found", args[0])
+			}
+			return nil
+		},
+	}
+}
+
+// lookupMachineIDs returns the IDs of the machines with the provided
+// IDs, names or fingerprints.
+func lookupMachineIDs(cmd *cobra.Command, dbc *ent.Client, refs []string) ([]string, error) {
+	machineIDs := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		m, err := registry.Lookup(cmd.Context(), dbc, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get machine %q: %w", ref, err)
+		}
+		machineIDs = append(machineIDs, m.ID)
+	}
+	return machineIDs, nil
+}
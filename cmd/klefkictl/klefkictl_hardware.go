@@ -0,0 +1,130 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/hardware"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newHardwareCommand creates a hardware [cobra.Command]
+func newHardwareCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hardware",
+		Short: "Manage the hardware attributes machines are bound to",
+		Long: "Manage the hardware attributes machines are bound to. Machines are bound to\n" +
+			"the hardware attributes they first report. Mismatching attributes reported\n" +
+			"later are kept as pending, until accepted by an operator.",
+	}
+	cmd.AddCommand(
+		newHardwareShowCommand(),
+		newHardwareAcceptCommand(),
+		newHardwareResetCommand(),
+	)
+	return cmd
+}
+
+// newHardwareShowCommand creates a hardware show [cobra.Command]
+func newHardwareShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <machine>",
+		Short: "Show the bound and pending hardware attributes of a known machine",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Binding:", m.HardwareBinding)
+			fmt.Println("Bound:", hardware.Format(m.Hardware))
+			fmt.Println("Pending:", hardware.Format(m.PendingHardware))
+			return nil
+		},
+	}
+}
+
+// newHardwareAcceptCommand creates a hardware accept [cobra.Command]
+func newHardwareAcceptCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept <machine>",
+		Short: "Bind a known machine to its pending hardware attributes, e.g., after replacing a part",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+			if len(m.PendingHardware) == 0 {
+				return fmt.Errorf("machine %q has no pending hardware attributes", m.ID)
+			}
+
+			if err := m.Update().SetHardware(m.PendingHardware).ClearPendingHardware().
+				Exec(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to write to DB: %w", err)
+			}
+
+			return audit.Record(cmd.Context(), dbc, &audit.Event{
+				Type:      audit.EventHardwareAccepted,
+				MachineID: m.ID,
+				Details: fmt.Sprintf("accepted hardware attributes %s, replacing %s",
+					hardware.Format(m.PendingHardware), hardware.Format(m.Hardware)),
+			})
+		},
+	}
+}
+
+// newHardwareResetCommand creates a hardware reset [cobra.Command]
+func newHardwareResetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset <machine>",
+		Short: "Unbind a known machine from its hardware, binding it to what it reports next",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			return m.Update().ClearHardware().ClearPendingHardware().Exec(cmd.Context())
+		},
+	}
+}
@@ -20,46 +20,117 @@ package main
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/labels"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/internal/secrets"
 	"github.com/spf13/cobra"
 )
 
 // newListCommand creates a list [cobra.Command]
 func newListCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all known machines",
-		Args:  cobra.NoArgs,
+		Long: "List all known machines, optionally only those whose labels match the\n" +
+			"provided selector. Selectors are comma separated requirements, e.g.,\n" +
+			"'role=storage,zone in (a,b),!decommissioned'.",
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			selector, err := labels.ParseSelector(cmd.Flag("selector").Value.String())
+			if err != nil {
+				return err
+			}
+
 			dbc, err := db.New(cmd.Context())
 			if err != nil {
 				return fmt.Errorf("failed to open DB: %w", err)
 			}
 			defer dbc.Close()
 
-			ms, err := dbc.Machine.Query().All(cmd.Context())
+			ms, err := dbc.Machine.Query().Order(ent.Asc(machine.FieldName)).All(cmd.Context())
 			if err != nil {
 				return err
 			}
+			ms = slices.DeleteFunc(ms, func(m *ent.Machine) bool { return !selector.Matches(m.Labels) })
 			if len(ms) == 0 {
 				fmt.Println("No results found")
 				return nil
 			}
 
 			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
-			fmt.Fprint(tw, "FINGERPRINT\tCREATED AT\n")
+			fmt.Fprint(tw, "NAME\tID\tFINGERPRINT\tSTATE\tCREATED AT\tLAST ASKED\tLAST UNLOCKED\tEXPIRES\tLABELS\n")
 			for _, m := range ms {
-				createdAt, err := time.Parse(time.RFC3339, m.CreatedAt)
-				if err != nil {
-					return fmt.Errorf("failed to parse created_at (%s): %w", m.CreatedAt, err)
-				}
-
-				fmt.Fprintf(tw, "%s\t%s\n", m.ID, createdAt.Local())
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", m.Name, m.ID, m.Fingerprint, m.State,
+					formatTime(&m.CreatedAt), formatTime(m.LastAskedAt), formatTime(m.LastUnlockedAt),
+					formatTime(m.ExpiresAt), formatLabels(m.Labels))
 			}
 			return tw.Flush()
 		},
 	}
+	cmd.Flags().StringP("selector", "l", "", "only list machines whose labels match this selector (e.g., role=storage)")
+	return cmd
+}
+
+// newShowCommand creates a show [cobra.Command]
+func newShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <machine>",
+		Short: "Show a known machine by ID, name or fingerprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Name:", m.Name)
+			fmt.Println("ID:", m.ID)
+			fmt.Println("Fingerprint:", m.Fingerprint)
+			fmt.Println("Description:", m.Description)
+			fmt.Println("State:", m.State)
+			fmt.Println("Labels:", formatLabels(m.Labels))
+			fmt.Println("Secrets:", strings.Join(secrets.Names(m.Secrets), ","))
+			fmt.Println("Created At:", formatTime(&m.CreatedAt))
+			fmt.Println("Last Asked:", formatTime(m.LastAskedAt))
+			fmt.Println("Last Unlocked:", formatTime(m.LastUnlockedAt))
+			fmt.Println("Expires:", formatTime(m.ExpiresAt))
+			fmt.Println("Required Approvals:", m.RequiredApprovals)
+			fmt.Println("Auto Unlock:", m.AutoUnlock)
+			return nil
+		},
+	}
+}
+
+// formatTime formats the provided time in the local time zone, or as
+// never if not set.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.Local().Format(time.RFC3339)
+}
+
+// formatLabels formats the provided labels as a sorted, comma separated,
+// list of key=value pairs.
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
 }
@@ -0,0 +1,188 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+	"git.rgst.io/homelab/klefki/internal/labels"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/internal/windows"
+	"github.com/spf13/cobra"
+)
+
+// newWindowsCommand creates a windows [cobra.Command]
+func newWindowsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "windows",
+		Short: "Manage maintenance windows during which keys are released without approval",
+	}
+	cmd.AddCommand(
+		newWindowsCreateCommand(),
+		newWindowsListCommand(),
+		newWindowsDeleteCommand(),
+	)
+	return cmd
+}
+
+// newWindowsCreateCommand creates a windows create [cobra.Command]
+func newWindowsCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new maintenance window",
+		Long: "Create a new maintenance window. While the window is open, the stored or\n" +
+			"submitted keys of the machines it applies to are released without approval.\n\n" +
+			"The schedule is a standard cron expression of when the window opens, e.g.,\n" +
+			"'0 2 * * SUN' for 02:00 every Sunday. A time zone can be set by prefixing it\n" +
+			"with 'CRON_TZ=Europe/Berlin ', otherwise the time zone of the server is used.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			schedule, err := flags.GetString("schedule")
+			if err != nil {
+				return err
+			}
+			duration, err := flags.GetDuration("duration")
+			if err != nil {
+				return err
+			}
+			machineIDs, err := flags.GetStringSlice("machine")
+			if err != nil {
+				return err
+			}
+			selector, err := flags.GetString("selector")
+			if err != nil {
+				return err
+			}
+
+			if _, err := windows.ParseSchedule(schedule); err != nil {
+				return err
+			}
+			if duration < time.Second {
+				return fmt.Errorf("--duration must be at least a second")
+			}
+			if len(machineIDs) == 0 && selector == "" {
+				return fmt.Errorf("at least one --machine or a --selector is required")
+			}
+			if _, err := labels.ParseSelector(selector); err != nil {
+				return err
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			for i, id := range machineIDs {
+				m, err := registry.Lookup(cmd.Context(), dbc, id)
+				if err != nil {
+					return fmt.Errorf("failed to get machine %q: %w", id, err)
+				}
+				machineIDs[i] = m.ID
+			}
+
+			return dbc.MaintenanceWindow.Create().SetName(args[0]).
+				SetSchedule(schedule).SetDuration(int64(duration.Seconds())).
+				SetMachineIds(machineIDs).SetSelector(selector).
+				Exec(cmd.Context())
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("schedule", "", "cron expression of when the window opens")
+	flags.Duration("duration", time.Hour, "how long the window stays open for")
+	flags.StringSlice("machine", nil, "IDs, names or fingerprints of the machines the window applies to")
+	flags.String("selector", "", "label selector of the machines the window applies to, e.g., 'role=storage,env in (prod)'")
+	return cmd
+}
+
+// newWindowsListCommand creates a windows list [cobra.Command]
+func newWindowsListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all maintenance windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			ws, err := dbc.MaintenanceWindow.Query().All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(ws) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			now := time.Now()
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "NAME\tSCHEDULE\tDURATION\tMACHINES\tSELECTOR\tSTATUS\n")
+			for _, w := range ws {
+				status := "closed"
+				if open, err := windows.IsOpen(w, now); err != nil {
+					status = "invalid: " + err.Error()
+				} else if open {
+					status = "open"
+				} else if next, err := windows.NextOpen(w, now); err == nil {
+					status = "opens " + next.Local().Format(time.RFC3339)
+				}
+
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", w.Name, w.Schedule,
+					time.Duration(w.Duration)*time.Second, strings.Join(w.MachineIds, ","),
+					w.Selector, status)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newWindowsDeleteCommand creates a windows delete [cobra.Command]
+func newWindowsDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a maintenance window by name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			deleted, err := dbc.MaintenanceWindow.Delete().
+				Where(maintenancewindow.Name(args[0])).Exec(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if deleted == 0 {
+				return fmt.Errorf("maintenance window %q not found", args[0])
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+// newMeasurementsCommand creates a measurements [cobra.Command]
+func newMeasurementsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "measurements",
+		Short: "Manage the allowlist of known-good boot measurements of machines",
+		Long: "Manage the allowlist of known-good boot measurements of machines. Machines\n" +
+			"with at least one allowed set of measurements must report matching\n" +
+			"measurements of what they booted to receive their key.",
+	}
+	cmd.AddCommand(
+		newMeasurementsAddCommand(),
+		newMeasurementsListCommand(),
+		newMeasurementsDeleteCommand(),
+		newMeasurementsComputeCommand(),
+	)
+	return cmd
+}
+
+// newMeasurementsAddCommand creates a measurements add [cobra.Command]
+func newMeasurementsAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <machine>",
+		Short: "Allow a set of boot measurements for a known machine",
+		Long: "Allow a set of boot measurements for a known machine. Measurements are hex\n" +
+			"encoded SHA256 hashes, as printed by 'klefkictl measurements compute'.\n" +
+			"Measurements that aren't set match anything.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			kernel := cmd.Flag("kernel").Value.String()
+			initramfs := cmd.Flag("initramfs").Value.String()
+			cmdline := cmd.Flag("cmdline").Value.String()
+			if kernel == "" && initramfs == "" && cmdline == "" {
+				return fmt.Errorf("at least one of --kernel, --initramfs or --cmdline is required")
+			}
+			description, err := flags.GetString("description")
+			if err != nil {
+				return err
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			bm, err := dbc.BootMeasurement.Create().SetMachineID(m.ID).
+				SetKernel(kernel).SetInitramfs(initramfs).SetCmdline(cmdline).
+				SetDescription(description).
+				Save(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to write to DB: %w", err)
+			}
+
+			fmt.Println("ID:", bm.ID)
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("kernel", "", "SHA256 of the kernel")
+	flags.String("initramfs", "", "SHA256 of the initramfs")
+	flags.String("cmdline", "", "SHA256 of the kernel command line")
+	flags.String("description", "", "description of the measurements (e.g., kernel version)")
+	return cmd
+}
+
+// newMeasurementsListCommand creates a measurements list [cobra.Command]
+func newMeasurementsListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [machine]",
+		Short: "List allowed boot measurements, optionally only of a known machine",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			q := dbc.BootMeasurement.Query()
+			if len(args) == 1 {
+				m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+				if err != nil {
+					return err
+				}
+				q.Where(bootmeasurement.MachineID(m.ID))
+			}
+
+			bms, err := q.All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(bms) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "ID\tMACHINE\tKERNEL\tINITRAMFS\tCMDLINE\tDESCRIPTION\tCREATED AT\n")
+			for _, bm := range bms {
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", bm.ID, bm.MachineID,
+					orAny(bm.Kernel), orAny(bm.Initramfs), orAny(bm.Cmdline),
+					bm.Description, bm.CreatedAt.Local().Format(time.RFC3339))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newMeasurementsDeleteCommand creates a measurements delete
+// [cobra.Command]
+func newMeasurementsDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete allowed boot measurements by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID %q: %w", args[0], err)
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			return dbc.BootMeasurement.DeleteOneID(id).Exec(cmd.Context())
+		},
+	}
+}
+
+// newMeasurementsComputeCommand creates a measurements compute
+// [cobra.Command]
+func newMeasurementsComputeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compute",
+		Short: "Compute boot measurements to allow from a kernel, initramfs and command line",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			bm, err := client.MeasureBoot(cmd.Flag("kernel").Value.String(),
+				cmd.Flag("initramfs").Value.String(), "")
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("cmdline") {
+				bm.SetCmdline(client.HashCmdline(cmd.Flag("cmdline").Value.String()))
+			}
+
+			fmt.Println("Kernel:", orAny(bm.GetKernel()))
+			fmt.Println("Initramfs:", orAny(bm.GetInitramfs()))
+			fmt.Println("Cmdline:", orAny(bm.GetCmdline()))
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("kernel", "", "path to the kernel")
+	flags.String("initramfs", "", "path to the initramfs")
+	flags.String("cmdline", "", "kernel command line, as in /proc/cmdline")
+	return cmd
+}
+
+// orAny returns s, or "*" if it is empty.
+func orAny(s string) string {
+	if s == "" {
+		return "*"
+	}
+	return s
+}
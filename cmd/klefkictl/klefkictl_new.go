@@ -19,16 +19,19 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
 	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/registry"
 	"github.com/spf13/cobra"
 )
 
 // newNewCommand creates a new [cobra.Command]
 func newNewCommand() *cobra.Command {
-	// TODO(jaredallard): Support setting the name of the machine.
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "new <machineName>",
 		Short: "Create a new machine",
 		Args:  cobra.ExactArgs(1),
@@ -41,31 +44,122 @@ func newNewCommand() *cobra.Command {
 			}
 			defer dbc.Close()
 
-			m, err := machines.NewMachine()
+			flags := cmd.Flags()
+			requiredApprovals, err := flags.GetInt("required-approvals")
 			if err != nil {
 				return err
 			}
+			shareThreshold, err := flags.GetInt("share-threshold")
+			if err != nil {
+				return err
+			}
+			shareIndex, err := flags.GetInt("share-index")
+			if err != nil {
+				return err
+			}
+			autoUnlock, err := flags.GetBool("auto-unlock")
+			if err != nil {
+				return err
+			}
+			labels, err := flags.GetStringToString("label")
+			if err != nil {
+				return err
+			}
+			hardwareBinding, err := flags.GetString("hardware-binding")
+			if err != nil {
+				return err
+			}
+			secretNames, err := flags.GetStringSlice("secret")
+			if err != nil {
+				return err
+			}
+			var expiresAt *time.Time
+			if flags.Changed("expires-at") {
+				t, err := time.Parse(time.RFC3339, cmd.Flag("expires-at").Value.String())
+				if err != nil {
+					return fmt.Errorf("failed to parse --expires-at: %w", err)
+				}
+				expiresAt = &t
+			}
+			if shareThreshold > 0 && shareIndex > 0 {
+				return fmt.Errorf("--share-threshold and --share-index are mutually exclusive")
+			}
+
+			// Machines enrolled with multiple servers share the same key,
+			// so allow using the public key of an existing machine.
+			var m *machines.Machine
+			if pubKeyPath := cmd.Flag("public-key").Value.String(); pubKeyPath != "" {
+				pubKeyByt, err := os.ReadFile(pubKeyPath)
+				if err != nil {
+					return err
+				}
+
+				pubKey, err := machines.DecodePublicKey(pubKeyByt)
+				if err != nil {
+					return err
+				}
+				m = &machines.Machine{PublicKey: pubKey}
+			} else {
+				m, err = machines.NewMachine()
+				if err != nil {
+					return err
+				}
+			}
 
 			fprint, err := m.Fingerprint()
 			if err != nil {
 				return err
 			}
 
-			privKey, err := m.EncodePrivateKey()
+			pubKey, err := m.EncodePublicKey()
 			if err != nil {
 				return err
 			}
 
+			id := registry.NewID()
 			if err := dbc.Machine.Create().SetName(name).
-				SetID(fprint).SetPublicKey(m.PublicKey).
+				SetID(id).SetPublicKey(m.PublicKey).SetFingerprint(fprint).
+				SetRequiredApprovals(requiredApprovals).
+				SetShareThreshold(shareThreshold).
+				SetShareIndex(shareIndex).
+				SetAutoUnlock(autoUnlock).
+				SetLabels(labels).
+				SetHardwareBinding(machine.HardwareBinding(hardwareBinding)).
+				SetNillableExpiresAt(expiresAt).
+				SetDescription(cmd.Flag("description").Value.String()).
+				SetSecrets(secretNames).
 				Exec(cmd.Context()); err != nil {
 				return fmt.Errorf("failed to write to DB: %w", err)
 			}
 
+			fmt.Println("ID:", id)
 			fmt.Println("Fingerprint:", fprint)
+			fmt.Println("Public Key:")
+			fmt.Println(pubKey)
+			if m.PrivateKey == nil {
+				return nil
+			}
+
+			privKey, err := m.EncodePrivateKey()
+			if err != nil {
+				return err
+			}
+
 			fmt.Println("Private Key:")
 			fmt.Println(privKey)
 			return nil
 		},
 	}
+	flags := cmd.Flags()
+	flags.Int("required-approvals", 0, "number of distinct operators that must approve a session before its key is released")
+	flags.Int("share-threshold", 0, "number of passphrase shares required to unlock, if the passphrase is split")
+	flags.Int("share-index", 0, "index of the passphrase share held by this server, if the passphrase is distributed across servers")
+	flags.Bool("auto-unlock", false, "release the key stored in the vault for this machine without an operator submitting it")
+	flags.StringToString("label", nil, "labels of the machine, used by policies (e.g., --label role=storage)")
+	flags.String("hardware-binding", string(machine.HardwareBindingWarn), "what happens when the reported hardware doesn't match, one of warn or enforce")
+	flags.String("description", "", "free-form description of the machine")
+	flags.StringSlice("secret", nil, "names of the secrets of the machine (e.g., --secret root,tank), the first being its default one")
+	flags.String("expires-at", "", "when the machine stops being able to request keys, in RFC3339 (e.g., 2027-01-01T00:00:00Z)")
+	flags.String("public-key", "", "path to the public key of an existing machine to use instead of generating a new one")
+	return cmd
 }
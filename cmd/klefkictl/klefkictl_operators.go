@@ -0,0 +1,137 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	"github.com/spf13/cobra"
+)
+
+// newOperatorsCommand creates an operators [cobra.Command]
+func newOperatorsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operators",
+		Short: "Manage operators that can approve key releases",
+	}
+	cmd.AddCommand(
+		newOperatorsNewCommand(),
+		newOperatorsListCommand(),
+		newOperatorsDeleteCommand(),
+	)
+	return cmd
+}
+
+// newOperatorsNewCommand creates an operators new [cobra.Command]
+func newOperatorsNewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "new <operatorName>",
+		Short: "Create a new operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0] // Checked by [cobra.ExactArgs] above.
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			o, err := operators.NewOperator()
+			if err != nil {
+				return err
+			}
+
+			fprint, err := o.Fingerprint()
+			if err != nil {
+				return err
+			}
+
+			privKey, err := o.EncodePrivateKey()
+			if err != nil {
+				return err
+			}
+
+			if err := dbc.Operator.Create().SetName(name).
+				SetID(fprint).SetPublicKey(o.PublicKey).
+				Exec(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to write to DB: %w", err)
+			}
+
+			fmt.Println("Fingerprint:", fprint)
+			fmt.Println("Private Key:")
+			fmt.Println(privKey)
+			return nil
+		},
+	}
+}
+
+// newOperatorsListCommand creates an operators list [cobra.Command]
+func newOperatorsListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all known operators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			ops, err := dbc.Operator.Query().All(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if len(ops) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "FINGERPRINT\tNAME\tCREATED AT\n")
+			for _, o := range ops {
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", o.ID, o.Name, o.CreatedAt.Local().Format(time.RFC3339))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newOperatorsDeleteCommand creates an operators delete [cobra.Command]
+func newOperatorsDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <fingerprint>",
+		Short: "Delete a known operator by fingerprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			return dbc.Operator.DeleteOneID(args[0]).Exec(cmd.Context())
+		},
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/internal/secrets"
+	"github.com/spf13/cobra"
+)
+
+// newPassphrasesCommand creates a passphrases [cobra.Command]
+func newPassphrasesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "passphrases",
+		Short: "Manage the local passphrase store used to submit keys",
+		Long: "Manage the local passphrase store, which holds the passphrases of machines by\n" +
+			"fingerprint, encrypted with age to a password. 'klefkictl requests submitkey'\n" +
+			"and 'bulkapprove' read passphrases from it, asking for its password once.",
+	}
+	cmd.AddCommand(
+		newPassphrasesInitCommand(),
+		newPassphrasesSetCommand(),
+		newPassphrasesListCommand(),
+		newPassphrasesRemoveCommand(),
+	)
+	flags := cmd.PersistentFlags()
+	flags.String("store", "", "path to the passphrase store (default $XDG_CONFIG_HOME/klefki/passphrases.age)")
+	return cmd
+}
+
+// storePathFromFlags returns the path of the passphrase store provided
+// through the --store flag, or the default one.
+func storePathFromFlags(cmd *cobra.Command) (string, error) {
+	if path := cmd.Flag("store").Value.String(); path != "" {
+		return path, nil
+	}
+	return defaultStorePath()
+}
+
+// newPassphrasesInitCommand creates a passphrases init [cobra.Command]
+func newPassphrasesInitCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "init",
+		Short: "Create a new, empty, passphrase store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := storePathFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("passphrase store %s already exists", path)
+			}
+
+			password, err := promptTTY("New password for passphrase store: ")
+			if err != nil {
+				return err
+			}
+			defer clear(password)
+
+			confirm, err := promptTTY("Confirm password: ")
+			if err != nil {
+				return err
+			}
+			defer clear(confirm)
+			if !bytes.Equal(password, confirm) {
+				return fmt.Errorf("passwords don't match")
+			}
+
+			ps := &passphraseStore{Machines: make(map[string]map[string][]byte)}
+			if err := ps.save(path, password); err != nil {
+				return err
+			}
+
+			fmt.Println("Created passphrase store", path)
+			return nil
+		},
+	}
+}
+
+// newPassphrasesSetCommand creates a passphrases set [cobra.Command]
+func newPassphrasesSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <machine>",
+		Short: "Store the passphrase of a known machine by its ID, name or fingerprint",
+		Long: "Store the passphrase of a secret of a known machine, replacing any stored one.\n" +
+			"The passphrase is prompted for, or read as is from --from-file.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, secret, err := lookupMachineSecret(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			path, err := storePathFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ps, password, err := unlockStore(path)
+			if err != nil {
+				return err
+			}
+			defer clear(password)
+
+			var passphrase []byte
+			if fromFile := cmd.Flag("from-file").Value.String(); fromFile != "" {
+				passphrase, err = readKeyFile(fromFile)
+			} else {
+				passphrase, err = promptTTY(fmt.Sprintf("Passphrase for secret %q of %s: ", secret, m.Name))
+			}
+			if err != nil {
+				return err
+			}
+			defer clear(passphrase)
+
+			if ps.Machines[m.Fingerprint] == nil {
+				ps.Machines[m.Fingerprint] = make(map[string][]byte)
+			}
+			ps.Machines[m.Fingerprint][secret] = passphrase
+			return ps.save(path, password)
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("secret", "", "name of the secret of the machine, instead of its default one")
+	flags.String("from-file", "", "path to a passphrase or keyfile to store as is, - for stdin")
+	return cmd
+}
+
+// newPassphrasesListCommand creates a passphrases list [cobra.Command]
+func newPassphrasesListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the machines and secrets with a stored passphrase",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := storePathFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ps, password, err := unlockStore(path)
+			if err != nil {
+				return err
+			}
+			clear(password)
+
+			fprints := make([]string, 0, len(ps.Machines))
+			for fprint := range ps.Machines {
+				fprints = append(fprints, fprint)
+			}
+			slices.Sort(fprints)
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "FINGERPRINT\tSECRETS\n")
+			for _, fprint := range fprints {
+				names := make([]string, 0, len(ps.Machines[fprint]))
+				for name := range ps.Machines[fprint] {
+					names = append(names, name)
+				}
+				slices.Sort(names)
+				fmt.Fprintf(tw, "%s\t%s\n", fprint, strings.Join(names, ","))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newPassphrasesRemoveCommand creates a passphrases remove [cobra.Command]
+func newPassphrasesRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <machine>",
+		Short: "Remove the stored passphrases of a known machine by its ID, name or fingerprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, secret, err := lookupMachineSecret(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			path, err := storePathFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ps, password, err := unlockStore(path)
+			if err != nil {
+				return err
+			}
+			defer clear(password)
+
+			// Without --secret, every secret of the machine is removed.
+			if cmd.Flags().Changed("secret") {
+				delete(ps.Machines[m.Fingerprint], secret)
+			}
+			if !cmd.Flags().Changed("secret") || len(ps.Machines[m.Fingerprint]) == 0 {
+				delete(ps.Machines, m.Fingerprint)
+			}
+			return ps.save(path, password)
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("secret", "", "name of the secret of the machine to remove, instead of all of them")
+	return cmd
+}
+
+// lookupMachineSecret returns the known machine with the provided ID,
+// name or fingerprint, and the name of its secret provided through the
+// --secret flag, or its default one.
+func lookupMachineSecret(cmd *cobra.Command, ref string) (*ent.Machine, string, error) {
+	dbc, err := db.New(cmd.Context())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open DB: %w", err)
+	}
+	defer dbc.Close()
+
+	m, err := registry.Lookup(cmd.Context(), dbc, ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := secrets.Resolve(m.Secrets, cmd.Flag("secret").Value.String())
+	if err != nil {
+		return nil, "", err
+	}
+	return m, secret, nil
+}
@@ -0,0 +1,363 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"git.rgst.io/homelab/klefki/internal/policy"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newPolicyCommand creates a policy [cobra.Command]
+func newPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Manage policies that decide what happens to key requests",
+		Long: "Manage policies that decide what happens to key requests. Policies are CEL\n" +
+			"expressions evaluated on every key request, in ascending priority. The first\n" +
+			"policy whose expression is true decides the request, otherwise the settings\n" +
+			"of the machine apply.\n\n" +
+			"Expressions have access to the following variables:\n\n" +
+			"  machine_id     string               ID of the machine\n" +
+			"  machine_name   string               name of the machine\n" +
+			"  labels         map(string, string)  labels of the machine\n" +
+			"  peer           string               IP address the request was made from\n" +
+			"  metadata       map(string, string)  gRPC metadata sent with the request\n" +
+			"  now            timestamp            time of the request\n" +
+			"  last_unlocked  timestamp            when a key was last released to the\n" +
+			"                                      machine, the unix epoch if never",
+	}
+	cmd.AddCommand(
+		newPolicyCreateCommand(),
+		newPolicyListCommand(),
+		newPolicyDeleteCommand(),
+		newPolicyTestCommand(),
+	)
+	return cmd
+}
+
+// newPolicyCreateCommand creates a policy create [cobra.Command]
+func newPolicyCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := policyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			return dbc.UnlockPolicy.Create().SetName(args[0]).
+				SetExpression(p.Expression).SetAction(p.Action).
+				SetRequiredApprovals(p.RequiredApprovals).SetPriority(p.Priority).
+				Exec(cmd.Context())
+		},
+	}
+	addPolicyFlags(cmd)
+	//nolint:errcheck // Why: Only fails if the flag doesn't exist.
+	cmd.MarkFlagRequired("action")
+	return cmd
+}
+
+// newPolicyListCommand creates a policy list [cobra.Command]
+func newPolicyListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all policies in the order they are evaluated",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			policies, err := policy.List(cmd.Context(), dbc)
+			if err != nil {
+				return err
+			}
+			if len(policies) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "PRIORITY\tNAME\tACTION\tEXPRESSION\n")
+			for _, p := range policies {
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", p.Priority, p.Name, describePolicyAction(p), p.Expression)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newPolicyDeleteCommand creates a policy delete [cobra.Command]
+func newPolicyDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a policy by name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			deleted, err := dbc.UnlockPolicy.Delete().Where(unlockpolicy.Name(args[0])).Exec(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if deleted == 0 {
+				return fmt.Errorf("policy %q not found", args[0])
+			}
+			return nil
+		},
+	}
+}
+
+// newPolicyTestCommand creates a policy test [cobra.Command]
+func newPolicyTestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test [requests.json]",
+		Short: "Dry-run policies against sample key requests",
+		Long: "Dry-run policies against sample key requests. Requests are read from a JSON\n" +
+			"file containing an array of objects with the machine_id, machine_name,\n" +
+			"labels, peer, metadata, now and last_unlocked keys. If no file is provided,\n" +
+			"a single request is built from the flags instead.\n\n" +
+			"The policies that have been created are tested, unless --expression is set,\n" +
+			"in which case only the policy described by the flags is.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			var policies []*ent.UnlockPolicy
+			if cmd.Flags().Changed("expression") {
+				p, err := policyFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				p.Name = "<flags>"
+				policies = []*ent.UnlockPolicy{p}
+			} else {
+				policies, err = policy.List(cmd.Context(), dbc)
+				if err != nil {
+					return err
+				}
+			}
+
+			var inputs []*policy.Input
+			if len(args) == 1 {
+				b, err := os.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+				if err := json.Unmarshal(b, &inputs); err != nil {
+					return fmt.Errorf("failed to parse requests: %w", err)
+				}
+			} else {
+				in, err := policyInputFromFlags(cmd, dbc)
+				if err != nil {
+					return err
+				}
+				inputs = []*policy.Input{in}
+			}
+
+			engine, err := policy.NewEngine()
+			if err != nil {
+				return err
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "REQUEST\tPOLICY\tACTION\n")
+			for i, in := range inputs {
+				if in.Now.IsZero() {
+					in.Now = time.Now()
+				}
+
+				name := in.MachineName
+				if name == "" {
+					name = in.MachineID
+				}
+				if name == "" {
+					name = fmt.Sprintf("#%d", i+1)
+				}
+
+				p, err := engine.Evaluate(policies, in)
+				if err != nil {
+					fmt.Fprintf(tw, "%s\t-\terror: %v\n", name, err)
+					continue
+				}
+				if p == nil {
+					fmt.Fprintf(tw, "%s\t-\tnone (machine settings apply)\n", name)
+					continue
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", name, p.Name, describePolicyAction(p))
+			}
+			return tw.Flush()
+		},
+	}
+	addPolicyFlags(cmd)
+	flags := cmd.Flags()
+	flags.String("machine", "", "ID, name or fingerprint of a known machine to take the name, labels and last unlock of")
+	flags.StringToString("label", nil, "labels of the machine making the request")
+	flags.String("peer", "", "IP address the request is made from")
+	flags.StringToString("metadata", nil, "gRPC metadata sent with the request")
+	flags.String("time", "", "time of the request in RFC3339, defaults to now")
+	flags.String("last-unlocked", "", "when a key was last released to the machine in RFC3339")
+	return cmd
+}
+
+// addPolicyFlags adds the flags describing a policy to the provided
+// command.
+func addPolicyFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.String("expression", "", "CEL expression that must be true for the policy to apply")
+	flags.String("action", "", "action to take when the policy applies, one of release, require_approvals or deny")
+	flags.Int("required-approvals", 0, "number of approvals required by the require_approvals action, at least 1")
+	flags.Int("priority", 0, "policies are evaluated in ascending priority, the first that applies wins")
+}
+
+// policyFromFlags creates a policy from the flags added by
+// [addPolicyFlags], validating its expression.
+func policyFromFlags(cmd *cobra.Command) (*ent.UnlockPolicy, error) {
+	flags := cmd.Flags()
+	expr, err := flags.GetString("expression")
+	if err != nil {
+		return nil, err
+	}
+	action, err := flags.GetString("action")
+	if err != nil {
+		return nil, err
+	}
+	requiredApprovals, err := flags.GetInt("required-approvals")
+	if err != nil {
+		return nil, err
+	}
+	priority, err := flags.GetInt("priority")
+	if err != nil {
+		return nil, err
+	}
+
+	if action == "" {
+		return nil, fmt.Errorf("--action is required")
+	}
+
+	p := &ent.UnlockPolicy{
+		Expression:        expr,
+		Action:            unlockpolicy.Action(action),
+		RequiredApprovals: requiredApprovals,
+		Priority:          priority,
+	}
+	if err := policy.Validate(p); err != nil {
+		return nil, err
+	}
+
+	engine, err := policy.NewEngine()
+	if err != nil {
+		return nil, err
+	}
+	if err := engine.Compile(expr); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// policyInputFromFlags creates a sample key request from the flags of
+// the policy test command.
+func policyInputFromFlags(cmd *cobra.Command, dbc *ent.Client) (*policy.Input, error) {
+	flags := cmd.Flags()
+	in := &policy.Input{}
+
+	if machineID := cmd.Flag("machine").Value.String(); machineID != "" {
+		m, err := registry.Lookup(cmd.Context(), dbc, machineID)
+		if err != nil {
+			return nil, err
+		}
+		in.MachineID = m.ID
+		in.MachineName = m.Name
+		in.Labels = m.Labels
+		if m.LastUnlockedAt != nil {
+			in.LastUnlocked = *m.LastUnlockedAt
+		}
+	}
+
+	if flags.Changed("label") {
+		labels, err := flags.GetStringToString("label")
+		if err != nil {
+			return nil, err
+		}
+		in.Labels = labels
+	}
+
+	metadata, err := flags.GetStringToString("metadata")
+	if err != nil {
+		return nil, err
+	}
+	in.Metadata = make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		// gRPC metadata keys are always lowercase.
+		in.Metadata[strings.ToLower(k)] = v
+	}
+	in.Peer = cmd.Flag("peer").Value.String()
+
+	if ts := cmd.Flag("time").Value.String(); ts != "" {
+		in.Now, err = time.Parse(time.RFC3339, ts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse --time: %w", err)
+		}
+	}
+	if ts := cmd.Flag("last-unlocked").Value.String(); ts != "" {
+		in.LastUnlocked, err = time.Parse(time.RFC3339, ts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse --last-unlocked: %w", err)
+		}
+	}
+
+	return in, nil
+}
+
+// describePolicyAction returns a human readable description of the
+// action of the provided policy.
+func describePolicyAction(p *ent.UnlockPolicy) string {
+	if p.Action == unlockpolicy.ActionRequireApprovals {
+		return fmt.Sprintf("%s (%d)", p.Action, p.RequiredApprovals)
+	}
+	return string(p.Action)
+}
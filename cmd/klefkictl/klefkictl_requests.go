@@ -19,37 +19,29 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
 	"git.rgst.io/homelab/klefki/pkg/client"
 
+	"git.rgst.io/homelab/klefki/internal/chunks"
 	"git.rgst.io/homelab/klefki/internal/machines"
-	"git.rgst.io/homelab/sigtool/v3/sign"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	"git.rgst.io/homelab/klefki/internal/shamir"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
 
-// nopWriteCloser is a no-op [io.WriteCloser]
-type nopWriteCloser struct {
-	io.Writer
-}
-
-// Close implements [io.Closer]
-func (nwc nopWriteCloser) Close() error {
-	return nil
-}
-
-// newNopWriteCloser creates a new nopWriteCloser
-func newNopWriteCloser(w io.Writer) *nopWriteCloser {
-	return &nopWriteCloser{w}
-}
-
 // newRequestsCommand creates a requests [cobra.Command]
 func newRequestsCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -60,89 +52,383 @@ func newRequestsCommand() *cobra.Command {
 		newGetKeyCommand(),
 		newListSessionsCommand(),
 		newSubmitKeyCommand(),
+		newSubmitShareCommand(),
+		newApproveCommand(),
+		newDenyCommand(),
+		newCancelCommand(),
+		newBulkApproveCommand(),
+		newIdentityCommand(),
+		newRequestEnrollCommand(),
+		newRotateKeyCommand(),
 	)
 	flags := cmd.Flags()
 	flags.String("hostname", "127.0.0.1:5300", "hostname of the klefki server to connect to")
 	return cmd
 }
 
-// newGetKeyCommand creates a getkeyrequest [cobra.Command]
-func newGetKeyCommand() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "getkey",
-		Short: "Get the passphrase for the given machine",
+// newIdentityCommand creates an identity [cobra.Command]
+func newIdentityCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "identity",
+		Short: "Print the identity of the server, to pin it on machines",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			privKeyPath := cmd.Flag("priv-key").Value.String()
-			hostname := cmd.Parent().Flag("hostname").Value.String()
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
 
-			privKeyByt, err := os.ReadFile(privKeyPath)
+			resp, err := kc.GetIdentity(cmd.Context(), &pbgrpcv1.GetIdentityRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get server identity: %w", err)
+			}
+
+			fprint, err := machines.Fingerprint(resp.GetPublicKey())
 			if err != nil {
 				return err
 			}
 
-			pk, err := machines.DecodePrivateKey(privKeyByt)
+			fmt.Println("Fingerprint:", fprint)
+			fmt.Println("Identity:", base64.StdEncoding.EncodeToString(resp.GetPublicKey()))
+			return nil
+		},
+	}
+}
+
+// newRequestEnrollCommand creates an enroll [cobra.Command]
+func newRequestEnrollCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enroll <token>",
+		Short: "Enroll this machine with an enrollment token",
+		Long: "Enroll this machine with an enrollment token created by 'klefkictl enroll\n" +
+			"token'. If the private key at --priv-key doesn't exist yet, it is generated.\n" +
+			"Only the public key is sent to the server.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			privKeyPath := cmd.Flag("priv-key").Value.String()
+			pk, err := client.ReadPrivateKey(privKeyPath)
+			if errors.Is(err, fs.ErrNotExist) {
+				pk, err = generatePrivateKey(privKeyPath)
+			}
 			if err != nil {
 				return err
 			}
 
-			machineID, err := machines.Fingerprint(pk.Public().(ed25519.PublicKey))
+			reportHardware, err := cmd.Flags().GetBool("report-hardware")
 			if err != nil {
-				return fmt.Errorf("failed to get fingerprint for key: %w", err)
+				return err
+			}
+			var hw *pbgrpcv1.HardwareAttributes
+			if reportHardware {
+				hw, err = client.ReadHardware()
+				if err != nil {
+					return err
+				}
 			}
 
-			spk, err := sign.PrivateKeyFromBytes(pk)
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
 			if err != nil {
-				return fmt.Errorf("failed to create private key for decryption: %w", err)
+				return err
 			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
 
-			kc, kcclose, err := client.Dial(hostname)
+			resp, err := client.Enroll(cmd.Context(), kc, pk, args[0], hw)
 			if err != nil {
 				return err
 			}
-			defer kcclose() //nolint:errcheck // Why: Btiest effort
 
-			tsResp, err := kc.GetTime(cmd.Context(), &pbgrpcv1.GetTimeRequest{})
+			fprint, err := machines.Fingerprint(pk.Public().(ed25519.PublicKey))
 			if err != nil {
-				return fmt.Errorf("failed to connect to server to get time: %w", err)
+				return err
 			}
 
-			req := &pbgrpcv1.GetKeyRequest{}
-			req.SetMachineId(machineID)
-			req.SetNonce(uuid.New().String())
-			req.SetSignedAt(tsResp.GetTime())
-			req.SetSignature(ed25519.Sign(pk, []byte(req.GetNonce())))
+			fmt.Println("ID:", resp.GetMachineId())
+			fmt.Println("Fingerprint:", fprint)
+			if resp.GetPendingConfirmation() {
+				fmt.Println("Enrolled, an operator must confirm the fingerprint before keys are released")
+			}
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("priv-key", client.DefaultKeyPath, "path to the private key of this machine, generated if it doesn't exist")
+	flags.Bool("report-hardware", false, "bind the machine to the hardware attributes of this machine")
+	return cmd
+}
 
-			resp, err := kc.GetKey(cmd.Context(), req)
+// newRotateKeyCommand creates a rotatekey [cobra.Command]
+func newRotateKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotatekey",
+		Short: "Replace the private key of this machine with a new one",
+		Long: "Replace the private key of this machine with a newly generated one, signed by\n" +
+			"the current one. The new key replaces the one at --priv-key once the server\n" +
+			"accepted it. The server keeps accepting the old key for a grace period, e.g.,\n" +
+			"until the initramfs containing it is rebuilt.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			privKeyPath := cmd.Flag("priv-key").Value.String()
+			pk, err := client.ReadPrivateKey(privKeyPath)
 			if err != nil {
-				return fmt.Errorf("failed to get key from server: %w", err)
+				return err
+			}
+
+			// Write the new key next to the current one first, so that it
+			// isn't lost if the server accepted it but writing it failed.
+			newPrivKeyPath := privKeyPath + ".new"
+			newPK, err := generatePrivateKey(newPrivKeyPath)
+			if err != nil {
+				return err
 			}
+			defer os.Remove(newPrivKeyPath) //nolint:errcheck // Why: Best effort, renamed on success.
 
-			dec, err := sign.NewDecryptor(bytes.NewReader(resp.GetEncKey()))
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
 			if err != nil {
-				return fmt.Errorf("failed to create decryptor: %w", err)
+				return err
 			}
-			if err := dec.SetPrivateKey(spk, nil); err != nil {
-				return fmt.Errorf("failed to set private key on decryptor: %w", err)
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			resp, err := client.RotateKey(cmd.Context(), kc, pk, newPK)
+			if err != nil {
+				return err
 			}
 
-			var buf bytes.Buffer
-			if err := dec.Decrypt(&buf); err != nil {
-				return fmt.Errorf("failed to decrypt session ID: %w", err)
+			if err := os.Rename(newPrivKeyPath, privKeyPath); err != nil {
+				return fmt.Errorf("failed to replace private key: %w", err)
 			}
 
-			fmt.Println(buf.String())
+			fmt.Println("ID:", resp.GetMachineId())
+			fmt.Println("Fingerprint:", resp.GetFingerprint())
+			fmt.Println("Old key accepted until:", resp.GetAliasExpiresAt())
 			return nil
 		},
 	}
 	flags := cmd.Flags()
+	flags.String("priv-key", client.DefaultKeyPath, "path to the private key of this machine, replaced with the new one")
+	return cmd
+}
+
+// generatePrivateKey generates a new private key for this machine and
+// writes it to the provided path, readable only by its owner.
+func generatePrivateKey(path string) (ed25519.PrivateKey, error) {
+	m, err := machines.NewMachine()
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := m.EncodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create key directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(privKey), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write private key: %w", err)
+	}
+	return m.PrivateKey, nil
+}
+
+// newGetKeyCommand creates a getkeyrequest [cobra.Command]
+func newGetKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "getkey [machine]",
+		Short: "Get the passphrase for the given machine",
+		Long: "Get the passphrase for the machine owning --priv-key. The machine is\n" +
+			"identified by the fingerprint of the key, or by the provided name or\n" +
+			"fingerprint. Machines with multiple secrets get their default one, or\n" +
+			"--secret.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			privKeyPath := cmd.Flag("priv-key").Value.String()
+			hostname := cmd.Parent().Flag("hostname").Value.String()
+
+			flags := cmd.Flags()
+			servers, err := flags.GetStringSlice("servers")
+			if err != nil {
+				return err
+			}
+			threshold, err := flags.GetInt("threshold")
+			if err != nil {
+				return err
+			}
+			pollInterval, err := flags.GetDuration("poll-interval")
+			if err != nil {
+				return err
+			}
+
+			privKeyByt, err := os.ReadFile(privKeyPath)
+			if err != nil {
+				return err
+			}
+
+			pk, err := machines.DecodePrivateKey(privKeyByt)
+			if err != nil {
+				return err
+			}
+
+			opts, err := requestOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				opts = append(opts, client.WithMachine(args[0]))
+			}
+			if secret := cmd.Flag("secret").Value.String(); secret != "" {
+				opts = append(opts, client.WithSecret(secret))
+			}
+
+			// When the key is distributed across multiple servers, each
+			// holding one share, rebuild it from the shares instead.
+			if len(servers) != 0 {
+				key, err := client.GetKeyFromServers(cmd.Context(), servers, threshold, pk, pollInterval, opts...)
+				if err != nil {
+					return err
+				}
+				return deliverKey(cmd, key)
+			}
+
+			kc, kcclose, err := client.Dial(hostname)
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Btiest effort
+
+			key, err := client.GetKey(cmd.Context(), kc, pk, opts...)
+			if err != nil {
+				return err
+			}
+			return deliverKey(cmd, key)
+		},
+	}
+	flags := cmd.Flags()
 	flags.String("priv-key", "", "path to private key")
+	flags.String("secret", "", "name of the secret of the machine to get, instead of its default one")
+	flags.StringSlice("servers", nil, "addresses of the klefki servers each holding a share of the key, instead of --hostname")
+	flags.Int("threshold", 2, "number of key shares required from --servers to rebuild the key")
+	flags.Duration("poll-interval", 5*time.Second, "how often to ask --servers that have not released their share yet")
+	flags.String("measure-kernel", "", "path to the booted kernel to report a measurement of")
+	flags.String("measure-initramfs", "", "path to the booted initramfs to report a measurement of")
+	flags.Bool("measure-cmdline", false, "report a measurement of the kernel command line")
+	flags.Bool("report-hardware", false, "report the hardware attributes of this machine")
+	flags.Int("max-key-size", chunks.DefaultMaxKeySize, "maximum size, in bytes, of the key, or all of its shares, once encrypted")
+	flags.String("keyring", "", "add the key to this kernel keyring (user or session) instead of printing it")
+	flags.String("key-description", client.DefaultKeyDescription, "description of the key added to --keyring")
+	flags.Duration("key-timeout", client.DefaultKeyTimeout, "how long the key added to --keyring is kept, 0 to keep it")
+	flags.StringP("output", "o", "", "write the key to this file, readable only by its owner, instead of printing it")
+	flags.Int("output-fd", -1, "write the key to this file descriptor instead of printing it")
+	cmd.MarkFlagsMutuallyExclusive("keyring", "output", "output-fd")
 	return cmd
 }
 
+// deliverKey writes the provided key as is, without a trailing
+// newline, to stdout or the file or file descriptor provided through
+// the flags of the getkey command, or adds it to the kernel keyring
+// provided through them.
+func deliverKey(cmd *cobra.Command, key []byte) error {
+	defer clear(key)
+
+	keyringName := cmd.Flag("keyring").Value.String()
+	if keyringName == "" {
+		return writeKey(cmd, key)
+	}
+
+	keyring, err := client.ParseKeyring(keyringName)
+	if err != nil {
+		return err
+	}
+
+	timeout, err := cmd.Flags().GetDuration("key-timeout")
+	if err != nil {
+		return err
+	}
+
+	description := cmd.Flag("key-description").Value.String()
+	id, err := client.AddToKeyring(key, keyring, description, timeout)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Added key %q to the %s keyring (serial %d)\n", description, keyring, id)
+	return nil
+}
+
+// writeKey writes the provided key to the file or file descriptor
+// provided through the flags of the getkey command, or stdout.
+func writeKey(cmd *cobra.Command, key []byte) error {
+	if output := cmd.Flag("output").Value.String(); output != "" {
+		if err := os.WriteFile(output, key, 0o600); err != nil {
+			return fmt.Errorf("failed to write key: %w", err)
+		}
+		return nil
+	}
+
+	fd, err := cmd.Flags().GetInt("output-fd")
+	if err != nil {
+		return err
+	}
+
+	w := os.Stdout
+	if fd >= 0 {
+		w = os.NewFile(uintptr(fd), "output-fd") //nolint:gosec // Why: Checked above.
+		defer w.Close()
+	}
+	if _, err := w.Write(key); err != nil {
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	return nil
+}
+
+// requestOptionsFromFlags returns the [client.RequestOption]s for the
+// flags of the getkey command.
+func requestOptionsFromFlags(cmd *cobra.Command) ([]client.RequestOption, error) {
+	flags := cmd.Flags()
+	kernelPath := cmd.Flag("measure-kernel").Value.String()
+	initramfsPath := cmd.Flag("measure-initramfs").Value.String()
+	measureCmdline, err := flags.GetBool("measure-cmdline")
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []client.RequestOption
+	if kernelPath != "" || initramfsPath != "" || measureCmdline {
+		cmdlinePath := ""
+		if measureCmdline {
+			cmdlinePath = client.CmdlinePath
+		}
+
+		bm, err := client.MeasureBoot(kernelPath, initramfsPath, cmdlinePath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithBootMeasurements(bm))
+	}
+
+	reportHardware, err := flags.GetBool("report-hardware")
+	if err != nil {
+		return nil, err
+	}
+	if reportHardware {
+		hw, err := client.ReadHardware()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithHardwareAttributes(hw))
+	}
+
+	maxKeySize, err := flags.GetInt("max-key-size")
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, client.WithMaxKeySize(maxKeySize))
+	return opts, nil
+}
+
 // newListSessionsCommand creates a listsessions [cobra.Command]
 func newListSessionsCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "listsessions",
 		Short: "Return a list of all machines waiting for a key to be provided",
 		Args:  cobra.NoArgs,
@@ -153,7 +439,10 @@ func newListSessionsCommand() *cobra.Command {
 			}
 			defer kcclose() //nolint:errcheck // Why: Best effort
 
-			resp, err := kc.ListSessions(cmd.Context(), &pbgrpcv1.ListSessionsRequest{})
+			req := &pbgrpcv1.ListSessionsRequest{}
+			req.SetGroup(cmd.Flag("group").Value.String())
+			req.SetSelector(cmd.Flag("selector").Value.String())
+			resp, err := kc.ListSessions(cmd.Context(), req)
 			if err != nil {
 				return fmt.Errorf("failed to get key from server: %w", err)
 			}
@@ -165,74 +454,386 @@ func newListSessionsCommand() *cobra.Command {
 			}
 
 			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
-			fmt.Fprint(tw, "FINGERPRINT\tLAST ASKED\n")
+			fmt.Fprint(tw, "ID\tNAME\tLAST ASKED\tSECRETS\tAPPROVALS\n")
 			for _, m := range ms {
-				fmt.Fprintf(tw, "%s\t%s\n", m.GetId(), m.GetLastAsked())
+				approvers := make([]string, 0, len(m.GetApprovals()))
+				for _, a := range m.GetApprovals() {
+					approvers = append(approvers, a.GetOperatorName())
+				}
+
+				approvals := fmt.Sprintf("%d/%d", len(m.GetApprovals()), m.GetRequiredApprovals())
+				if len(approvers) != 0 {
+					approvals += " (" + strings.Join(approvers, ", ") + ")"
+				}
+
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", m.GetId(), m.GetName(), m.GetLastAsked(), sessionStatus(m), approvals)
 			}
 			return tw.Flush()
 		},
 	}
+	flags := cmd.Flags()
+	flags.String("group", "", "only list machines in this group")
+	flags.StringP("selector", "l", "", "only list machines whose labels match this selector (e.g., role=storage)")
+	return cmd
+}
+
+// sessionStatus returns a human readable status of the secrets of the
+// provided machine, as returned by ListSessions.
+func sessionStatus(m *pbgrpcv1.Machine) string {
+	if m.GetDeniedBy() != "" {
+		return "denied by " + m.GetDeniedBy()
+	}
+	statuses := make([]string, 0, len(m.GetSecrets()))
+	for _, sec := range m.GetSecrets() {
+		statuses = append(statuses, sec.GetName()+"="+secretStatus(m, sec))
+	}
+	return strings.Join(statuses, ",")
+}
+
+// secretStatus returns a human readable status of the provided secret
+// of the provided machine, as returned by ListSessions.
+func secretStatus(m *pbgrpcv1.Machine, sec *pbgrpcv1.SecretStatus) string {
+	switch {
+	case sec.GetReleased():
+		return "released"
+	case m.GetShareThreshold() > 0:
+		return fmt.Sprintf("%d/%d shares", sec.GetSharesSubmitted(), m.GetShareThreshold())
+	case sec.GetKeySubmitted():
+		return "submitted"
+	}
+	return "waiting"
 }
 
 // newSubmitKeyCommand creates a submitekey [cobra.Command]
 func newSubmitKeyCommand() *cobra.Command {
-	return &cobra.Command{
-		Use:   "submitkey <machineID> <passphrase>",
-		Short: "Submit a passphrase to a given machine by its ID",
-		Args:  cobra.ExactArgs(2),
+	cmd := &cobra.Command{
+		Use:   "submitkey <machine> [passphrase]",
+		Short: "Submit a passphrase or keyfile to a given machine by its ID, name or fingerprint",
+		Long: "Submit a passphrase or keyfile to a machine waiting for it. Unless passed as\n" +
+			"an argument, which exposes it to other users of this host, the key is read as\n" +
+			"is from --from-file, or from the passphrase sources: by default the passphrase\n" +
+			"store, if it exists, then a prompt on the terminal, or stdin.",
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			machineID := args[0]
-			// TODO(jaredallard): don't expect to be passed as an arg
-			passphrase := args[1]
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			machine, err := findSession(cmd.Context(), kc, args[0])
+			if err != nil {
+				return err
+			}
+
+			secret := cmd.Flag("secret").Value.String()
+			if secret == "" {
+				secret = defaultSecret(machine)
+			}
+
+			var passphrase []byte
+			switch {
+			case len(args) == 2:
+				passphrase = []byte(args[1])
+			case cmd.Flag("from-file").Value.String() != "":
+				passphrase, err = readKeyFile(cmd.Flag("from-file").Value.String())
+			default:
+				var source PassphraseSource
+				source, err = passphraseSourceFromFlags(cmd, true)
+				if err == nil {
+					passphrase, err = source.Passphrase(machine, secret)
+				}
+			}
+			if err != nil {
+				return err
+			}
+			defer clear(passphrase)
 
+			return submitKeyTo(cmd.Context(), kc, machine, secret, passphrase, 0)
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("secret", "", "name of the secret of the machine to submit, instead of its default one")
+	flags.String("from-file", "", "path to the passphrase or keyfile to submit, - for stdin")
+	flags.StringSlice("passphrase-source", nil,
+		"sources to read the passphrase from, in order: tty, stdin, file:<path>, list:<path>, store[:<path>], "+
+			"vault[:<path>] or helper:<name>")
+	return cmd
+}
+
+// newSubmitShareCommand creates a submitshare [cobra.Command]
+func newSubmitShareCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submitshare <machine>",
+		Short: "Submit a share of a split passphrase to a given machine by its ID, name or fingerprint",
+		Long: "Submit a share of a split passphrase, as printed by 'klefkictl shamir split',\n" +
+			"to a machine waiting for it. The share is read from --from-file, or from the\n" +
+			"passphrase sources: by default the passphrase store, if it exists, then a\n" +
+			"prompt on the terminal, or stdin.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
 			if err != nil {
 				return err
 			}
 			defer kcclose() //nolint:errcheck // Why: Best effort
 
-			resp, err := kc.ListSessions(cmd.Context(), &pbgrpcv1.ListSessionsRequest{})
+			machine, err := findSession(cmd.Context(), kc, args[0])
 			if err != nil {
-				return fmt.Errorf("failed to get key from server: %w", err)
+				return err
 			}
 
-			ms := resp.GetMachines()
+			secret := cmd.Flag("secret").Value.String()
+			if secret == "" {
+				secret = defaultSecret(machine)
+			}
 
-			var machine *pbgrpcv1.Machine
-			for _, m := range ms {
-				if m.GetId() == machineID {
-					machine = m
-					break
+			var encoded []byte
+			if path := cmd.Flag("from-file").Value.String(); path != "" {
+				encoded, err = readKeyFile(path)
+			} else {
+				var source PassphraseSource
+				source, err = passphraseSourceFromFlags(cmd, true)
+				if err == nil {
+					encoded, err = source.Passphrase(machine, secret)
 				}
 			}
-			if machine == nil {
-				return fmt.Errorf("no sessions found for %q", machineID)
+			if err != nil {
+				return err
+			}
+			share, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(encoded)))
+			clear(encoded)
+			if err != nil {
+				return fmt.Errorf("failed to decode share: %w", err)
+			}
+			defer clear(share)
+
+			shareIndex, err := shamir.Index(share)
+			if err != nil {
+				return err
+			}
+
+			index := int32(shareIndex) //nolint:gosec // Why: At most 255.
+			return submitKeyTo(cmd.Context(), kc, machine, secret, share, index)
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("secret", "", "name of the secret of the machine to submit a share of, instead of its default one")
+	flags.String("from-file", "", "path to the base64 encoded share to submit, - for stdin")
+	flags.StringSlice("passphrase-source", nil,
+		"sources to read the share from, in order: tty, stdin, file:<path>, list:<path>, store[:<path>], "+
+			"vault[:<path>] or helper:<name>")
+	return cmd
+}
+
+// findSession returns the machine with an active session, by its ID,
+// name or fingerprint.
+func findSession(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, machineID string) (*pbgrpcv1.Machine, error) {
+	resp, err := kc.ListSessions(ctx, &pbgrpcv1.ListSessionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key from server: %w", err)
+	}
+
+	for _, m := range resp.GetMachines() {
+		if m.GetId() == machineID || m.GetName() == machineID || m.GetFingerprint() == machineID {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("no sessions found for %q", machineID)
+}
+
+// submitKeyTo encrypts the provided key to the provided machine, as
+// returned by ListSessions, and submits it as the secret with the
+// provided name, or its default one if empty. Keys too large for a
+// single message are sent through SubmitKeyStream.
+func submitKeyTo(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, machine *pbgrpcv1.Machine,
+	secret string, key []byte, shareIndex int32) error {
+	encKey, err := machines.Encrypt(machine.GetPublicKey(), key)
+	if err != nil {
+		return err
+	}
+
+	req := &pbgrpcv1.SubmitKeyRequest{}
+	req.SetEncKey(encKey)
+	req.SetMachineId(machine.GetId())
+	req.SetShareIndex(shareIndex)
+	req.SetSecret(secret)
+	if len(encKey) <= chunks.Size {
+		_, err = kc.SubmitKey(ctx, req)
+		return err
+	}
+
+	stream, err := kc.SubmitKeyStream(ctx)
+	if err != nil {
+		return err
+	}
+	if err := chunks.Send(req, stream.Send); err != nil {
+		return fmt.Errorf("failed to send key: %w", err)
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// newApproveCommand creates an approve [cobra.Command]
+func newApproveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve <machineID>",
+		Short: "Approve releasing the submitted key to a given machine by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			machineID := args[0]
+			pk, operatorID, err := readOperatorKey(cmd.Flag("operator-key").Value.String())
+			if err != nil {
+				return err
+			}
+
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			resp, err := approveSession(cmd.Context(), kc, pk, operatorID, machineID)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Approved (%d/%d)\n", resp.GetApprovals(), resp.GetRequiredApprovals())
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("operator-key", "", "path to operator private key")
+	return cmd
+}
+
+// approveSession approves the session of the machine with the provided
+// ID as the operator owning the provided private key.
+func approveSession(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, pk ed25519.PrivateKey,
+	operatorID, machineID string) (*pbgrpcv1.ApproveSessionResponse, error) {
+	signedAt, err := serverTime(ctx, kc)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pbgrpcv1.ApproveSessionRequest{}
+	req.SetMachineId(machineID)
+	req.SetOperatorId(operatorID)
+	req.SetNonce(uuid.New().String())
+	req.SetSignedAt(signedAt)
+	req.SetSignature(operators.SignRequest(pk, operators.ActionApprove, machineID, req.GetNonce(), req.GetSignedAt()))
+
+	resp, err := kc.ApproveSession(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve session: %w", err)
+	}
+	return resp, nil
+}
+
+// newDenyCommand creates a deny [cobra.Command]
+func newDenyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deny <machineID>",
+		Short: "Deny releasing the key to a given machine by its ID",
+		Long: "Deny the session of a machine, e.g., a boot that wasn't expected. Keys submitted\n" +
+			"for it are dropped, and the machine is refused until the session is cancelled.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pk, operatorID, err := readOperatorKey(cmd.Flag("operator-key").Value.String())
+			if err != nil {
+				return err
 			}
 
-			pubKey, err := sign.PublicKeyFromBytes(machine.GetPublicKey())
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
 			if err != nil {
-				return fmt.Errorf("failed to convert machine's public key to encryption public key: %w", err)
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			if err := denySession(cmd.Context(), kc, pk, operatorID, args[0]); err != nil {
+				return err
 			}
 
-			enc, err := sign.NewEncryptor(nil, 1024)
+			fmt.Println("Denied")
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("operator-key", "", "path to operator private key")
+	return cmd
+}
+
+// newCancelCommand creates a cancel [cobra.Command]
+func newCancelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel <machineID>",
+		Short: "Cancel the session of a given machine by its ID",
+		Long: "Cancel the session of a machine, dropping the keys submitted for it, its\n" +
+			"approvals and any denial. The machine starts a new session when it next asks.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pk, operatorID, err := readOperatorKey(cmd.Flag("operator-key").Value.String())
 			if err != nil {
-				return fmt.Errorf("failed to create decryptor: %w", err)
+				return err
 			}
 
-			if err := enc.AddRecipient(pubKey); err != nil {
-				return fmt.Errorf("failed to set private key on decryptor: %w", err)
+			kc, kcclose, err := client.Dial(cmd.Parent().Flag("hostname").Value.String())
+			if err != nil {
+				return err
 			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
 
-			var buf bytes.Buffer
-			if err := enc.Encrypt(strings.NewReader(passphrase), newNopWriteCloser(&buf)); err != nil {
-				return fmt.Errorf("failed to decrypt session ID: %w", err)
+			if err := cancelSession(cmd.Context(), kc, pk, operatorID, args[0]); err != nil {
+				return err
 			}
 
-			req := &pbgrpcv1.SubmitKeyRequest{}
-			req.SetEncKey(buf.Bytes())
-			req.SetMachineId(machineID)
-			_, err = kc.SubmitKey(cmd.Context(), req)
-			return err
+			fmt.Println("Cancelled")
+			return nil
 		},
 	}
+	flags := cmd.Flags()
+	flags.String("operator-key", "", "path to operator private key")
+	return cmd
+}
+
+// denySession denies the session of the machine with the provided ID
+// as the operator owning the provided private key.
+func denySession(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, pk ed25519.PrivateKey, operatorID, machineID string) error {
+	signedAt, err := serverTime(ctx, kc)
+	if err != nil {
+		return err
+	}
+
+	req := &pbgrpcv1.DenySessionRequest{}
+	req.SetMachineId(machineID)
+	req.SetOperatorId(operatorID)
+	req.SetNonce(uuid.New().String())
+	req.SetSignedAt(signedAt)
+	req.SetSignature(operators.SignRequest(pk, operators.ActionDeny, machineID, req.GetNonce(), req.GetSignedAt()))
+
+	if _, err := kc.DenySession(ctx, req); err != nil {
+		return fmt.Errorf("failed to deny session: %w", err)
+	}
+	return nil
+}
+
+// cancelSession cancels the session of the machine with the provided
+// ID as the operator owning the provided private key.
+func cancelSession(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, pk ed25519.PrivateKey, operatorID, machineID string) error {
+	signedAt, err := serverTime(ctx, kc)
+	if err != nil {
+		return err
+	}
+
+	req := &pbgrpcv1.CancelSessionRequest{}
+	req.SetMachineId(machineID)
+	req.SetOperatorId(operatorID)
+	req.SetNonce(uuid.New().String())
+	req.SetSignedAt(signedAt)
+	req.SetSignature(operators.SignRequest(pk, operators.ActionCancel, machineID, req.GetNonce(), req.GetSignedAt()))
+
+	if _, err := kc.CancelSession(ctx, req); err != nil {
+		return fmt.Errorf("failed to cancel session: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,80 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/shamir"
+	"github.com/spf13/cobra"
+)
+
+// newShamirCommand creates a shamir [cobra.Command]
+func newShamirCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shamir",
+		Short: "Split passphrases into shares held by different operators",
+	}
+	cmd.AddCommand(
+		newShamirSplitCommand(),
+	)
+	return cmd
+}
+
+// newShamirSplitCommand creates a shamir split [cobra.Command]
+func newShamirSplitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split",
+		Short: "Split a passphrase read from stdin into shares, one per line",
+		Long: "Split a passphrase read from stdin into shares, one per line. Each share\n" +
+			"should be handed to a different operator, who submits it with\n" +
+			"'klefkictl requests submitshare'.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			flags := cmd.Flags()
+			n, err := flags.GetInt("shares")
+			if err != nil {
+				return err
+			}
+			k, err := flags.GetInt("threshold")
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := readPassphrase()
+			if err != nil {
+				return err
+			}
+
+			shares, err := shamir.Split([]byte(passphrase), n, k)
+			if err != nil {
+				return err
+			}
+
+			for _, share := range shares {
+				fmt.Println(base64.StdEncoding.EncodeToString(share))
+			}
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.Int("shares", 3, "number of shares to split the passphrase into")
+	flags.Int("threshold", 2, "number of shares required to recover the passphrase")
+	return cmd
+}
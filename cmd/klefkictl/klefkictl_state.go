@@ -0,0 +1,91 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newEnableCommand creates an enable [cobra.Command]
+func newEnableCommand() *cobra.Command {
+	return newStateCommand(machine.StateActive, "enable",
+		"Enable a disabled or quarantined machine, allowing it to request keys again")
+}
+
+// newDisableCommand creates a disable [cobra.Command]
+func newDisableCommand() *cobra.Command {
+	return newStateCommand(machine.StateDisabled, "disable",
+		"Disable a machine, refusing its key requests without deleting it")
+}
+
+// newQuarantineCommand creates a quarantine [cobra.Command]
+func newQuarantineCommand() *cobra.Command {
+	cmd := newStateCommand(machine.StateQuarantined, "quarantine",
+		"Quarantine a machine, e.g., when it was stolen")
+	cmd.Long = "Quarantine a machine, e.g., when it was stolen. Requests made by the machine\n" +
+		"are recorded in the audit log, with the address and metadata they were made\n" +
+		"with, and alerted on. The machine never receives a key, but isn't told so."
+	return cmd
+}
+
+// newStateCommand creates a [cobra.Command] setting the state of a
+// machine to the provided state.
+func newStateCommand(state machine.State, use, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " <machine>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+			if m.State == state {
+				return fmt.Errorf("machine %q is already %s", m.ID, state)
+			}
+
+			if err := m.Update().SetState(state).Exec(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to write to DB: %w", err)
+			}
+
+			details := fmt.Sprintf("changed state from %s to %s", m.State, state)
+			if reason := cmd.Flag("reason").Value.String(); reason != "" {
+				details += ": " + reason
+			}
+			return audit.Record(cmd.Context(), dbc, &audit.Event{
+				Type:      audit.EventMachineStateChanged,
+				MachineID: m.ID,
+				Details:   details,
+			})
+		},
+	}
+	cmd.Flags().String("reason", "", "reason for the change, recorded in the audit log")
+	return cmd
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"crypto"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/tang"
+	"github.com/spf13/cobra"
+)
+
+// newTangCommand creates a tang [cobra.Command]
+func newTangCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tang",
+		Short: "Manage the keys of the Tang compatible endpoint",
+	}
+	cmd.AddCommand(
+		newTangListCommand(),
+		newTangRotateCommand(),
+		newTangDeleteCommand(),
+	)
+	return cmd
+}
+
+// newTangListCommand creates a tang list [cobra.Command]
+func newTangListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all Tang keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			keys, err := tang.LoadKeys(cmd.Context(), dbc)
+			if err != nil {
+				return err
+			}
+			if len(keys) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 2, 2, 2, ' ', 0)
+			fmt.Fprint(tw, "ID\tUSE\tMACHINE\tADVERTISED\tTHUMBPRINT\tCREATED AT\n")
+			for _, k := range keys {
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%t\t%s\t%s\n", k.ID, k.Use, k.MachineID, k.Advertised,
+					k.JWK().Thumbprint(crypto.SHA256), k.CreatedAt.Local().Format(time.RFC3339))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// newTangRotateCommand creates a tang rotate [cobra.Command]
+func newTangRotateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate",
+		Short: "Generate a new Tang signing key to advertise",
+		Long: "Generate a new Tang signing key to advertise. The previously advertised\n" +
+			"keys are no longer advertised, but can still be used by existing clients to\n" +
+			"recover until they are deleted. New exchange keys are generated when machines\n" +
+			"next fetch the advertisement. Rebind clients to the new keys before deleting\n" +
+			"the old ones.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			return tang.Rotate(cmd.Context(), dbc)
+		},
+	}
+}
+
+// newTangDeleteCommand creates a tang delete [cobra.Command]
+func newTangDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a rotated Tang key by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID %q: %w", args[0], err)
+			}
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			k, err := dbc.TangKey.Get(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			if k.Advertised {
+				return fmt.Errorf("tang key %d is still advertised, rotate it first", id)
+			}
+
+			return dbc.TangKey.DeleteOne(k).Exec(cmd.Context())
+		},
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateCommand creates an update [cobra.Command]
+func newUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <machine>",
+		Short: "Update the settings of a known machine by ID, name or fingerprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+			upd := m.Update()
+
+			flags := cmd.Flags()
+			if flags.Changed("required-approvals") {
+				requiredApprovals, err := flags.GetInt("required-approvals")
+				if err != nil {
+					return err
+				}
+				upd.SetRequiredApprovals(requiredApprovals)
+			}
+			if flags.Changed("share-threshold") {
+				shareThreshold, err := flags.GetInt("share-threshold")
+				if err != nil {
+					return err
+				}
+				upd.SetShareThreshold(shareThreshold)
+				m.ShareThreshold = shareThreshold
+			}
+			if flags.Changed("share-index") {
+				shareIndex, err := flags.GetInt("share-index")
+				if err != nil {
+					return err
+				}
+				upd.SetShareIndex(shareIndex)
+				m.ShareIndex = shareIndex
+			}
+			if flags.Changed("auto-unlock") {
+				autoUnlock, err := flags.GetBool("auto-unlock")
+				if err != nil {
+					return err
+				}
+				upd.SetAutoUnlock(autoUnlock)
+			}
+			if flags.Changed("label") || flags.Changed("remove-label") {
+				setLabels, err := flags.GetStringToString("label")
+				if err != nil {
+					return err
+				}
+				removeLabels, err := flags.GetStringSlice("remove-label")
+				if err != nil {
+					return err
+				}
+
+				labels := make(map[string]string, len(m.Labels)+len(setLabels))
+				maps.Copy(labels, m.Labels)
+				maps.Copy(labels, setLabels)
+				for _, k := range removeLabels {
+					delete(labels, k)
+				}
+				upd.SetLabels(labels)
+			}
+			if flags.Changed("hardware-binding") {
+				hardwareBinding, err := flags.GetString("hardware-binding")
+				if err != nil {
+					return err
+				}
+				upd.SetHardwareBinding(machine.HardwareBinding(hardwareBinding))
+			}
+			if flags.Changed("name") {
+				upd.SetName(cmd.Flag("name").Value.String())
+			}
+			if flags.Changed("description") {
+				upd.SetDescription(cmd.Flag("description").Value.String())
+			}
+			if flags.Changed("secret") {
+				secretNames, err := flags.GetStringSlice("secret")
+				if err != nil {
+					return err
+				}
+				upd.SetSecrets(secretNames)
+			}
+			if flags.Changed("expires-at") {
+				expiresAt, err := time.Parse(time.RFC3339, cmd.Flag("expires-at").Value.String())
+				if err != nil {
+					return fmt.Errorf("failed to parse --expires-at: %w", err)
+				}
+				upd.SetExpiresAt(expiresAt)
+			}
+			if noExpiry, err := flags.GetBool("no-expiry"); err != nil {
+				return err
+			} else if noExpiry {
+				upd.ClearExpiresAt()
+			}
+			if m.ShareThreshold > 0 && m.ShareIndex > 0 {
+				return fmt.Errorf("share threshold and share index are mutually exclusive, unset one of them")
+			}
+
+			return upd.Exec(cmd.Context())
+		},
+	}
+	flags := cmd.Flags()
+	flags.Int("required-approvals", 0, "number of distinct operators that must approve a session before its key is released")
+	flags.Int("share-threshold", 0, "number of passphrase shares required to unlock, if the passphrase is split")
+	flags.Int("share-index", 0, "index of the passphrase share held by this server, if the passphrase is distributed across servers")
+	flags.Bool("auto-unlock", false, "release the key stored in the vault for this machine without an operator submitting it")
+	flags.StringToString("label", nil, "labels to add to, or change on, the machine (e.g., --label role=storage)")
+	flags.StringSlice("remove-label", nil, "keys of labels to remove from the machine")
+	flags.String("hardware-binding", string(machine.HardwareBindingWarn), "what happens when the reported hardware doesn't match, one of warn or enforce")
+	flags.String("name", "", "new name of the machine")
+	flags.String("description", "", "free-form description of the machine")
+	flags.StringSlice("secret", nil, "names of the secrets of the machine (e.g., --secret root,tank), the first being its default one, replacing the current ones")
+	flags.String("expires-at", "", "when the machine stops being able to request keys, in RFC3339 (e.g., 2027-01-01T00:00:00Z)")
+	flags.Bool("no-expiry", false, "remove the expiry of the machine")
+	cmd.MarkFlagsMutuallyExclusive("expires-at", "no-expiry")
+	return cmd
+}
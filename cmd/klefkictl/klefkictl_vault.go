@@ -0,0 +1,378 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"git.rgst.io/homelab/klefki/internal/db"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/internal/secrets"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/internal/vault"
+	"git.rgst.io/homelab/klefki/pkg/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newVaultCommand creates a vault [cobra.Command]
+func newVaultCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault",
+		Short: "Manage the sealed vault used for unattended unlocks",
+	}
+	cmd.AddCommand(
+		newVaultInitCommand(),
+		newVaultStoreCommand(),
+		newVaultUnsealCommand(),
+		newVaultSealCommand(),
+		newVaultStatusCommand(),
+	)
+	flags := cmd.PersistentFlags()
+	flags.String("hostname", "127.0.0.1:5300", "hostname of the klefki server to connect to")
+	return cmd
+}
+
+// newVaultInitCommand creates a vault init [cobra.Command]
+func newVaultInitCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "init",
+		Short: "Initialize the vault and print its master key",
+		Long: "Initialize the vault and print its master key. The master key is not\n" +
+			"stored by klefki and is required to store keys in, and unseal, the vault.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			key, err := vault.GenerateKey()
+			if err != nil {
+				return err
+			}
+
+			if err := vault.Init(cmd.Context(), dbc, key); err != nil {
+				return fmt.Errorf("failed to initialize vault: %w", err)
+			}
+
+			fmt.Println("Master Key:")
+			fmt.Println(base64.StdEncoding.EncodeToString(key))
+			return nil
+		},
+	}
+}
+
+// newVaultStoreCommand creates a vault store [cobra.Command]
+func newVaultStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store <machine>",
+		Short: "Store a passphrase or keyfile in the vault for a known machine",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := readMasterKey(cmd.Flag("key-file").Value.String())
+			if err != nil {
+				return err
+			}
+
+			var passphrase []byte
+			if fromFile := cmd.Flag("from-file").Value.String(); fromFile != "" {
+				passphrase, err = readKeyFile(fromFile)
+			} else {
+				var line string
+				line, err = readPassphrase()
+				passphrase = []byte(line)
+			}
+			if err != nil {
+				return err
+			}
+			defer clear(passphrase)
+
+			dbc, err := db.New(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer dbc.Close()
+
+			m, err := registry.Lookup(cmd.Context(), dbc, args[0])
+			if err != nil {
+				return err
+			}
+
+			secret, err := secrets.Resolve(m.Secrets, cmd.Flag("secret").Value.String())
+			if err != nil {
+				return err
+			}
+
+			return vault.Store(cmd.Context(), dbc, key, m.ID, secrets.StoredName(m.Secrets, secret), passphrase)
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("key-file", "", "path to a file containing the master key")
+	flags.String("secret", "", "name of the secret of the machine to store, instead of its default one")
+	flags.String("from-file", "", "path to a passphrase or keyfile to store as is, - for stdin, instead of a line read from stdin")
+	return cmd
+}
+
+// newVaultUnsealCommand creates a vault unseal [cobra.Command]
+func newVaultUnsealCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unseal",
+		Short: "Unseal the vault of a running klefki server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			key, err := readMasterKey(cmd.Flag("key-file").Value.String())
+			if err != nil {
+				return err
+			}
+
+			pk, operatorID, err := readOperatorKey(cmd.Flag("operator-key").Value.String())
+			if err != nil {
+				return err
+			}
+
+			kc, kcclose, err := client.Dial(cmd.Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			idResp, err := kc.GetIdentity(cmd.Context(), &pbgrpcv1.GetIdentityRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get server identity: %w", err)
+			}
+
+			// GetIdentity is unauthenticated, so anyone in the middle could
+			// return their own key to receive the master key instead.
+			if err := checkServerIdentity(cmd.Flag("server-identity").Value.String(), idResp.GetPublicKey()); err != nil {
+				return err
+			}
+
+			// Only the server can read the master key in transit.
+			encKey, err := machines.Encrypt(idResp.GetPublicKey(), key)
+			if err != nil {
+				return err
+			}
+
+			signedAt, err := serverTime(cmd.Context(), kc)
+			if err != nil {
+				return err
+			}
+
+			req := &pbgrpcv1.UnsealRequest{}
+			req.SetOperatorId(operatorID)
+			req.SetEncKey(encKey)
+			req.SetNonce(uuid.New().String())
+			req.SetSignedAt(signedAt)
+			req.SetSignature(operators.SignRequest(pk, operators.ActionUnseal,
+				operators.KeyDigest(encKey), req.GetNonce(), req.GetSignedAt()))
+
+			resp, err := kc.Unseal(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to unseal vault: %w", err)
+			}
+
+			printVaultStatus(resp.GetStatus())
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("key-file", "", "path to a file containing the master key")
+	flags.String("operator-key", "", "path to operator private key")
+	flags.String("server-identity", "",
+		"pinned identity of the server, base64 encoded or its fingerprint, as printed by 'klefkictl requests identity'")
+	//nolint:errcheck // Why: Only fails if the flag doesn't exist.
+	cmd.MarkFlagRequired("server-identity")
+	return cmd
+}
+
+// checkServerIdentity returns an error if the provided identity
+// returned by a server doesn't match the pinned one, base64 encoded or
+// its fingerprint.
+func checkServerIdentity(pinned string, identity ed25519.PublicKey) error {
+	if len(identity) != ed25519.PublicKeySize {
+		return fmt.Errorf("server returned an invalid identity")
+	}
+
+	if strings.HasPrefix(pinned, "SHA256:") {
+		fprint, err := machines.Fingerprint(identity)
+		if err != nil {
+			return err
+		}
+		if fprint != pinned {
+			return fmt.Errorf("server identity %s doesn't match the pinned one %s", fprint, pinned)
+		}
+		return nil
+	}
+
+	want, err := base64.StdEncoding.DecodeString(pinned)
+	if err != nil {
+		return fmt.Errorf("failed to decode pinned server identity: %w", err)
+	}
+	if !identity.Equal(ed25519.PublicKey(want)) {
+		return fmt.Errorf("server identity %s doesn't match the pinned one %s",
+			base64.StdEncoding.EncodeToString(identity), pinned)
+	}
+	return nil
+}
+
+// newVaultSealCommand creates a vault seal [cobra.Command]
+func newVaultSealCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seal",
+		Short: "Seal the vault of a running klefki server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			pk, operatorID, err := readOperatorKey(cmd.Flag("operator-key").Value.String())
+			if err != nil {
+				return err
+			}
+
+			kc, kcclose, err := client.Dial(cmd.Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			signedAt, err := serverTime(cmd.Context(), kc)
+			if err != nil {
+				return err
+			}
+
+			req := &pbgrpcv1.SealRequest{}
+			req.SetOperatorId(operatorID)
+			req.SetNonce(uuid.New().String())
+			req.SetSignedAt(signedAt)
+			req.SetSignature(operators.SignRequest(pk, operators.ActionSeal, "", req.GetNonce(), req.GetSignedAt()))
+
+			resp, err := kc.Seal(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to seal vault: %w", err)
+			}
+
+			printVaultStatus(resp.GetStatus())
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.String("operator-key", "", "path to operator private key")
+	return cmd
+}
+
+// newVaultStatusCommand creates a vault status [cobra.Command]
+func newVaultStatusCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of the vault of a running klefki server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			kc, kcclose, err := client.Dial(cmd.Flag("hostname").Value.String())
+			if err != nil {
+				return err
+			}
+			defer kcclose() //nolint:errcheck // Why: Best effort
+
+			resp, err := kc.GetVaultStatus(cmd.Context(), &pbgrpcv1.GetVaultStatusRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get vault status: %w", err)
+			}
+
+			printVaultStatus(resp.GetStatus())
+			return nil
+		},
+	}
+}
+
+// printVaultStatus prints the provided vault status.
+func printVaultStatus(status *pbgrpcv1.VaultStatus) {
+	fmt.Println("Initialized:", status.GetInitialized())
+	fmt.Println("Sealed:", status.GetSealed())
+	if status.GetUnsealedAt() != "" {
+		fmt.Println("Unsealed At:", status.GetUnsealedAt())
+	}
+	if status.GetSealsAt() != "" {
+		fmt.Println("Seals At:", status.GetSealsAt())
+	}
+}
+
+// readMasterKey reads a base64 encoded master key from the provided
+// path.
+func readMasterKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, fmt.Errorf("--key-file is required")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode master key: %w", err)
+	}
+	return key, nil
+}
+
+// readPassphrase reads a single line passphrase from stdin.
+func readPassphrase() (string, error) {
+	passphrase, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && passphrase == "" {
+		return "", fmt.Errorf("failed to read passphrase from stdin: %w", err)
+	}
+	return strings.TrimRight(passphrase, "\r\n"), nil
+}
+
+// readOperatorKey reads the private key of an operator from the
+// provided path and returns it along with the ID of the operator.
+func readOperatorKey(path string) (ed25519.PrivateKey, string, error) {
+	privKeyByt, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pk, err := machines.DecodePrivateKey(privKeyByt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	operatorID, err := machines.Fingerprint(pk.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get fingerprint for key: %w", err)
+	}
+	return pk, operatorID, nil
+}
+
+// serverTime returns the current time of the server, used to sign
+// operator requests.
+func serverTime(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient) (string, error) {
+	tsResp, err := kc.GetTime(ctx, &pbgrpcv1.GetTimeRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to server to get time: %w", err)
+	}
+	return tsResp.GetTime(), nil
+}
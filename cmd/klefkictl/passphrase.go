@@ -0,0 +1,300 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"git.rgst.io/homelab/klefki/internal/credhelper"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/internal/vaultkv"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// errNoPassphrase is returned by a [PassphraseSource] that doesn't hold
+// the requested passphrase, so that the next source is tried.
+var errNoPassphrase = errors.New("no passphrase")
+
+// PassphraseSource provides the passphrases of the secrets of machines,
+// so that operators don't pass them as arguments, where they end up in
+// shell history and ps.
+type PassphraseSource interface {
+	// Passphrase returns the passphrase of the secret with the provided
+	// name of the provided machine, as returned by ListSessions. Returns
+	// an error wrapping [errNoPassphrase] if the source doesn't hold it.
+	Passphrase(m *pbgrpcv1.Machine, secret string) ([]byte, error)
+}
+
+// parsePassphraseSources parses the provided passphrase sources, tried
+// in order:
+//
+//   - tty - prompts for passphrases on the terminal, without echoing
+//     them.
+//   - stdin - reads a single passphrase or keyfile from stdin, as is.
+//   - file:<path> - reads a single passphrase or keyfile, as is.
+//   - list:<path> - reads passphrases from a file of
+//     '<machine>[@<secret>] <passphrase>' lines.
+//   - store[:<path>] - reads passphrases from an age-encrypted store,
+//     managed with 'klefkictl passphrases'.
+//   - vault[:<path>] - reads passphrases from HashiCorp Vault KV v2, at
+//     the provided path template, configured through the VAULT_*
+//     environment variables.
+//   - helper:<name> - gets passphrases from the klefki-credential-<name>
+//     credential helper.
+func parsePassphraseSources(ctx context.Context, specs []string) (PassphraseSource, error) {
+	sources := make(sourceChain, 0, len(specs))
+	for _, spec := range specs {
+		kind, path, _ := strings.Cut(spec, ":")
+		switch {
+		case kind == "tty" && path == "":
+			sources = append(sources, ttySource{})
+		case kind == "stdin" && path == "":
+			sources = append(sources, &stdinSource{})
+		case kind == "file" && path != "":
+			sources = append(sources, fileSource(path))
+		case kind == "list" && path != "":
+			ls, err := readListSource(path)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, ls)
+		case kind == "store":
+			if path == "" {
+				var err error
+				path, err = defaultStorePath()
+				if err != nil {
+					return nil, err
+				}
+			}
+			sources = append(sources, &storeSource{path: path})
+		case kind == "vault":
+			kv, err := vaultkv.New(vaultkv.ConfigFromEnv(path))
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, &vaultSource{ctx: ctx, kv: kv})
+		case kind == "helper" && path != "":
+			sources = append(sources, &helperSource{ctx: ctx, helper: &credhelper.Helper{Name: path}})
+		default:
+			return nil, fmt.Errorf("invalid passphrase source %q, expected tty, stdin, file:<path>, list:<path>, "+
+				"store[:<path>], vault[:<path>] or helper:<name>", spec)
+		}
+	}
+	return sources, nil
+}
+
+// defaultPassphraseSources returns the passphrase sources used when
+// none are provided: the default store, if it exists, then the
+// terminal, if interactive is set and there is one, or stdin.
+func defaultPassphraseSources(interactive bool) ([]string, error) {
+	var specs []string
+	path, err := defaultStorePath()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		specs = append(specs, "store")
+	}
+
+	if interactive {
+		if term.IsTerminal(int(os.Stdin.Fd())) { //nolint:gosec // Why: File descriptors fit in an int.
+			specs = append(specs, "tty")
+		} else {
+			specs = append(specs, "stdin")
+		}
+	}
+	return specs, nil
+}
+
+// passphraseSourceFromFlags returns the [PassphraseSource] provided
+// through the --passphrase-source flag of the provided command, or the
+// default ones, prompting if interactive is set, if not provided.
+func passphraseSourceFromFlags(cmd *cobra.Command, interactive bool) (PassphraseSource, error) {
+	flags := cmd.Flags()
+	specs, err := flags.GetStringSlice("passphrase-source")
+	if err != nil {
+		return nil, err
+	}
+	if !flags.Changed("passphrase-source") {
+		specs, err = defaultPassphraseSources(interactive)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return parsePassphraseSources(cmd.Context(), specs)
+}
+
+// sourceChain is a [PassphraseSource] trying each of its sources in
+// order, until one holds the passphrase.
+type sourceChain []PassphraseSource
+
+// Passphrase implements [PassphraseSource].
+func (sc sourceChain) Passphrase(m *pbgrpcv1.Machine, secret string) ([]byte, error) {
+	for _, s := range sc {
+		passphrase, err := s.Passphrase(m, secret)
+		if !errors.Is(err, errNoPassphrase) {
+			return passphrase, err
+		}
+	}
+	return nil, fmt.Errorf("%w for secret %q of machine %q", errNoPassphrase, secret, m.GetName())
+}
+
+// ttySource is a [PassphraseSource] prompting for passphrases on the
+// terminal, without echoing them.
+type ttySource struct{}
+
+// Passphrase implements [PassphraseSource].
+func (ttySource) Passphrase(m *pbgrpcv1.Machine, secret string) ([]byte, error) {
+	prompt := fmt.Sprintf("Passphrase for %s: ", m.GetName())
+	if len(m.GetSecrets()) > 1 {
+		prompt = fmt.Sprintf("Passphrase for secret %q of %s: ", secret, m.GetName())
+	}
+	return promptTTY(prompt)
+}
+
+// promptTTY prompts for a line on the terminal with the provided
+// prompt, without echoing it.
+func promptTTY(prompt string) ([]byte, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open terminal: %w", err)
+	}
+	defer tty.Close()
+
+	fmt.Fprint(tty, prompt)
+	line, err := term.ReadPassword(int(tty.Fd())) //nolint:gosec // Why: File descriptors fit in an int.
+	fmt.Fprintln(tty)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from terminal: %w", err)
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("passphrase is empty")
+	}
+	return line, nil
+}
+
+// stdinSource is a [PassphraseSource] reading a single passphrase or
+// keyfile from stdin, as is, which is used for every machine.
+type stdinSource struct {
+	passphrase []byte
+}
+
+// Passphrase implements [PassphraseSource].
+func (ss *stdinSource) Passphrase(_ *pbgrpcv1.Machine, _ string) ([]byte, error) {
+	if ss.passphrase == nil {
+		passphrase, err := readKeyFile("-")
+		if err != nil {
+			return nil, err
+		}
+		ss.passphrase = passphrase
+	}
+	return bytes.Clone(ss.passphrase), nil
+}
+
+// fileSource is a [PassphraseSource] reading a single passphrase or
+// keyfile from the file at its path, as is, which is used for every
+// machine.
+type fileSource string
+
+// Passphrase implements [PassphraseSource].
+func (fs fileSource) Passphrase(_ *pbgrpcv1.Machine, _ string) ([]byte, error) {
+	return readKeyFile(string(fs))
+}
+
+// readKeyFile reads the passphrase or keyfile at the provided path, or
+// stdin if -, as is.
+func readKeyFile(path string) ([]byte, error) {
+	var key []byte
+	var err error
+	if path == "-" {
+		key, err = io.ReadAll(os.Stdin)
+	} else {
+		key, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key is empty")
+	}
+	return key, nil
+}
+
+// listSource is a [PassphraseSource] reading passphrases from a file.
+// Each line is the ID, name or fingerprint of a machine, followed by @
+// and the name of one of its secrets for other than its default
+// secret, then whitespace and the passphrase. Empty lines and lines
+// starting with # are ignored.
+type listSource map[string][]byte
+
+// readListSource reads the passphrase list at the provided path.
+func readListSource(path string) (listSource, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+	defer clear(b)
+
+	ls := make(listSource)
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for i := 1; s.Scan(); i++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sep := strings.IndexFunc(line, unicode.IsSpace)
+		if sep == -1 {
+			return nil, fmt.Errorf("%s:%d: expected a machine and its passphrase", path, i)
+		}
+		ls[line[:sep]] = []byte(strings.TrimSpace(line[sep:]))
+	}
+	return ls, s.Err()
+}
+
+// Passphrase implements [PassphraseSource].
+func (ls listSource) Passphrase(m *pbgrpcv1.Machine, secret string) ([]byte, error) {
+	isDefault := defaultSecret(m) == secret
+	for _, ref := range []string{m.GetId(), m.GetName(), m.GetFingerprint()} {
+		if passphrase, ok := ls[ref+"@"+secret]; ok {
+			return bytes.Clone(passphrase), nil
+		}
+		if passphrase, ok := ls[ref]; ok && isDefault {
+			return bytes.Clone(passphrase), nil
+		}
+	}
+	return nil, errNoPassphrase
+}
+
+// defaultSecret returns the name of the default secret of the provided
+// machine, as returned by ListSessions.
+func defaultSecret(m *pbgrpcv1.Machine) string {
+	if len(m.GetSecrets()) == 0 {
+		return ""
+	}
+	return m.GetSecrets()[0].GetName()
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/credhelper"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// helperSource is a [PassphraseSource] getting passphrases from a
+// credential helper.
+type helperSource struct {
+	// ctx is the context of the command, as PassphraseSource doesn't
+	// take one.
+	ctx    context.Context
+	helper *credhelper.Helper
+}
+
+// Passphrase implements [PassphraseSource].
+func (hs *helperSource) Passphrase(m *pbgrpcv1.Machine, secret string) ([]byte, error) {
+	passphrase, err := hs.helper.Get(hs.ctx, &credhelper.Request{
+		MachineID:   m.GetId(),
+		Name:        m.GetName(),
+		Fingerprint: m.GetFingerprint(),
+		Labels:      m.GetLabels(),
+		Secret:      secret,
+	})
+	if errors.Is(err, credhelper.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %w", errNoPassphrase, err)
+	}
+	return passphrase, err
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"filippo.io/age"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// passphraseStore is the content of a passphrase store, which is
+// encrypted with age to a password.
+type passphraseStore struct {
+	// Machines are the passphrases of machines, by the fingerprint of
+	// the machine, then by the name of the secret.
+	Machines map[string]map[string][]byte `json:"machines"`
+}
+
+// defaultStorePath returns the path of the default passphrase store.
+func defaultStorePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find config directory: %w", err)
+	}
+	return filepath.Join(dir, "klefki", "passphrases.age"), nil
+}
+
+// openStore decrypts the passphrase store at the provided path with the
+// provided password.
+func openStore(path string, password []byte) (*passphraseStore, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open passphrase store: %w", err)
+	}
+	defer f.Close()
+
+	identity, err := age.NewScryptIdentity(string(password))
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := age.Decrypt(f, identity)
+	var nomatch *age.NoIdentityMatchError
+	if errors.As(err, &nomatch) {
+		return nil, fmt.Errorf("wrong password for passphrase store %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt passphrase store: %w", err)
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt passphrase store: %w", err)
+	}
+	defer clear(b)
+
+	var ps passphraseStore
+	if err := json.Unmarshal(b, &ps); err != nil {
+		return nil, fmt.Errorf("failed to parse passphrase store: %w", err)
+	}
+	if ps.Machines == nil {
+		ps.Machines = make(map[string]map[string][]byte)
+	}
+	return &ps, nil
+}
+
+// unlockStore prompts for the password of the passphrase store at the
+// provided path and decrypts it. The password is returned so that
+// changes can be saved.
+func unlockStore(path string) (*passphraseStore, []byte, error) {
+	password, err := promptTTY(fmt.Sprintf("Password for passphrase store %s: ", path))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps, err := openStore(path, password)
+	if err != nil {
+		clear(password)
+		return nil, nil, err
+	}
+	return ps, password, nil
+}
+
+// save encrypts the passphrase store with the provided password and
+// writes it to the provided path, readable only by its owner.
+func (ps *passphraseStore) save(path string, password []byte) error {
+	recipient, err := age.NewScryptRecipient(string(password))
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(ps)
+	if err != nil {
+		return fmt.Errorf("failed to encode passphrase store: %w", err)
+	}
+	defer clear(b)
+
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipient)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt passphrase store: %w", err)
+	}
+	if _, err := w.Write(b); err != nil {
+		return fmt.Errorf("failed to encrypt passphrase store: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to encrypt passphrase store: %w", err)
+	}
+
+	// Written next to the store first, so that it isn't lost if writing
+	// fails halfway.
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create passphrase store directory: %w", err)
+	}
+	if err := os.WriteFile(path+".new", buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write passphrase store: %w", err)
+	}
+	if err := os.Rename(path+".new", path); err != nil {
+		return fmt.Errorf("failed to replace passphrase store: %w", err)
+	}
+	return nil
+}
+
+// storeSource is a [PassphraseSource] reading passphrases from the
+// passphrase store at its path. The store is unlocked when first used,
+// so that its password is only asked for once.
+type storeSource struct {
+	path  string
+	store *passphraseStore
+}
+
+// unlock unlocks the passphrase store, if it isn't already.
+func (ss *storeSource) unlock() error {
+	if ss.store != nil {
+		return nil
+	}
+
+	ps, password, err := unlockStore(ss.path)
+	if err != nil {
+		return err
+	}
+	clear(password)
+	ss.store = ps
+	return nil
+}
+
+// Passphrase implements [PassphraseSource].
+func (ss *storeSource) Passphrase(m *pbgrpcv1.Machine, secret string) ([]byte, error) {
+	if err := ss.unlock(); err != nil {
+		return nil, err
+	}
+
+	passphrase, ok := ss.store.Machines[m.GetFingerprint()][secret]
+	if !ok {
+		return nil, errNoPassphrase
+	}
+	return bytes.Clone(passphrase), nil
+}
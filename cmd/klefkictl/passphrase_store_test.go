@@ -0,0 +1,69 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPassphraseStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "klefki", "passphrases.age")
+	ps := &passphraseStore{Machines: map[string]map[string][]byte{
+		"fingerprint1": {"root": []byte("root passphrase"), "tank": []byte("tank passphrase")},
+	}}
+	if err := ps.save(path, []byte("password")); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+
+	if _, err := openStore(path, []byte("wrong")); err == nil {
+		t.Error("openStore() with a wrong password error = nil, want error")
+	}
+	opened, err := openStore(path, []byte("password"))
+	if err != nil {
+		t.Fatalf("openStore() error = %v", err)
+	}
+
+	ss := &storeSource{path: path, store: opened}
+	tests := []struct {
+		fingerprint, secret string
+		want                string
+		wantErr             error
+	}{
+		{fingerprint: "fingerprint1", secret: "root", want: "root passphrase"},
+		{fingerprint: "fingerprint1", secret: "tank", want: "tank passphrase"},
+		{fingerprint: "fingerprint1", secret: "backup", wantErr: errNoPassphrase},
+		{fingerprint: "fingerprint2", secret: "root", wantErr: errNoPassphrase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fingerprint+"@"+tt.secret, func(t *testing.T) {
+			m := pbgrpcv1.Machine_builder{Fingerprint: proto.String(tt.fingerprint)}.Build()
+			got, err := ss.Passphrase(m, tt.secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Passphrase() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Passphrase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
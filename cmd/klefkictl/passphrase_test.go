@@ -0,0 +1,169 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListSourceSecrets(t *testing.T) {
+	pf := listSource{"nas1": []byte("root"), "nas1@tank": []byte("tank")}
+	m := pbgrpcv1.Machine_builder{Name: proto.String("nas1"), Secrets: []*pbgrpcv1.SecretStatus{
+		pbgrpcv1.SecretStatus_builder{Name: proto.String("root")}.Build(),
+		pbgrpcv1.SecretStatus_builder{Name: proto.String("tank")}.Build(),
+		pbgrpcv1.SecretStatus_builder{Name: proto.String("backup")}.Build(),
+	}}.Build()
+
+	tests := []struct {
+		secret  string
+		want    string
+		wantErr bool
+	}{
+		{secret: "root", want: "root"},
+		{secret: "tank", want: "tank"},
+		{secret: "backup", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.secret, func(t *testing.T) {
+			got, err := pf.Passphrase(m, tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Passphrase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Passphrase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadListSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			contents: "# comment\n\nnas1 correct horse\nnas2\tbattery staple \n",
+			want:     map[string]string{"nas1": "correct horse", "nas2": "battery staple"},
+		},
+		{name: "missing passphrase", contents: "nas1\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "passphrases")
+			if err := os.WriteFile(path, []byte(tt.contents), 0o600); err != nil {
+				t.Fatalf("failed to write passphrase file: %v", err)
+			}
+
+			pf, err := readListSource(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readListSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for ref, want := range tt.want {
+				got, err := pf.Passphrase(pbgrpcv1.Machine_builder{Name: proto.String(ref)}.Build(), "")
+				if err != nil {
+					t.Fatalf("Passphrase(%s) error = %v", ref, err)
+				}
+				if string(got) != want {
+					t.Errorf("Passphrase(%s) = %q, want %q", ref, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePassphraseSources(t *testing.T) {
+	list := filepath.Join(t.TempDir(), "passphrases")
+	if err := os.WriteFile(list, []byte("nas1 correct horse\n"), 0o600); err != nil {
+		t.Fatalf("failed to write passphrase file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		specs   []string
+		wantErr bool
+	}{
+		{name: "none", specs: nil},
+		{name: "all", specs: []string{"tty", "stdin", "file:/etc/key", "list:" + list, "store", "store:/tmp/store.age"}},
+		{name: "unknown", specs: []string{"keychain"}, wantErr: true},
+		{name: "file without path", specs: []string{"file"}, wantErr: true},
+		{name: "tty with path", specs: []string{"tty:/dev/tty1"}, wantErr: true},
+		{name: "missing list", specs: []string{"list:" + list + ".missing"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := parsePassphraseSources(context.Background(), tt.specs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePassphraseSources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(source.(sourceChain)) != len(tt.specs) {
+				t.Errorf("parsePassphraseSources() = %d sources, want %d", len(source.(sourceChain)), len(tt.specs))
+			}
+		})
+	}
+}
+
+func TestSourceChain(t *testing.T) {
+	keyfile := filepath.Join(t.TempDir(), "keyfile")
+	if err := os.WriteFile(keyfile, []byte{0x00, 0xff, '\n'}, 0o600); err != nil {
+		t.Fatalf("failed to write keyfile: %v", err)
+	}
+	m := pbgrpcv1.Machine_builder{Name: proto.String("nas1")}.Build()
+
+	tests := []struct {
+		name    string
+		chain   sourceChain
+		want    string
+		wantErr error
+	}{
+		{name: "first holds it", chain: sourceChain{listSource{"nas1": []byte("pp1")}, fileSource(keyfile)}, want: "pp1"},
+		{name: "falls through", chain: sourceChain{listSource{"nas2": []byte("pp2")}, fileSource(keyfile)}, want: "\x00\xff\n"},
+		{name: "none holds it", chain: sourceChain{listSource{"nas2": []byte("pp2")}}, wantErr: errNoPassphrase},
+		{name: "empty", chain: sourceChain{}, wantErr: errNoPassphrase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.chain.Passphrase(m, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Passphrase() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Passphrase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileSourceError(t *testing.T) {
+	// Failing to read a source the operator pointed at is reported,
+	// rather than falling through to the next source.
+	chain := sourceChain{fileSource(filepath.Join(t.TempDir(), "missing")), listSource{"nas1": []byte("pp1")}}
+	_, err := chain.Passphrase(pbgrpcv1.Machine_builder{Name: proto.String("nas1")}.Build(), "")
+	if err == nil || errors.Is(err, errNoPassphrase) {
+		t.Errorf("Passphrase() error = %v, want a read error", err)
+	}
+}
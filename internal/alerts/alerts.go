@@ -0,0 +1,95 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package alerts implements alerting operators about events that need
+// their attention, such as a machine booting something unexpected.
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+)
+
+// Alerter sends alerts to operators. Alerts are always logged and, if
+// a webhook is configured, posted to it as JSON.
+type Alerter struct {
+	// WebhookURL is the URL alerts are posted to. If empty, alerts are
+	// only logged.
+	WebhookURL string
+
+	// Client is the HTTP client used to post alerts, defaults to a
+	// client with a 10 second timeout.
+	Client *http.Client
+}
+
+// payload is the JSON body posted to the webhook.
+type payload struct {
+	Type              string    `json:"type"`
+	MachineID         string    `json:"machine_id,omitempty"`
+	OperatorID        string    `json:"operator_id,omitempty"`
+	MaintenanceWindow string    `json:"maintenance_window,omitempty"`
+	Details           string    `json:"details,omitempty"`
+	Time              time.Time `json:"time"`
+}
+
+// Send sends an alert about the provided event.
+func (a *Alerter) Send(ctx context.Context, ev *audit.Event) error {
+	fmt.Printf("ALERT: %s: machine %q: %s\n", ev.Type, ev.MachineID, ev.Details)
+	if a.WebhookURL == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(&payload{
+		Type:              ev.Type,
+		MachineID:         ev.MachineID,
+		OperatorID:        ev.OperatorID,
+		MaintenanceWindow: ev.MaintenanceWindow,
+		Details:           ev.Details,
+		Time:              time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.WebhookURL, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("failed to create alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := a.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send alert: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to send alert: webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package audit implements recording security relevant events, such as
+// keys being released, to the audit log.
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+)
+
+// Types of events recorded in the audit log.
+const (
+	// EventKeyReleased is recorded when a key is released to a machine.
+	EventKeyReleased = "key_released"
+
+	// EventSessionApproved is recorded when an operator approves the
+	// session of a machine.
+	EventSessionApproved = "session_approved"
+
+	// EventBootMeasurementMismatch is recorded when a machine reports
+	// boot measurements that aren't on its allowlist.
+	EventBootMeasurementMismatch = "boot_measurement_mismatch"
+
+	// EventHardwareBound is recorded when a machine is bound to the
+	// hardware attributes it reported for the first time.
+	EventHardwareBound = "hardware_bound"
+
+	// EventHardwareMismatch is recorded when a machine reports hardware
+	// attributes that don't match the ones it's bound to.
+	EventHardwareMismatch = "hardware_mismatch"
+
+	// EventHardwareAccepted is recorded when an operator accepts the
+	// mismatching hardware attributes a machine reported.
+	EventHardwareAccepted = "hardware_accepted"
+
+	// EventTangRecovery is recorded when a client recovers a key
+	// through the Tang endpoint.
+	EventTangRecovery = "tang_recovery"
+
+	// EventTangDenied is recorded when a client isn't allowed to
+	// recover a key through the Tang endpoint.
+	EventTangDenied = "tang_denied"
+
+	// EventMachineEnrolled is recorded when a machine enrolls itself
+	// with an enrollment token.
+	EventMachineEnrolled = "machine_enrolled"
+
+	// EventMachineConfirmed is recorded when an operator confirms a
+	// machine that enrolled itself.
+	EventMachineConfirmed = "machine_confirmed"
+
+	// EventMachineKeyRotated is recorded when a machine rotates its key.
+	EventMachineKeyRotated = "machine_key_rotated"
+
+	// EventMachineStateChanged is recorded when an operator changes the
+	// state of a machine, e.g., disabling it.
+	EventMachineStateChanged = "machine_state_changed"
+
+	// EventQuarantinedRequest is recorded when a quarantined machine
+	// makes a request.
+	EventQuarantinedRequest = "quarantined_request"
+
+	// EventSessionDenied is recorded when an operator denies the
+	// session of a machine.
+	EventSessionDenied = "session_denied"
+
+	// EventSessionCancelled is recorded when an operator cancels the
+	// session of a machine.
+	EventSessionCancelled = "session_cancelled"
+)
+
+// Event is an event to record in the audit log.
+type Event struct {
+	// Type is the type of the event, e.g., [EventKeyReleased].
+	Type string
+
+	// MachineID is the ID of the machine the event is about, if any.
+	MachineID string
+
+	// OperatorID is the fingerprint of the operator that caused the
+	// event, if any.
+	OperatorID string
+
+	// MaintenanceWindow is the name of the maintenance window that
+	// allowed the event, if any.
+	MaintenanceWindow string
+
+	// Details are human readable details of the event.
+	Details string
+}
+
+// Record records the provided event in the audit log of the provided
+// DB.
+func Record(ctx context.Context, db *ent.Client, e *Event) error {
+	if err := db.AuditEvent.Create().
+		SetType(e.Type).
+		SetMachineID(e.MachineID).
+		SetOperatorID(e.OperatorID).
+		SetMaintenanceWindow(e.MaintenanceWindow).
+		SetDetails(e.Details).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
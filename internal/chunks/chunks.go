@@ -0,0 +1,89 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package chunks implements sending messages through streaming RPCs
+// as [pbgrpcv1.KeyChunk]s, so that keys larger than the maximum size
+// of a gRPC message can be transferred.
+package chunks
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Size is the maximum size of the data of a chunk.
+const Size = 64 << 10
+
+// DefaultMaxKeySize is the default maximum size, in bytes, of keys once
+// encrypted, which bounds how much is received through [Receive].
+const DefaultMaxKeySize = 8 << 20
+
+// EnvelopeSize is how much larger than the key a message carrying it
+// may be.
+const EnvelopeSize = 4 << 10
+
+// Send marshals the provided message and sends it through send, split
+// into chunks of at most [Size] bytes.
+func Send(msg proto.Message, send func(*pbgrpcv1.KeyChunk) error) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	for len(b) > 0 {
+		n := min(len(b), Size)
+		chunk := &pbgrpcv1.KeyChunk{}
+		chunk.SetData(b[:n])
+		if err := send(chunk); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// Receive receives chunks through recv until it returns [io.EOF], then
+// unmarshals them into the provided message. If limit is positive, an
+// error is returned once more than limit bytes have been received.
+func Receive(recv func() (*pbgrpcv1.KeyChunk, error), limit int, msg proto.Message) error {
+	var b []byte
+	for {
+		chunk, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if limit > 0 && len(b)+len(chunk.GetData()) > limit {
+			return status.Errorf(codes.ResourceExhausted, "message is larger than the maximum of %d bytes", limit)
+		}
+		b = append(b, chunk.GetData()...)
+	}
+
+	if err := proto.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("failed to unmarshal message: %w", err)
+	}
+	return nil
+}
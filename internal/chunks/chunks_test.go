@@ -0,0 +1,142 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package chunks
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recorder records the chunks sent through it and replays them.
+type recorder struct {
+	chunks []*pbgrpcv1.KeyChunk
+}
+
+// send records the provided chunk.
+func (r *recorder) send(chunk *pbgrpcv1.KeyChunk) error {
+	r.chunks = append(r.chunks, chunk)
+	return nil
+}
+
+// recv returns the next recorded chunk, or [io.EOF] once all were.
+func (r *recorder) recv() (*pbgrpcv1.KeyChunk, error) {
+	if len(r.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := r.chunks[0]
+	r.chunks = r.chunks[1:]
+	return chunk, nil
+}
+
+func TestSendReceive(t *testing.T) {
+	tests := []struct {
+		name       string
+		keySize    int
+		limit      int
+		wantChunks int
+		wantCode   codes.Code
+	}{
+		{name: "empty key", keySize: 0, wantChunks: 1},
+		{name: "single chunk", keySize: 1 << 10, wantChunks: 1},
+		{name: "multiple chunks", keySize: 3 * Size, wantChunks: 4},
+		{name: "within limit", keySize: 3 * Size, limit: 3*Size + EnvelopeSize, wantChunks: 4},
+		{name: "over limit", keySize: 3 * Size, limit: 2 * Size, wantChunks: 4, wantCode: codes.ResourceExhausted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := bytes.Repeat([]byte{0xab}, tt.keySize)
+			req := &pbgrpcv1.SubmitKeyRequest{}
+			req.SetMachineId("machine")
+			req.SetSecret("tank")
+			req.SetEncKey(key)
+
+			r := &recorder{}
+			if err := Send(req, r.send); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if len(r.chunks) != tt.wantChunks {
+				t.Errorf("Send() sent %d chunks, want %d", len(r.chunks), tt.wantChunks)
+			}
+			for i, chunk := range r.chunks {
+				if len(chunk.GetData()) > Size {
+					t.Errorf("chunk %d is %d bytes, more than %d", i, len(chunk.GetData()), Size)
+				}
+			}
+
+			got := &pbgrpcv1.SubmitKeyRequest{}
+			err := Receive(r.recv, tt.limit, got)
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("Receive() error = %v, want code %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Receive() error = %v", err)
+			}
+			if got.GetMachineId() != "machine" || got.GetSecret() != "tank" || !bytes.Equal(got.GetEncKey(), key) {
+				t.Errorf("Receive() = %s/%s with a %d byte key, want machine/tank with %d bytes",
+					got.GetMachineId(), got.GetSecret(), len(got.GetEncKey()), len(key))
+			}
+		})
+	}
+}
+
+func TestReceiveErrors(t *testing.T) {
+	errRecv := errors.New("stream broken")
+	invalid := &pbgrpcv1.KeyChunk{}
+	invalid.SetData([]byte{0xff, 0xff, 0xff})
+
+	tests := []struct {
+		name string
+		recv func() (*pbgrpcv1.KeyChunk, error)
+	}{
+		{
+			name: "receive error",
+			recv: func() (*pbgrpcv1.KeyChunk, error) { return nil, errRecv },
+		},
+		{
+			name: "invalid message",
+			recv: (&recorder{chunks: []*pbgrpcv1.KeyChunk{invalid}}).recv,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Receive(tt.recv, 0, &pbgrpcv1.SubmitKeyRequest{}); err == nil {
+				t.Error("Receive() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestSendError(t *testing.T) {
+	errSend := errors.New("stream broken")
+	req := &pbgrpcv1.SubmitKeyRequest{}
+	req.SetEncKey([]byte("key"))
+
+	err := Send(req, func(*pbgrpcv1.KeyChunk) error { return errSend })
+	if !errors.Is(err, errSend) {
+		t.Errorf("Send() error = %v, want %v", err, errSend)
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package clevis implements the klefki pin for clevis, which binds data
+// to the key released by a klefki server for a machine. The data is
+// encrypted into a JWE with a key derived from the machine's key, and
+// the information needed to request it again is recorded in the JWE's
+// protected header.
+package clevis
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/pkg/client"
+)
+
+// Pin is the name of the klefki pin.
+const Pin = "klefki"
+
+// PollInterval is how often the server is asked for the key until it
+// has been released.
+const PollInterval = 5 * time.Second
+
+// info is used when deriving the content encryption key from the key
+// released by the server.
+const info = "klefki clevis pin"
+
+// Config is the configuration of the klefki pin, as provided when
+// binding and recorded in the JWE's protected header.
+type Config struct {
+	// URL is the address of the klefki server (e.g., 10.0.0.1:5300).
+	URL string `json:"url"`
+
+	// Key is the path to the machine's private key.
+	Key string `json:"key,omitempty"`
+
+	// Identity is the base64 encoded public key of the klefki server,
+	// used to verify its responses. When binding, it is fetched from
+	// the server if not provided.
+	Identity string `json:"identity,omitempty"`
+
+	// Salt is the base64 encoded salt used to derive the content
+	// encryption key. Generated when binding.
+	Salt string `json:"salt,omitempty"`
+}
+
+// header is the protected header of the JWE.
+type header struct {
+	Alg    string       `json:"alg"`
+	Enc    string       `json:"enc"`
+	Clevis clevisHeader `json:"clevis"`
+}
+
+// clevisHeader is the clevis section of the JWE's protected header.
+type clevisHeader struct {
+	Pin    string  `json:"pin"`
+	Klefki *Config `json:"klefki"`
+}
+
+// Encrypt encrypts the provided plaintext with a key derived from the
+// key released by the server in the provided configuration, returning
+// a compact JWE. This blocks until the server has released the key.
+func Encrypt(ctx context.Context, cfg *Config, plaintext []byte) ([]byte, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("missing url in configuration")
+	}
+	if cfg.Key == "" {
+		cfg.Key = client.DefaultKeyPath
+	}
+
+	kc, kcclose, err := client.Dial(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer kcclose() //nolint:errcheck // Why: Best effort
+
+	// Trust the identity of the server on first use, like tang does
+	// with its advertisement.
+	if cfg.Identity == "" {
+		idResp, err := kc.GetIdentity(ctx, &pbgrpcv1.GetIdentityRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get server identity: %w", err)
+		}
+		cfg.Identity = base64.StdEncoding.EncodeToString(idResp.GetPublicKey())
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	cfg.Salt = base64.StdEncoding.EncodeToString(salt)
+
+	cek, err := contentKey(ctx, kc, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return seal(cfg, cek, plaintext)
+}
+
+// seal encrypts the provided plaintext with the provided content
+// encryption key, returning a compact JWE with the provided
+// configuration in its header.
+func seal(cfg *Config, cek, plaintext []byte) ([]byte, error) {
+	hdr, err := json.Marshal(&header{
+		Alg:    "dir",
+		Enc:    "A256GCM",
+		Clevis: clevisHeader{Pin: Pin, Klefki: cfg},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode header: %w", err)
+	}
+	hdr64 := base64.RawURLEncoding.EncodeToString(hdr)
+
+	aead, err := newAEAD(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("failed to generate iv: %w", err)
+	}
+
+	sealed := aead.Seal(nil, iv, plaintext, []byte(hdr64))
+	ciphertext, tag := sealed[:len(sealed)-aead.Overhead()], sealed[len(sealed)-aead.Overhead():]
+
+	return []byte(strings.Join([]string{
+		hdr64, "",
+		base64.RawURLEncoding.EncodeToString(iv),
+		base64.RawURLEncoding.EncodeToString(ciphertext),
+		base64.RawURLEncoding.EncodeToString(tag),
+	}, ".")), nil
+}
+
+// Decrypt decrypts the provided compact JWE created by [Encrypt]. This
+// blocks until the server in its header has released the key.
+func Decrypt(ctx context.Context, jwe []byte) ([]byte, error) {
+	return open(jwe, func(cfg *Config) ([]byte, error) {
+		kc, kcclose, err := client.Dial(cfg.URL)
+		if err != nil {
+			return nil, err
+		}
+		defer kcclose() //nolint:errcheck // Why: Best effort
+
+		return contentKey(ctx, kc, cfg)
+	})
+}
+
+// open decrypts the provided compact JWE created by [seal], with the
+// content encryption key returned by getKey for the configuration in
+// its header.
+func open(jwe []byte, getKey func(cfg *Config) ([]byte, error)) ([]byte, error) {
+	parts := strings.Split(string(bytes.TrimSpace(jwe)), ".")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid JWE: expected 5 parts, got %d", len(parts))
+	}
+
+	hdrByt, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode header: %w", err)
+	}
+
+	var hdr header
+	if err := json.Unmarshal(hdrByt, &hdr); err != nil {
+		return nil, fmt.Errorf("failed to parse header: %w", err)
+	}
+	if hdr.Clevis.Pin != Pin || hdr.Clevis.Klefki == nil {
+		return nil, fmt.Errorf("JWE is not bound with the %s pin", Pin)
+	}
+	if hdr.Alg != "dir" || hdr.Enc != "A256GCM" {
+		return nil, fmt.Errorf("unsupported JWE algorithm %q/%q", hdr.Alg, hdr.Enc)
+	}
+
+	fields := make([][]byte, 0, 3)
+	for _, part := range parts[2:] {
+		field, err := base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JWE: %w", err)
+		}
+		fields = append(fields, field)
+	}
+	iv, ciphertext, tag := fields[0], fields[1], fields[2]
+
+	cek, err := getKey(hdr.Clevis.Klefki)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(cek)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid JWE: iv must be %d bytes", aead.NonceSize())
+	}
+
+	plaintext, err := aead.Open(nil, iv, append(ciphertext, tag...), []byte(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt JWE: %w", err)
+	}
+	return plaintext, nil
+}
+
+// contentKey requests the key for the machine in the provided
+// configuration from the server, waiting until it is released, and
+// derives the content encryption key from it.
+func contentKey(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, cfg *Config) ([]byte, error) {
+	identity, err := base64.StdEncoding.DecodeString(cfg.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode server identity: %w", err)
+	}
+	if len(identity) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("server identity must be %d bytes, got %d", ed25519.PublicKeySize, len(identity))
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(cfg.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode salt: %w", err)
+	}
+
+	keyPath := cfg.Key
+	if keyPath == "" {
+		keyPath = client.DefaultKeyPath
+	}
+	pk, err := client.ReadPrivateKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := client.WaitForKey(ctx, kc, pk, PollInterval, client.WithServerIdentity(identity))
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	cek, err := hkdf.Key(sha256.New, key, salt, info, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive content encryption key: %w", err)
+	}
+	return cek, nil
+}
+
+// newAEAD creates an AES-256-GCM [cipher.AEAD] from the content
+// encryption key.
+func newAEAD(cek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
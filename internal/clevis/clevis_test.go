@@ -0,0 +1,92 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package clevis
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	cfg := &Config{URL: "10.0.0.1:5300", Identity: "aWRlbnRpdHk=", Salt: "c2FsdA=="}
+	cek := bytes.Repeat([]byte{1}, 32)
+	plaintext := []byte("luks passphrase")
+
+	jwe, err := seal(cfg, cek, plaintext)
+	if err != nil {
+		t.Fatalf("seal() error = %v", err)
+	}
+
+	var got *Config
+	decrypted, err := open(jwe, func(c *Config) ([]byte, error) {
+		got = c
+		return cek, nil
+	})
+	if err != nil {
+		t.Fatalf("open() error = %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("open() = %q, want %q", decrypted, plaintext)
+	}
+
+	// The configuration needed to request the key again comes from the
+	// header.
+	if *got != *cfg {
+		t.Errorf("open() requested the key for %+v, want %+v", got, cfg)
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	cek := bytes.Repeat([]byte{1}, 32)
+	jwe, err := seal(&Config{URL: "10.0.0.1:5300"}, cek, []byte("luks passphrase"))
+	if err != nil {
+		t.Fatalf("seal() error = %v", err)
+	}
+	parts := strings.Split(string(jwe), ".")
+
+	// header returns the parts of the JWE with its header replaced.
+	header := func(hdr string) string {
+		return strings.Join(append([]string{base64.RawURLEncoding.EncodeToString([]byte(hdr))}, parts[1:]...), ".")
+	}
+	otherCfg, err := json.Marshal(&clevisHeader{Pin: Pin, Klefki: &Config{URL: "10.0.0.2:5300"}})
+	if err != nil {
+		t.Fatalf("failed to encode header: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		jwe  string
+		cek  []byte
+	}{
+		{name: "other key", jwe: string(jwe), cek: bytes.Repeat([]byte{2}, 32)},
+		{name: "truncated", jwe: strings.Join(parts[:4], "."), cek: cek},
+		{name: "other pin", jwe: header(`{"alg":"dir","enc":"A256GCM","clevis":{"pin":"tang","tang":{}}}`), cek: cek},
+		{name: "other algorithm", jwe: header(`{"alg":"ECDH-ES","enc":"A256GCM","clevis":{"pin":"klefki","klefki":{}}}`), cek: cek},
+		{name: "tampered header", jwe: header(`{"alg":"dir","enc":"A256GCM","clevis":` + string(otherCfg) + `}`), cek: cek},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := open([]byte(tt.jwe), func(*Config) ([]byte, error) { return tt.cek, nil }); err == nil {
+				t.Error("open() error = nil, want error")
+			}
+		})
+	}
+}
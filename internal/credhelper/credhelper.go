@@ -0,0 +1,212 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package credhelper implements the credential-helper protocol, which
+// reads the keys of machines from external programs, in the spirit of
+// git and docker credential helpers.
+//
+// The helper with a name is the klefki-credential-<name> program in
+// PATH. It's run with the operation as its only argument, currently
+// always get, and a JSON [Request] on stdin. It must write a JSON
+// [Response] to stdout and exit with 0, with no secret (or nothing) if
+// it doesn't hold the key. Exiting with anything else is an error, which is
+// reported with what the helper wrote to stderr. Helpers that don't
+// exit within the timeout are killed.
+package credhelper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is how long helpers have to respond by default.
+const DefaultTimeout = 10 * time.Second
+
+// maxStderr is the maximum length of what a helper wrote to stderr that
+// is reported in errors.
+const maxStderr = 512
+
+// ErrNotFound is returned when a helper doesn't hold the key of a
+// machine.
+var ErrNotFound = errors.New("credential helper has no key for machine")
+
+// Request is the request a helper reads from stdin.
+type Request struct {
+	// MachineID is the ID of the machine.
+	MachineID string `json:"machine_id"`
+
+	// Name is the name of the machine.
+	Name string `json:"name"`
+
+	// Fingerprint is the fingerprint of the public key of the machine.
+	Fingerprint string `json:"fingerprint"`
+
+	// Labels are the labels of the machine.
+	Labels map[string]string `json:"labels"`
+
+	// Secret is the name of the secret to get the key of.
+	Secret string `json:"secret"`
+}
+
+// Response is the response a helper writes to stdout.
+type Response struct {
+	// Secret is the key, as is. Empty if the helper doesn't hold it.
+	Secret string `json:"secret,omitempty"`
+
+	// SecretBase64 is the key, base64 encoded, for binary keyfiles.
+	// Used instead of Secret if set.
+	SecretBase64 string `json:"secret_base64,omitempty"`
+
+	// TTL is how long, in seconds, the key may be cached in memory. If
+	// zero, it's not cached and the helper is run every time it's
+	// needed.
+	TTL int `json:"ttl,omitempty"`
+}
+
+// cached is a key cached in memory.
+type cached struct {
+	key       []byte
+	expiresAt time.Time
+}
+
+// Helper runs a credential helper.
+type Helper struct {
+	// Name is the name of the helper, which is run as
+	// klefki-credential-<name>.
+	Name string
+
+	// Timeout is how long the helper has to respond, defaults to
+	// [DefaultTimeout].
+	Timeout time.Duration
+
+	// cache is a machine_id/secret -> key map of the keys returned with
+	// a TTL.
+	cache   map[string]*cached
+	cacheMu sync.Mutex
+}
+
+// Program returns the name of the program of the helper.
+func (h *Helper) Program() string {
+	return "klefki-credential-" + h.Name
+}
+
+// Get returns the key of the secret of the machine in the provided
+// request, from the cache if it hasn't expired yet. [ErrNotFound] is
+// returned if the helper doesn't hold the key.
+func (h *Helper) Get(ctx context.Context, req *Request) ([]byte, error) {
+	cacheKey := req.MachineID + "/" + req.Secret
+	h.cacheMu.Lock()
+	if c, ok := h.cache[cacheKey]; ok {
+		if time.Now().Before(c.expiresAt) {
+			h.cacheMu.Unlock()
+			return bytes.Clone(c.key), nil
+		}
+		clear(c.key)
+		delete(h.cache, cacheKey)
+	}
+	h.cacheMu.Unlock()
+
+	resp, err := h.run(ctx, "get", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var key []byte
+	if resp.SecretBase64 != "" {
+		key, err = base64.StdEncoding.DecodeString(resp.SecretBase64)
+		if err != nil {
+			return nil, fmt.Errorf("credential helper %s returned invalid secret_base64: %w", h.Name, err)
+		}
+	} else {
+		key = []byte(resp.Secret)
+	}
+	if len(key) == 0 {
+		return nil, ErrNotFound
+	}
+
+	if resp.TTL > 0 {
+		h.cacheMu.Lock()
+		if h.cache == nil {
+			h.cache = make(map[string]*cached)
+		}
+		h.cache[cacheKey] = &cached{
+			key:       bytes.Clone(key),
+			expiresAt: time.Now().Add(time.Duration(resp.TTL) * time.Second),
+		}
+		h.cacheMu.Unlock()
+	}
+	return key, nil
+}
+
+// run runs the helper with the provided operation and request, and
+// returns its response.
+func (h *Helper) run(ctx context.Context, op string, req *Request) (*Response, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode credential helper request: %w", err)
+	}
+
+	timeout := h.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.Program(), op) //nolint:gosec // Why: Helpers are configured by the operator.
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Children of the helper holding its output open aren't waited for
+	// once it's killed.
+	cmd.WaitDelay = time.Second
+	defer clear(stdout.Bytes())
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("credential helper %s didn't respond within %s", h.Name, timeout)
+		}
+
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxStderr {
+			msg = msg[:maxStderr] + "..."
+		}
+		if msg != "" {
+			return nil, fmt.Errorf("credential helper %s failed: %w: %s", h.Name, err, msg)
+		}
+		return nil, fmt.Errorf("credential helper %s failed: %w", h.Name, err)
+	}
+
+	// Nothing written is the same as no secret.
+	var resp Response
+	if len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return &resp, nil
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("credential helper %s returned an invalid response: %w", h.Name, err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package credhelper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// helpers are the scripts of the credential helpers used in tests, by
+// name. The calls helper appends a line to the file in $CALLS for every
+// call.
+var helpers = map[string]string{
+	"static": `req=$(cat)
+case "$req" in
+*'"name":"nas1"'*'"secret":"root"'*) echo '{"secret": "root passphrase"}' ;;
+*) echo '{}' ;;
+esac`,
+	"binary":  `echo '{"secret_base64": "AP8K"}'`,
+	"silent":  `exit 0`,
+	"failing": `echo 'vault is sealed' >&2; exit 1`,
+	"invalid": `echo 'not json'`,
+	"slow":    `exec sleep 10`,
+	"calls":   `echo "$1" >>"$CALLS"; echo '{"secret": "cached passphrase", "ttl": 60}'`,
+}
+
+// installHelpers writes the helpers to a new directory, which is
+// prepended to PATH.
+func installHelpers(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, script := range helpers {
+		path := filepath.Join(dir, "klefki-credential-"+name)
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o700); err != nil { //nolint:gosec // Why: Must be executable.
+			t.Fatalf("failed to write helper: %v", err)
+		}
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestGet(t *testing.T) {
+	installHelpers(t)
+
+	tests := []struct {
+		name    string
+		helper  string
+		req     Request
+		want    string
+		wantErr string
+	}{
+		{name: "found", helper: "static", req: Request{Name: "nas1", Secret: "root"}, want: "root passphrase"},
+		{name: "not held", helper: "static", req: Request{Name: "nas2", Secret: "root"}, wantErr: ErrNotFound.Error()},
+		{name: "base64", helper: "binary", want: "\x00\xff\n"},
+		{name: "no output", helper: "silent", wantErr: ErrNotFound.Error()},
+		{name: "failing", helper: "failing", wantErr: "vault is sealed"},
+		{name: "invalid response", helper: "invalid", wantErr: "invalid response"},
+		{name: "timeout", helper: "slow", wantErr: "didn't respond within"},
+		{name: "not installed", helper: "missing", wantErr: "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Helper{Name: tt.helper, Timeout: 500 * time.Millisecond}
+			got, err := h.Get(context.Background(), &tt.req)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Get() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Get() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	installHelpers(t)
+
+	h := &Helper{Name: "static"}
+	if _, err := h.Get(context.Background(), &Request{Name: "nas2"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestGetCache(t *testing.T) {
+	installHelpers(t)
+	calls := filepath.Join(t.TempDir(), "calls")
+	t.Setenv("CALLS", calls)
+
+	h := &Helper{Name: "calls"}
+	for _, req := range []*Request{
+		{MachineID: "nas1", Secret: "root"},
+		{MachineID: "nas1", Secret: "root"},
+		{MachineID: "nas1", Secret: "tank"},
+	} {
+		got, err := h.Get(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if string(got) != "cached passphrase" {
+			t.Errorf("Get() = %q, want %q", got, "cached passphrase")
+		}
+
+		// The cached key must not be changed through what's returned.
+		clear(got)
+	}
+
+	b, err := os.ReadFile(calls)
+	if err != nil {
+		t.Fatalf("failed to read calls: %v", err)
+	}
+	if got := strings.Count(string(b), "get\n"); got != 2 {
+		t.Errorf("helper calls = %d, want 2 (once per secret)", got)
+	}
+}
@@ -20,10 +20,15 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"github.com/ncruces/go-sqlite3"
 
 	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
 	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
@@ -31,15 +36,76 @@ import (
 
 // New creates a new connection to the DB.
 func New(ctx context.Context) (*ent.Client, error) {
-	client, err := ent.Open(dialect.SQLite, "file:data/klefki.db")
+	drv, err := entsql.Open(dialect.SQLite, "file:data/klefki.db")
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 
 	// Run the automatic migration tool to create all schema resources.
 	if err := client.Schema.Create(ctx); err != nil {
 		return nil, fmt.Errorf("failed to run DB migrations: %w", err)
 	}
 
+	// Machines created before their IDs were separate from the
+	// fingerprints of their keys used the fingerprint as the ID.
+	ms, err := client.Machine.Query().Where(machine.FingerprintIsNil()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machines without a fingerprint: %w", err)
+	}
+	for _, m := range ms {
+		if err := m.Update().SetFingerprint(m.ID).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to set fingerprint of machine %q: %w", m.ID, err)
+		}
+	}
+
+	if err := convertCreatedAt(ctx, drv.DB()); err != nil {
+		return nil, err
+	}
+
 	return client, nil
 }
+
+// convertCreatedAt converts the creation times of machines created
+// before they were stored as times, when they were RFC3339 strings, to
+// the format times are stored in now. The time of machines whose
+// creation time can't be parsed is unknown, so they're set to now,
+// which they're known to have been created by.
+func convertCreatedAt(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, "SELECT id, CAST(created_at AS TEXT) FROM machines")
+	if err != nil {
+		return fmt.Errorf("failed to get creation times of machines: %w", err)
+	}
+	defer rows.Close()
+
+	converted := make(map[string]time.Time)
+	for rows.Next() {
+		var id string
+		var createdAt sql.NullString
+		if err := rows.Scan(&id, &createdAt); err != nil {
+			return fmt.Errorf("failed to read creation time of machine: %w", err)
+		}
+
+		ts, err := sqlite3.TimeFormatAuto.Decode(createdAt.String)
+		if err != nil {
+			converted[id] = time.Now()
+			continue
+		}
+		if sqlite3.TimeFormatDefault.Encode(ts) != createdAt.String {
+			converted[id] = ts
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read creation times of machines: %w", err)
+	}
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("failed to read creation times of machines: %w", err)
+	}
+
+	for id, ts := range converted {
+		if _, err := db.ExecContext(ctx, "UPDATE machines SET created_at = ? WHERE id = ?", ts, id); err != nil {
+			return fmt.Errorf("failed to convert creation time of machine %q: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ncruces/go-sqlite3"
+)
+
+func TestConvertCreatedAt(t *testing.T) {
+	ctx := context.Background()
+	sqldb, err := sql.Open("sqlite3", "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer sqldb.Close()
+
+	if _, err := sqldb.ExecContext(ctx, "CREATE TABLE machines (id TEXT PRIMARY KEY, created_at DATETIME)"); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := map[string]any{
+		"legacy":    created.Format(time.RFC3339),
+		"current":   sqlite3.TimeFormatDefault.Encode(created),
+		"unparsed":  "not a time",
+		"missingts": nil,
+	}
+	for id, createdAt := range rows {
+		if _, err := sqldb.ExecContext(ctx, "INSERT INTO machines (id, created_at) VALUES (?, ?)", id, createdAt); err != nil {
+			t.Fatalf("failed to insert machine %q: %v", id, err)
+		}
+	}
+
+	before := time.Now()
+	if err := convertCreatedAt(ctx, sqldb); err != nil {
+		t.Fatalf("convertCreatedAt() error = %v", err)
+	}
+
+	tests := []struct {
+		id   string
+		want func(time.Time) bool
+	}{
+		{id: "legacy", want: created.Equal},
+		{id: "current", want: created.Equal},
+		{id: "unparsed", want: func(ts time.Time) bool { return !ts.Before(before.Truncate(time.Millisecond)) }},
+		{id: "missingts", want: func(ts time.Time) bool { return !ts.Before(before.Truncate(time.Millisecond)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			var got string
+			err := sqldb.QueryRowContext(ctx, "SELECT CAST(created_at AS TEXT) FROM machines WHERE id = ?", tt.id).Scan(&got)
+			if err != nil {
+				t.Fatalf("failed to get creation time: %v", err)
+			}
+
+			ts, err := sqlite3.TimeFormatDefault.Decode(got)
+			if err != nil {
+				t.Fatalf("creation time %q isn't in the default format: %v", got, err)
+			}
+			if sqlite3.TimeFormatDefault.Encode(ts) != got {
+				t.Errorf("creation time %q isn't in the default format", got)
+			}
+			if !tt.want(ts) {
+				t.Errorf("creation time = %v, unexpected", ts)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+)
+
+// BootMeasurement is the model entity for the BootMeasurement schema.
+type BootMeasurement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Fingerprint of the machine these measurements are allowed for
+	MachineID string `json:"machine_id,omitempty"`
+	// Hex encoded SHA256 of the kernel, empty to allow any
+	Kernel string `json:"kernel,omitempty"`
+	// Hex encoded SHA256 of the initramfs, empty to allow any
+	Initramfs string `json:"initramfs,omitempty"`
+	// Hex encoded SHA256 of the kernel command line, empty to allow any
+	Cmdline string `json:"cmdline,omitempty"`
+	// User friendly description of these measurements (e.g., kernel version)
+	Description string `json:"description,omitempty"`
+	// When these measurements were added
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BootMeasurement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bootmeasurement.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bootmeasurement.FieldMachineID, bootmeasurement.FieldKernel, bootmeasurement.FieldInitramfs, bootmeasurement.FieldCmdline, bootmeasurement.FieldDescription:
+			values[i] = new(sql.NullString)
+		case bootmeasurement.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BootMeasurement fields.
+func (_m *BootMeasurement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bootmeasurement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case bootmeasurement.FieldMachineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value.Valid {
+				_m.MachineID = value.String
+			}
+		case bootmeasurement.FieldKernel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kernel", values[i])
+			} else if value.Valid {
+				_m.Kernel = value.String
+			}
+		case bootmeasurement.FieldInitramfs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field initramfs", values[i])
+			} else if value.Valid {
+				_m.Initramfs = value.String
+			}
+		case bootmeasurement.FieldCmdline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cmdline", values[i])
+			} else if value.Valid {
+				_m.Cmdline = value.String
+			}
+		case bootmeasurement.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bootmeasurement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BootMeasurement.
+// This includes values selected through modifiers, order, etc.
+func (_m *BootMeasurement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BootMeasurement.
+// Note that you need to call BootMeasurement.Unwrap() before calling this method if this BootMeasurement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BootMeasurement) Update() *BootMeasurementUpdateOne {
+	return NewBootMeasurementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BootMeasurement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BootMeasurement) Unwrap() *BootMeasurement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BootMeasurement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BootMeasurement) String() string {
+	var builder strings.Builder
+	builder.WriteString("BootMeasurement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("machine_id=")
+	builder.WriteString(_m.MachineID)
+	builder.WriteString(", ")
+	builder.WriteString("kernel=")
+	builder.WriteString(_m.Kernel)
+	builder.WriteString(", ")
+	builder.WriteString("initramfs=")
+	builder.WriteString(_m.Initramfs)
+	builder.WriteString(", ")
+	builder.WriteString("cmdline=")
+	builder.WriteString(_m.Cmdline)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BootMeasurements is a parsable slice of BootMeasurement.
+type BootMeasurements []*BootMeasurement
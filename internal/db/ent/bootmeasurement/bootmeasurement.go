@@ -0,0 +1,96 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bootmeasurement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bootmeasurement type in the database.
+	Label = "boot_measurement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldKernel holds the string denoting the kernel field in the database.
+	FieldKernel = "kernel"
+	// FieldInitramfs holds the string denoting the initramfs field in the database.
+	FieldInitramfs = "initramfs"
+	// FieldCmdline holds the string denoting the cmdline field in the database.
+	FieldCmdline = "cmdline"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the bootmeasurement in the database.
+	Table = "boot_measurements"
+)
+
+// Columns holds all SQL columns for bootmeasurement fields.
+var Columns = []string{
+	FieldID,
+	FieldMachineID,
+	FieldKernel,
+	FieldInitramfs,
+	FieldCmdline,
+	FieldDescription,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
+	MachineIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BootMeasurement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByKernel orders the results by the kernel field.
+func ByKernel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKernel, opts...).ToFunc()
+}
+
+// ByInitramfs orders the results by the initramfs field.
+func ByInitramfs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInitramfs, opts...).ToFunc()
+}
+
+// ByCmdline orders the results by the cmdline field.
+func ByCmdline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCmdline, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bootmeasurement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldID, id))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldMachineID, v))
+}
+
+// Kernel applies equality check predicate on the "kernel" field. It's identical to KernelEQ.
+func Kernel(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldKernel, v))
+}
+
+// Initramfs applies equality check predicate on the "initramfs" field. It's identical to InitramfsEQ.
+func Initramfs(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldInitramfs, v))
+}
+
+// Cmdline applies equality check predicate on the "cmdline" field. It's identical to CmdlineEQ.
+func Cmdline(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldCmdline, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// MachineIDGT applies the GT predicate on the "machine_id" field.
+func MachineIDGT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldMachineID, v))
+}
+
+// MachineIDGTE applies the GTE predicate on the "machine_id" field.
+func MachineIDGTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldMachineID, v))
+}
+
+// MachineIDLT applies the LT predicate on the "machine_id" field.
+func MachineIDLT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldMachineID, v))
+}
+
+// MachineIDLTE applies the LTE predicate on the "machine_id" field.
+func MachineIDLTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldMachineID, v))
+}
+
+// MachineIDContains applies the Contains predicate on the "machine_id" field.
+func MachineIDContains(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContains(FieldMachineID, v))
+}
+
+// MachineIDHasPrefix applies the HasPrefix predicate on the "machine_id" field.
+func MachineIDHasPrefix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasPrefix(FieldMachineID, v))
+}
+
+// MachineIDHasSuffix applies the HasSuffix predicate on the "machine_id" field.
+func MachineIDHasSuffix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasSuffix(FieldMachineID, v))
+}
+
+// MachineIDEqualFold applies the EqualFold predicate on the "machine_id" field.
+func MachineIDEqualFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEqualFold(FieldMachineID, v))
+}
+
+// MachineIDContainsFold applies the ContainsFold predicate on the "machine_id" field.
+func MachineIDContainsFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContainsFold(FieldMachineID, v))
+}
+
+// KernelEQ applies the EQ predicate on the "kernel" field.
+func KernelEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldKernel, v))
+}
+
+// KernelNEQ applies the NEQ predicate on the "kernel" field.
+func KernelNEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldKernel, v))
+}
+
+// KernelIn applies the In predicate on the "kernel" field.
+func KernelIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldKernel, vs...))
+}
+
+// KernelNotIn applies the NotIn predicate on the "kernel" field.
+func KernelNotIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldKernel, vs...))
+}
+
+// KernelGT applies the GT predicate on the "kernel" field.
+func KernelGT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldKernel, v))
+}
+
+// KernelGTE applies the GTE predicate on the "kernel" field.
+func KernelGTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldKernel, v))
+}
+
+// KernelLT applies the LT predicate on the "kernel" field.
+func KernelLT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldKernel, v))
+}
+
+// KernelLTE applies the LTE predicate on the "kernel" field.
+func KernelLTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldKernel, v))
+}
+
+// KernelContains applies the Contains predicate on the "kernel" field.
+func KernelContains(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContains(FieldKernel, v))
+}
+
+// KernelHasPrefix applies the HasPrefix predicate on the "kernel" field.
+func KernelHasPrefix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasPrefix(FieldKernel, v))
+}
+
+// KernelHasSuffix applies the HasSuffix predicate on the "kernel" field.
+func KernelHasSuffix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasSuffix(FieldKernel, v))
+}
+
+// KernelIsNil applies the IsNil predicate on the "kernel" field.
+func KernelIsNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIsNull(FieldKernel))
+}
+
+// KernelNotNil applies the NotNil predicate on the "kernel" field.
+func KernelNotNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotNull(FieldKernel))
+}
+
+// KernelEqualFold applies the EqualFold predicate on the "kernel" field.
+func KernelEqualFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEqualFold(FieldKernel, v))
+}
+
+// KernelContainsFold applies the ContainsFold predicate on the "kernel" field.
+func KernelContainsFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContainsFold(FieldKernel, v))
+}
+
+// InitramfsEQ applies the EQ predicate on the "initramfs" field.
+func InitramfsEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldInitramfs, v))
+}
+
+// InitramfsNEQ applies the NEQ predicate on the "initramfs" field.
+func InitramfsNEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldInitramfs, v))
+}
+
+// InitramfsIn applies the In predicate on the "initramfs" field.
+func InitramfsIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldInitramfs, vs...))
+}
+
+// InitramfsNotIn applies the NotIn predicate on the "initramfs" field.
+func InitramfsNotIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldInitramfs, vs...))
+}
+
+// InitramfsGT applies the GT predicate on the "initramfs" field.
+func InitramfsGT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldInitramfs, v))
+}
+
+// InitramfsGTE applies the GTE predicate on the "initramfs" field.
+func InitramfsGTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldInitramfs, v))
+}
+
+// InitramfsLT applies the LT predicate on the "initramfs" field.
+func InitramfsLT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldInitramfs, v))
+}
+
+// InitramfsLTE applies the LTE predicate on the "initramfs" field.
+func InitramfsLTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldInitramfs, v))
+}
+
+// InitramfsContains applies the Contains predicate on the "initramfs" field.
+func InitramfsContains(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContains(FieldInitramfs, v))
+}
+
+// InitramfsHasPrefix applies the HasPrefix predicate on the "initramfs" field.
+func InitramfsHasPrefix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasPrefix(FieldInitramfs, v))
+}
+
+// InitramfsHasSuffix applies the HasSuffix predicate on the "initramfs" field.
+func InitramfsHasSuffix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasSuffix(FieldInitramfs, v))
+}
+
+// InitramfsIsNil applies the IsNil predicate on the "initramfs" field.
+func InitramfsIsNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIsNull(FieldInitramfs))
+}
+
+// InitramfsNotNil applies the NotNil predicate on the "initramfs" field.
+func InitramfsNotNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotNull(FieldInitramfs))
+}
+
+// InitramfsEqualFold applies the EqualFold predicate on the "initramfs" field.
+func InitramfsEqualFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEqualFold(FieldInitramfs, v))
+}
+
+// InitramfsContainsFold applies the ContainsFold predicate on the "initramfs" field.
+func InitramfsContainsFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContainsFold(FieldInitramfs, v))
+}
+
+// CmdlineEQ applies the EQ predicate on the "cmdline" field.
+func CmdlineEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldCmdline, v))
+}
+
+// CmdlineNEQ applies the NEQ predicate on the "cmdline" field.
+func CmdlineNEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldCmdline, v))
+}
+
+// CmdlineIn applies the In predicate on the "cmdline" field.
+func CmdlineIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldCmdline, vs...))
+}
+
+// CmdlineNotIn applies the NotIn predicate on the "cmdline" field.
+func CmdlineNotIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldCmdline, vs...))
+}
+
+// CmdlineGT applies the GT predicate on the "cmdline" field.
+func CmdlineGT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldCmdline, v))
+}
+
+// CmdlineGTE applies the GTE predicate on the "cmdline" field.
+func CmdlineGTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldCmdline, v))
+}
+
+// CmdlineLT applies the LT predicate on the "cmdline" field.
+func CmdlineLT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldCmdline, v))
+}
+
+// CmdlineLTE applies the LTE predicate on the "cmdline" field.
+func CmdlineLTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldCmdline, v))
+}
+
+// CmdlineContains applies the Contains predicate on the "cmdline" field.
+func CmdlineContains(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContains(FieldCmdline, v))
+}
+
+// CmdlineHasPrefix applies the HasPrefix predicate on the "cmdline" field.
+func CmdlineHasPrefix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasPrefix(FieldCmdline, v))
+}
+
+// CmdlineHasSuffix applies the HasSuffix predicate on the "cmdline" field.
+func CmdlineHasSuffix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasSuffix(FieldCmdline, v))
+}
+
+// CmdlineIsNil applies the IsNil predicate on the "cmdline" field.
+func CmdlineIsNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIsNull(FieldCmdline))
+}
+
+// CmdlineNotNil applies the NotNil predicate on the "cmdline" field.
+func CmdlineNotNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotNull(FieldCmdline))
+}
+
+// CmdlineEqualFold applies the EqualFold predicate on the "cmdline" field.
+func CmdlineEqualFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEqualFold(FieldCmdline, v))
+}
+
+// CmdlineContainsFold applies the ContainsFold predicate on the "cmdline" field.
+func CmdlineContainsFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContainsFold(FieldCmdline, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BootMeasurement) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BootMeasurement) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BootMeasurement) predicate.BootMeasurement {
+	return predicate.BootMeasurement(sql.NotPredicates(p))
+}
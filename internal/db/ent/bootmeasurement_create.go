@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+)
+
+// BootMeasurementCreate is the builder for creating a BootMeasurement entity.
+type BootMeasurementCreate struct {
+	config
+	mutation *BootMeasurementMutation
+	hooks    []Hook
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *BootMeasurementCreate) SetMachineID(v string) *BootMeasurementCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetKernel sets the "kernel" field.
+func (_c *BootMeasurementCreate) SetKernel(v string) *BootMeasurementCreate {
+	_c.mutation.SetKernel(v)
+	return _c
+}
+
+// SetNillableKernel sets the "kernel" field if the given value is not nil.
+func (_c *BootMeasurementCreate) SetNillableKernel(v *string) *BootMeasurementCreate {
+	if v != nil {
+		_c.SetKernel(*v)
+	}
+	return _c
+}
+
+// SetInitramfs sets the "initramfs" field.
+func (_c *BootMeasurementCreate) SetInitramfs(v string) *BootMeasurementCreate {
+	_c.mutation.SetInitramfs(v)
+	return _c
+}
+
+// SetNillableInitramfs sets the "initramfs" field if the given value is not nil.
+func (_c *BootMeasurementCreate) SetNillableInitramfs(v *string) *BootMeasurementCreate {
+	if v != nil {
+		_c.SetInitramfs(*v)
+	}
+	return _c
+}
+
+// SetCmdline sets the "cmdline" field.
+func (_c *BootMeasurementCreate) SetCmdline(v string) *BootMeasurementCreate {
+	_c.mutation.SetCmdline(v)
+	return _c
+}
+
+// SetNillableCmdline sets the "cmdline" field if the given value is not nil.
+func (_c *BootMeasurementCreate) SetNillableCmdline(v *string) *BootMeasurementCreate {
+	if v != nil {
+		_c.SetCmdline(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BootMeasurementCreate) SetDescription(v string) *BootMeasurementCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BootMeasurementCreate) SetNillableDescription(v *string) *BootMeasurementCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BootMeasurementCreate) SetCreatedAt(v time.Time) *BootMeasurementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BootMeasurementCreate) SetNillableCreatedAt(v *time.Time) *BootMeasurementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BootMeasurementMutation object of the builder.
+func (_c *BootMeasurementCreate) Mutation() *BootMeasurementMutation {
+	return _c.mutation
+}
+
+// Save creates the BootMeasurement in the database.
+func (_c *BootMeasurementCreate) Save(ctx context.Context) (*BootMeasurement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BootMeasurementCreate) SaveX(ctx context.Context) *BootMeasurement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BootMeasurementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BootMeasurementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BootMeasurementCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bootmeasurement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BootMeasurementCreate) check() error {
+	if _, ok := _c.mutation.MachineID(); !ok {
+		return &ValidationError{Name: "machine_id", err: errors.New(`ent: missing required field "BootMeasurement.machine_id"`)}
+	}
+	if v, ok := _c.mutation.MachineID(); ok {
+		if err := bootmeasurement.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`ent: validator failed for field "BootMeasurement.machine_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BootMeasurement.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BootMeasurementCreate) sqlSave(ctx context.Context) (*BootMeasurement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BootMeasurementCreate) createSpec() (*BootMeasurement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BootMeasurement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bootmeasurement.Table, sqlgraph.NewFieldSpec(bootmeasurement.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.MachineID(); ok {
+		_spec.SetField(bootmeasurement.FieldMachineID, field.TypeString, value)
+		_node.MachineID = value
+	}
+	if value, ok := _c.mutation.Kernel(); ok {
+		_spec.SetField(bootmeasurement.FieldKernel, field.TypeString, value)
+		_node.Kernel = value
+	}
+	if value, ok := _c.mutation.Initramfs(); ok {
+		_spec.SetField(bootmeasurement.FieldInitramfs, field.TypeString, value)
+		_node.Initramfs = value
+	}
+	if value, ok := _c.mutation.Cmdline(); ok {
+		_spec.SetField(bootmeasurement.FieldCmdline, field.TypeString, value)
+		_node.Cmdline = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bootmeasurement.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bootmeasurement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BootMeasurementCreateBulk is the builder for creating many BootMeasurement entities in bulk.
+type BootMeasurementCreateBulk struct {
+	config
+	err      error
+	builders []*BootMeasurementCreate
+}
+
+// Save creates the BootMeasurement entities in the database.
+func (_c *BootMeasurementCreateBulk) Save(ctx context.Context) ([]*BootMeasurement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BootMeasurement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BootMeasurementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BootMeasurementCreateBulk) SaveX(ctx context.Context) []*BootMeasurement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BootMeasurementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BootMeasurementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
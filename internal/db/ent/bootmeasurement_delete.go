@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// BootMeasurementDelete is the builder for deleting a BootMeasurement entity.
+type BootMeasurementDelete struct {
+	config
+	hooks    []Hook
+	mutation *BootMeasurementMutation
+}
+
+// Where appends a list predicates to the BootMeasurementDelete builder.
+func (_d *BootMeasurementDelete) Where(ps ...predicate.BootMeasurement) *BootMeasurementDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BootMeasurementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BootMeasurementDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BootMeasurementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bootmeasurement.Table, sqlgraph.NewFieldSpec(bootmeasurement.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BootMeasurementDeleteOne is the builder for deleting a single BootMeasurement entity.
+type BootMeasurementDeleteOne struct {
+	_d *BootMeasurementDelete
+}
+
+// Where appends a list predicates to the BootMeasurementDelete builder.
+func (_d *BootMeasurementDeleteOne) Where(ps ...predicate.BootMeasurement) *BootMeasurementDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BootMeasurementDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bootmeasurement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BootMeasurementDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
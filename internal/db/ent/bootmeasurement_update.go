@@ -0,0 +1,443 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// BootMeasurementUpdate is the builder for updating BootMeasurement entities.
+type BootMeasurementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BootMeasurementMutation
+}
+
+// Where appends a list predicates to the BootMeasurementUpdate builder.
+func (_u *BootMeasurementUpdate) Where(ps ...predicate.BootMeasurement) *BootMeasurementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *BootMeasurementUpdate) SetMachineID(v string) *BootMeasurementUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *BootMeasurementUpdate) SetNillableMachineID(v *string) *BootMeasurementUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetKernel sets the "kernel" field.
+func (_u *BootMeasurementUpdate) SetKernel(v string) *BootMeasurementUpdate {
+	_u.mutation.SetKernel(v)
+	return _u
+}
+
+// SetNillableKernel sets the "kernel" field if the given value is not nil.
+func (_u *BootMeasurementUpdate) SetNillableKernel(v *string) *BootMeasurementUpdate {
+	if v != nil {
+		_u.SetKernel(*v)
+	}
+	return _u
+}
+
+// ClearKernel clears the value of the "kernel" field.
+func (_u *BootMeasurementUpdate) ClearKernel() *BootMeasurementUpdate {
+	_u.mutation.ClearKernel()
+	return _u
+}
+
+// SetInitramfs sets the "initramfs" field.
+func (_u *BootMeasurementUpdate) SetInitramfs(v string) *BootMeasurementUpdate {
+	_u.mutation.SetInitramfs(v)
+	return _u
+}
+
+// SetNillableInitramfs sets the "initramfs" field if the given value is not nil.
+func (_u *BootMeasurementUpdate) SetNillableInitramfs(v *string) *BootMeasurementUpdate {
+	if v != nil {
+		_u.SetInitramfs(*v)
+	}
+	return _u
+}
+
+// ClearInitramfs clears the value of the "initramfs" field.
+func (_u *BootMeasurementUpdate) ClearInitramfs() *BootMeasurementUpdate {
+	_u.mutation.ClearInitramfs()
+	return _u
+}
+
+// SetCmdline sets the "cmdline" field.
+func (_u *BootMeasurementUpdate) SetCmdline(v string) *BootMeasurementUpdate {
+	_u.mutation.SetCmdline(v)
+	return _u
+}
+
+// SetNillableCmdline sets the "cmdline" field if the given value is not nil.
+func (_u *BootMeasurementUpdate) SetNillableCmdline(v *string) *BootMeasurementUpdate {
+	if v != nil {
+		_u.SetCmdline(*v)
+	}
+	return _u
+}
+
+// ClearCmdline clears the value of the "cmdline" field.
+func (_u *BootMeasurementUpdate) ClearCmdline() *BootMeasurementUpdate {
+	_u.mutation.ClearCmdline()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BootMeasurementUpdate) SetDescription(v string) *BootMeasurementUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BootMeasurementUpdate) SetNillableDescription(v *string) *BootMeasurementUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BootMeasurementUpdate) ClearDescription() *BootMeasurementUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the BootMeasurementMutation object of the builder.
+func (_u *BootMeasurementUpdate) Mutation() *BootMeasurementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BootMeasurementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BootMeasurementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BootMeasurementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BootMeasurementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BootMeasurementUpdate) check() error {
+	if v, ok := _u.mutation.MachineID(); ok {
+		if err := bootmeasurement.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`ent: validator failed for field "BootMeasurement.machine_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BootMeasurementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bootmeasurement.Table, bootmeasurement.Columns, sqlgraph.NewFieldSpec(bootmeasurement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(bootmeasurement.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kernel(); ok {
+		_spec.SetField(bootmeasurement.FieldKernel, field.TypeString, value)
+	}
+	if _u.mutation.KernelCleared() {
+		_spec.ClearField(bootmeasurement.FieldKernel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Initramfs(); ok {
+		_spec.SetField(bootmeasurement.FieldInitramfs, field.TypeString, value)
+	}
+	if _u.mutation.InitramfsCleared() {
+		_spec.ClearField(bootmeasurement.FieldInitramfs, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cmdline(); ok {
+		_spec.SetField(bootmeasurement.FieldCmdline, field.TypeString, value)
+	}
+	if _u.mutation.CmdlineCleared() {
+		_spec.ClearField(bootmeasurement.FieldCmdline, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bootmeasurement.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bootmeasurement.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bootmeasurement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BootMeasurementUpdateOne is the builder for updating a single BootMeasurement entity.
+type BootMeasurementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BootMeasurementMutation
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *BootMeasurementUpdateOne) SetMachineID(v string) *BootMeasurementUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *BootMeasurementUpdateOne) SetNillableMachineID(v *string) *BootMeasurementUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetKernel sets the "kernel" field.
+func (_u *BootMeasurementUpdateOne) SetKernel(v string) *BootMeasurementUpdateOne {
+	_u.mutation.SetKernel(v)
+	return _u
+}
+
+// SetNillableKernel sets the "kernel" field if the given value is not nil.
+func (_u *BootMeasurementUpdateOne) SetNillableKernel(v *string) *BootMeasurementUpdateOne {
+	if v != nil {
+		_u.SetKernel(*v)
+	}
+	return _u
+}
+
+// ClearKernel clears the value of the "kernel" field.
+func (_u *BootMeasurementUpdateOne) ClearKernel() *BootMeasurementUpdateOne {
+	_u.mutation.ClearKernel()
+	return _u
+}
+
+// SetInitramfs sets the "initramfs" field.
+func (_u *BootMeasurementUpdateOne) SetInitramfs(v string) *BootMeasurementUpdateOne {
+	_u.mutation.SetInitramfs(v)
+	return _u
+}
+
+// SetNillableInitramfs sets the "initramfs" field if the given value is not nil.
+func (_u *BootMeasurementUpdateOne) SetNillableInitramfs(v *string) *BootMeasurementUpdateOne {
+	if v != nil {
+		_u.SetInitramfs(*v)
+	}
+	return _u
+}
+
+// ClearInitramfs clears the value of the "initramfs" field.
+func (_u *BootMeasurementUpdateOne) ClearInitramfs() *BootMeasurementUpdateOne {
+	_u.mutation.ClearInitramfs()
+	return _u
+}
+
+// SetCmdline sets the "cmdline" field.
+func (_u *BootMeasurementUpdateOne) SetCmdline(v string) *BootMeasurementUpdateOne {
+	_u.mutation.SetCmdline(v)
+	return _u
+}
+
+// SetNillableCmdline sets the "cmdline" field if the given value is not nil.
+func (_u *BootMeasurementUpdateOne) SetNillableCmdline(v *string) *BootMeasurementUpdateOne {
+	if v != nil {
+		_u.SetCmdline(*v)
+	}
+	return _u
+}
+
+// ClearCmdline clears the value of the "cmdline" field.
+func (_u *BootMeasurementUpdateOne) ClearCmdline() *BootMeasurementUpdateOne {
+	_u.mutation.ClearCmdline()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BootMeasurementUpdateOne) SetDescription(v string) *BootMeasurementUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BootMeasurementUpdateOne) SetNillableDescription(v *string) *BootMeasurementUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BootMeasurementUpdateOne) ClearDescription() *BootMeasurementUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the BootMeasurementMutation object of the builder.
+func (_u *BootMeasurementUpdateOne) Mutation() *BootMeasurementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BootMeasurementUpdate builder.
+func (_u *BootMeasurementUpdateOne) Where(ps ...predicate.BootMeasurement) *BootMeasurementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BootMeasurementUpdateOne) Select(field string, fields ...string) *BootMeasurementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BootMeasurement entity.
+func (_u *BootMeasurementUpdateOne) Save(ctx context.Context) (*BootMeasurement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BootMeasurementUpdateOne) SaveX(ctx context.Context) *BootMeasurement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BootMeasurementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BootMeasurementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BootMeasurementUpdateOne) check() error {
+	if v, ok := _u.mutation.MachineID(); ok {
+		if err := bootmeasurement.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`ent: validator failed for field "BootMeasurement.machine_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BootMeasurementUpdateOne) sqlSave(ctx context.Context) (_node *BootMeasurement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bootmeasurement.Table, bootmeasurement.Columns, sqlgraph.NewFieldSpec(bootmeasurement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BootMeasurement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bootmeasurement.FieldID)
+		for _, f := range fields {
+			if !bootmeasurement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bootmeasurement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(bootmeasurement.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kernel(); ok {
+		_spec.SetField(bootmeasurement.FieldKernel, field.TypeString, value)
+	}
+	if _u.mutation.KernelCleared() {
+		_spec.ClearField(bootmeasurement.FieldKernel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Initramfs(); ok {
+		_spec.SetField(bootmeasurement.FieldInitramfs, field.TypeString, value)
+	}
+	if _u.mutation.InitramfsCleared() {
+		_spec.ClearField(bootmeasurement.FieldInitramfs, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cmdline(); ok {
+		_spec.SetField(bootmeasurement.FieldCmdline, field.TypeString, value)
+	}
+	if _u.mutation.CmdlineCleared() {
+		_spec.ClearField(bootmeasurement.FieldCmdline, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bootmeasurement.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bootmeasurement.FieldDescription, field.TypeString)
+	}
+	_node = &BootMeasurement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bootmeasurement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,7 +14,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
 	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+	"git.rgst.io/homelab/klefki/internal/db/ent/operator"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"git.rgst.io/homelab/klefki/internal/db/ent/vault"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +33,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// BootMeasurement is the client for interacting with the BootMeasurement builders.
+	BootMeasurement *BootMeasurementClient
+	// EnrollmentToken is the client for interacting with the EnrollmentToken builders.
+	EnrollmentToken *EnrollmentTokenClient
 	// Machine is the client for interacting with the Machine builders.
 	Machine *MachineClient
+	// MachineAlias is the client for interacting with the MachineAlias builders.
+	MachineAlias *MachineAliasClient
+	// MachineGroup is the client for interacting with the MachineGroup builders.
+	MachineGroup *MachineGroupClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
+	// Operator is the client for interacting with the Operator builders.
+	Operator *OperatorClient
+	// StoredKey is the client for interacting with the StoredKey builders.
+	StoredKey *StoredKeyClient
+	// TangKey is the client for interacting with the TangKey builders.
+	TangKey *TangKeyClient
+	// UnlockPolicy is the client for interacting with the UnlockPolicy builders.
+	UnlockPolicy *UnlockPolicyClient
+	// Vault is the client for interacting with the Vault builders.
+	Vault *VaultClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +68,18 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.BootMeasurement = NewBootMeasurementClient(c.config)
+	c.EnrollmentToken = NewEnrollmentTokenClient(c.config)
 	c.Machine = NewMachineClient(c.config)
+	c.MachineAlias = NewMachineAliasClient(c.config)
+	c.MachineGroup = NewMachineGroupClient(c.config)
+	c.MaintenanceWindow = NewMaintenanceWindowClient(c.config)
+	c.Operator = NewOperatorClient(c.config)
+	c.StoredKey = NewStoredKeyClient(c.config)
+	c.TangKey = NewTangKeyClient(c.config)
+	c.UnlockPolicy = NewUnlockPolicyClient(c.config)
+	c.Vault = NewVaultClient(c.config)
 }
 
 type (
@@ -126,9 +170,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Machine: NewMachineClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AuditEvent:        NewAuditEventClient(cfg),
+		BootMeasurement:   NewBootMeasurementClient(cfg),
+		EnrollmentToken:   NewEnrollmentTokenClient(cfg),
+		Machine:           NewMachineClient(cfg),
+		MachineAlias:      NewMachineAliasClient(cfg),
+		MachineGroup:      NewMachineGroupClient(cfg),
+		MaintenanceWindow: NewMaintenanceWindowClient(cfg),
+		Operator:          NewOperatorClient(cfg),
+		StoredKey:         NewStoredKeyClient(cfg),
+		TangKey:           NewTangKeyClient(cfg),
+		UnlockPolicy:      NewUnlockPolicyClient(cfg),
+		Vault:             NewVaultClient(cfg),
 	}, nil
 }
 
@@ -146,16 +201,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Machine: NewMachineClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AuditEvent:        NewAuditEventClient(cfg),
+		BootMeasurement:   NewBootMeasurementClient(cfg),
+		EnrollmentToken:   NewEnrollmentTokenClient(cfg),
+		Machine:           NewMachineClient(cfg),
+		MachineAlias:      NewMachineAliasClient(cfg),
+		MachineGroup:      NewMachineGroupClient(cfg),
+		MaintenanceWindow: NewMaintenanceWindowClient(cfg),
+		Operator:          NewOperatorClient(cfg),
+		StoredKey:         NewStoredKeyClient(cfg),
+		TangKey:           NewTangKeyClient(cfg),
+		UnlockPolicy:      NewUnlockPolicyClient(cfg),
+		Vault:             NewVaultClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Machine.
+//		AuditEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,25 +243,458 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Machine.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditEvent, c.BootMeasurement, c.EnrollmentToken, c.Machine, c.MachineAlias,
+		c.MachineGroup, c.MaintenanceWindow, c.Operator, c.StoredKey, c.TangKey,
+		c.UnlockPolicy, c.Vault,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Machine.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditEvent, c.BootMeasurement, c.EnrollmentToken, c.Machine, c.MachineAlias,
+		c.MachineGroup, c.MaintenanceWindow, c.Operator, c.StoredKey, c.TangKey,
+		c.UnlockPolicy, c.Vault,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
+	case *BootMeasurementMutation:
+		return c.BootMeasurement.mutate(ctx, m)
+	case *EnrollmentTokenMutation:
+		return c.EnrollmentToken.mutate(ctx, m)
 	case *MachineMutation:
 		return c.Machine.mutate(ctx, m)
+	case *MachineAliasMutation:
+		return c.MachineAlias.mutate(ctx, m)
+	case *MachineGroupMutation:
+		return c.MachineGroup.mutate(ctx, m)
+	case *MaintenanceWindowMutation:
+		return c.MaintenanceWindow.mutate(ctx, m)
+	case *OperatorMutation:
+		return c.Operator.mutate(ctx, m)
+	case *StoredKeyMutation:
+		return c.StoredKey.mutate(ctx, m)
+	case *TangKeyMutation:
+		return c.TangKey.mutate(ctx, m)
+	case *UnlockPolicyMutation:
+		return c.UnlockPolicy.mutate(ctx, m)
+	case *VaultMutation:
+		return c.Vault.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(_m *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(_m))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id int) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(_m *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id int) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id int) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id int) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
+// BootMeasurementClient is a client for the BootMeasurement schema.
+type BootMeasurementClient struct {
+	config
+}
+
+// NewBootMeasurementClient returns a client for the BootMeasurement from the given config.
+func NewBootMeasurementClient(c config) *BootMeasurementClient {
+	return &BootMeasurementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bootmeasurement.Hooks(f(g(h())))`.
+func (c *BootMeasurementClient) Use(hooks ...Hook) {
+	c.hooks.BootMeasurement = append(c.hooks.BootMeasurement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bootmeasurement.Intercept(f(g(h())))`.
+func (c *BootMeasurementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BootMeasurement = append(c.inters.BootMeasurement, interceptors...)
+}
+
+// Create returns a builder for creating a BootMeasurement entity.
+func (c *BootMeasurementClient) Create() *BootMeasurementCreate {
+	mutation := newBootMeasurementMutation(c.config, OpCreate)
+	return &BootMeasurementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BootMeasurement entities.
+func (c *BootMeasurementClient) CreateBulk(builders ...*BootMeasurementCreate) *BootMeasurementCreateBulk {
+	return &BootMeasurementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BootMeasurementClient) MapCreateBulk(slice any, setFunc func(*BootMeasurementCreate, int)) *BootMeasurementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BootMeasurementCreateBulk{err: fmt.Errorf("calling to BootMeasurementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BootMeasurementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BootMeasurementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BootMeasurement.
+func (c *BootMeasurementClient) Update() *BootMeasurementUpdate {
+	mutation := newBootMeasurementMutation(c.config, OpUpdate)
+	return &BootMeasurementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BootMeasurementClient) UpdateOne(_m *BootMeasurement) *BootMeasurementUpdateOne {
+	mutation := newBootMeasurementMutation(c.config, OpUpdateOne, withBootMeasurement(_m))
+	return &BootMeasurementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BootMeasurementClient) UpdateOneID(id int) *BootMeasurementUpdateOne {
+	mutation := newBootMeasurementMutation(c.config, OpUpdateOne, withBootMeasurementID(id))
+	return &BootMeasurementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BootMeasurement.
+func (c *BootMeasurementClient) Delete() *BootMeasurementDelete {
+	mutation := newBootMeasurementMutation(c.config, OpDelete)
+	return &BootMeasurementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BootMeasurementClient) DeleteOne(_m *BootMeasurement) *BootMeasurementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BootMeasurementClient) DeleteOneID(id int) *BootMeasurementDeleteOne {
+	builder := c.Delete().Where(bootmeasurement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BootMeasurementDeleteOne{builder}
+}
+
+// Query returns a query builder for BootMeasurement.
+func (c *BootMeasurementClient) Query() *BootMeasurementQuery {
+	return &BootMeasurementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBootMeasurement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BootMeasurement entity by its id.
+func (c *BootMeasurementClient) Get(ctx context.Context, id int) (*BootMeasurement, error) {
+	return c.Query().Where(bootmeasurement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BootMeasurementClient) GetX(ctx context.Context, id int) *BootMeasurement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BootMeasurementClient) Hooks() []Hook {
+	return c.hooks.BootMeasurement
+}
+
+// Interceptors returns the client interceptors.
+func (c *BootMeasurementClient) Interceptors() []Interceptor {
+	return c.inters.BootMeasurement
+}
+
+func (c *BootMeasurementClient) mutate(ctx context.Context, m *BootMeasurementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BootMeasurementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BootMeasurementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BootMeasurementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BootMeasurementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BootMeasurement mutation op: %q", m.Op())
+	}
+}
+
+// EnrollmentTokenClient is a client for the EnrollmentToken schema.
+type EnrollmentTokenClient struct {
+	config
+}
+
+// NewEnrollmentTokenClient returns a client for the EnrollmentToken from the given config.
+func NewEnrollmentTokenClient(c config) *EnrollmentTokenClient {
+	return &EnrollmentTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `enrollmenttoken.Hooks(f(g(h())))`.
+func (c *EnrollmentTokenClient) Use(hooks ...Hook) {
+	c.hooks.EnrollmentToken = append(c.hooks.EnrollmentToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enrollmenttoken.Intercept(f(g(h())))`.
+func (c *EnrollmentTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnrollmentToken = append(c.inters.EnrollmentToken, interceptors...)
+}
+
+// Create returns a builder for creating a EnrollmentToken entity.
+func (c *EnrollmentTokenClient) Create() *EnrollmentTokenCreate {
+	mutation := newEnrollmentTokenMutation(c.config, OpCreate)
+	return &EnrollmentTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnrollmentToken entities.
+func (c *EnrollmentTokenClient) CreateBulk(builders ...*EnrollmentTokenCreate) *EnrollmentTokenCreateBulk {
+	return &EnrollmentTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnrollmentTokenClient) MapCreateBulk(slice any, setFunc func(*EnrollmentTokenCreate, int)) *EnrollmentTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnrollmentTokenCreateBulk{err: fmt.Errorf("calling to EnrollmentTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnrollmentTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnrollmentTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnrollmentToken.
+func (c *EnrollmentTokenClient) Update() *EnrollmentTokenUpdate {
+	mutation := newEnrollmentTokenMutation(c.config, OpUpdate)
+	return &EnrollmentTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnrollmentTokenClient) UpdateOne(_m *EnrollmentToken) *EnrollmentTokenUpdateOne {
+	mutation := newEnrollmentTokenMutation(c.config, OpUpdateOne, withEnrollmentToken(_m))
+	return &EnrollmentTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnrollmentTokenClient) UpdateOneID(id int) *EnrollmentTokenUpdateOne {
+	mutation := newEnrollmentTokenMutation(c.config, OpUpdateOne, withEnrollmentTokenID(id))
+	return &EnrollmentTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnrollmentToken.
+func (c *EnrollmentTokenClient) Delete() *EnrollmentTokenDelete {
+	mutation := newEnrollmentTokenMutation(c.config, OpDelete)
+	return &EnrollmentTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnrollmentTokenClient) DeleteOne(_m *EnrollmentToken) *EnrollmentTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnrollmentTokenClient) DeleteOneID(id int) *EnrollmentTokenDeleteOne {
+	builder := c.Delete().Where(enrollmenttoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnrollmentTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for EnrollmentToken.
+func (c *EnrollmentTokenClient) Query() *EnrollmentTokenQuery {
+	return &EnrollmentTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnrollmentToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnrollmentToken entity by its id.
+func (c *EnrollmentTokenClient) Get(ctx context.Context, id int) (*EnrollmentToken, error) {
+	return c.Query().Where(enrollmenttoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnrollmentTokenClient) GetX(ctx context.Context, id int) *EnrollmentToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EnrollmentTokenClient) Hooks() []Hook {
+	return c.hooks.EnrollmentToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnrollmentTokenClient) Interceptors() []Interceptor {
+	return c.inters.EnrollmentToken
+}
+
+func (c *EnrollmentTokenClient) mutate(ctx context.Context, m *EnrollmentTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnrollmentTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnrollmentTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnrollmentTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnrollmentTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnrollmentToken mutation op: %q", m.Op())
+	}
+}
+
 // MachineClient is a client for the Machine schema.
 type MachineClient struct {
 	config
@@ -251,8 +750,8 @@ func (c *MachineClient) Update() *MachineUpdate {
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MachineClient) UpdateOne(m *Machine) *MachineUpdateOne {
-	mutation := newMachineMutation(c.config, OpUpdateOne, withMachine(m))
+func (c *MachineClient) UpdateOne(_m *Machine) *MachineUpdateOne {
+	mutation := newMachineMutation(c.config, OpUpdateOne, withMachine(_m))
 	return &MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -269,8 +768,8 @@ func (c *MachineClient) Delete() *MachineDelete {
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MachineClient) DeleteOne(m *Machine) *MachineDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *MachineClient) DeleteOne(_m *Machine) *MachineDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
@@ -329,12 +828,1080 @@ func (c *MachineClient) mutate(ctx context.Context, m *MachineMutation) (Value,
 	}
 }
 
+// MachineAliasClient is a client for the MachineAlias schema.
+type MachineAliasClient struct {
+	config
+}
+
+// NewMachineAliasClient returns a client for the MachineAlias from the given config.
+func NewMachineAliasClient(c config) *MachineAliasClient {
+	return &MachineAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `machinealias.Hooks(f(g(h())))`.
+func (c *MachineAliasClient) Use(hooks ...Hook) {
+	c.hooks.MachineAlias = append(c.hooks.MachineAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `machinealias.Intercept(f(g(h())))`.
+func (c *MachineAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MachineAlias = append(c.inters.MachineAlias, interceptors...)
+}
+
+// Create returns a builder for creating a MachineAlias entity.
+func (c *MachineAliasClient) Create() *MachineAliasCreate {
+	mutation := newMachineAliasMutation(c.config, OpCreate)
+	return &MachineAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MachineAlias entities.
+func (c *MachineAliasClient) CreateBulk(builders ...*MachineAliasCreate) *MachineAliasCreateBulk {
+	return &MachineAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MachineAliasClient) MapCreateBulk(slice any, setFunc func(*MachineAliasCreate, int)) *MachineAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MachineAliasCreateBulk{err: fmt.Errorf("calling to MachineAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MachineAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MachineAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MachineAlias.
+func (c *MachineAliasClient) Update() *MachineAliasUpdate {
+	mutation := newMachineAliasMutation(c.config, OpUpdate)
+	return &MachineAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MachineAliasClient) UpdateOne(_m *MachineAlias) *MachineAliasUpdateOne {
+	mutation := newMachineAliasMutation(c.config, OpUpdateOne, withMachineAlias(_m))
+	return &MachineAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MachineAliasClient) UpdateOneID(id string) *MachineAliasUpdateOne {
+	mutation := newMachineAliasMutation(c.config, OpUpdateOne, withMachineAliasID(id))
+	return &MachineAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MachineAlias.
+func (c *MachineAliasClient) Delete() *MachineAliasDelete {
+	mutation := newMachineAliasMutation(c.config, OpDelete)
+	return &MachineAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MachineAliasClient) DeleteOne(_m *MachineAlias) *MachineAliasDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MachineAliasClient) DeleteOneID(id string) *MachineAliasDeleteOne {
+	builder := c.Delete().Where(machinealias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MachineAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for MachineAlias.
+func (c *MachineAliasClient) Query() *MachineAliasQuery {
+	return &MachineAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMachineAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MachineAlias entity by its id.
+func (c *MachineAliasClient) Get(ctx context.Context, id string) (*MachineAlias, error) {
+	return c.Query().Where(machinealias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MachineAliasClient) GetX(ctx context.Context, id string) *MachineAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MachineAliasClient) Hooks() []Hook {
+	return c.hooks.MachineAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *MachineAliasClient) Interceptors() []Interceptor {
+	return c.inters.MachineAlias
+}
+
+func (c *MachineAliasClient) mutate(ctx context.Context, m *MachineAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MachineAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MachineAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MachineAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MachineAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MachineAlias mutation op: %q", m.Op())
+	}
+}
+
+// MachineGroupClient is a client for the MachineGroup schema.
+type MachineGroupClient struct {
+	config
+}
+
+// NewMachineGroupClient returns a client for the MachineGroup from the given config.
+func NewMachineGroupClient(c config) *MachineGroupClient {
+	return &MachineGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `machinegroup.Hooks(f(g(h())))`.
+func (c *MachineGroupClient) Use(hooks ...Hook) {
+	c.hooks.MachineGroup = append(c.hooks.MachineGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `machinegroup.Intercept(f(g(h())))`.
+func (c *MachineGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MachineGroup = append(c.inters.MachineGroup, interceptors...)
+}
+
+// Create returns a builder for creating a MachineGroup entity.
+func (c *MachineGroupClient) Create() *MachineGroupCreate {
+	mutation := newMachineGroupMutation(c.config, OpCreate)
+	return &MachineGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MachineGroup entities.
+func (c *MachineGroupClient) CreateBulk(builders ...*MachineGroupCreate) *MachineGroupCreateBulk {
+	return &MachineGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MachineGroupClient) MapCreateBulk(slice any, setFunc func(*MachineGroupCreate, int)) *MachineGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MachineGroupCreateBulk{err: fmt.Errorf("calling to MachineGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MachineGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MachineGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MachineGroup.
+func (c *MachineGroupClient) Update() *MachineGroupUpdate {
+	mutation := newMachineGroupMutation(c.config, OpUpdate)
+	return &MachineGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MachineGroupClient) UpdateOne(_m *MachineGroup) *MachineGroupUpdateOne {
+	mutation := newMachineGroupMutation(c.config, OpUpdateOne, withMachineGroup(_m))
+	return &MachineGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MachineGroupClient) UpdateOneID(id int) *MachineGroupUpdateOne {
+	mutation := newMachineGroupMutation(c.config, OpUpdateOne, withMachineGroupID(id))
+	return &MachineGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MachineGroup.
+func (c *MachineGroupClient) Delete() *MachineGroupDelete {
+	mutation := newMachineGroupMutation(c.config, OpDelete)
+	return &MachineGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MachineGroupClient) DeleteOne(_m *MachineGroup) *MachineGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MachineGroupClient) DeleteOneID(id int) *MachineGroupDeleteOne {
+	builder := c.Delete().Where(machinegroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MachineGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for MachineGroup.
+func (c *MachineGroupClient) Query() *MachineGroupQuery {
+	return &MachineGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMachineGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MachineGroup entity by its id.
+func (c *MachineGroupClient) Get(ctx context.Context, id int) (*MachineGroup, error) {
+	return c.Query().Where(machinegroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MachineGroupClient) GetX(ctx context.Context, id int) *MachineGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MachineGroupClient) Hooks() []Hook {
+	return c.hooks.MachineGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *MachineGroupClient) Interceptors() []Interceptor {
+	return c.inters.MachineGroup
+}
+
+func (c *MachineGroupClient) mutate(ctx context.Context, m *MachineGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MachineGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MachineGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MachineGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MachineGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MachineGroup mutation op: %q", m.Op())
+	}
+}
+
+// MaintenanceWindowClient is a client for the MaintenanceWindow schema.
+type MaintenanceWindowClient struct {
+	config
+}
+
+// NewMaintenanceWindowClient returns a client for the MaintenanceWindow from the given config.
+func NewMaintenanceWindowClient(c config) *MaintenanceWindowClient {
+	return &MaintenanceWindowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `maintenancewindow.Hooks(f(g(h())))`.
+func (c *MaintenanceWindowClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceWindow = append(c.hooks.MaintenanceWindow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `maintenancewindow.Intercept(f(g(h())))`.
+func (c *MaintenanceWindowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MaintenanceWindow = append(c.inters.MaintenanceWindow, interceptors...)
+}
+
+// Create returns a builder for creating a MaintenanceWindow entity.
+func (c *MaintenanceWindowClient) Create() *MaintenanceWindowCreate {
+	mutation := newMaintenanceWindowMutation(c.config, OpCreate)
+	return &MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MaintenanceWindow entities.
+func (c *MaintenanceWindowClient) CreateBulk(builders ...*MaintenanceWindowCreate) *MaintenanceWindowCreateBulk {
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MaintenanceWindowClient) MapCreateBulk(slice any, setFunc func(*MaintenanceWindowCreate, int)) *MaintenanceWindowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MaintenanceWindowCreateBulk{err: fmt.Errorf("calling to MaintenanceWindowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MaintenanceWindowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Update() *MaintenanceWindowUpdate {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdate)
+	return &MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaintenanceWindowClient) UpdateOne(_m *MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindow(_m))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaintenanceWindowClient) UpdateOneID(id int) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindowID(id))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Delete() *MaintenanceWindowDelete {
+	mutation := newMaintenanceWindowMutation(c.config, OpDelete)
+	return &MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceWindowClient) DeleteOne(_m *MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaintenanceWindowClient) DeleteOneID(id int) *MaintenanceWindowDeleteOne {
+	builder := c.Delete().Where(maintenancewindow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceWindowDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Query() *MaintenanceWindowQuery {
+	return &MaintenanceWindowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaintenanceWindow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MaintenanceWindow entity by its id.
+func (c *MaintenanceWindowClient) Get(ctx context.Context, id int) (*MaintenanceWindow, error) {
+	return c.Query().Where(maintenancewindow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceWindowClient) GetX(ctx context.Context, id int) *MaintenanceWindow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceWindowClient) Hooks() []Hook {
+	return c.hooks.MaintenanceWindow
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaintenanceWindowClient) Interceptors() []Interceptor {
+	return c.inters.MaintenanceWindow
+}
+
+func (c *MaintenanceWindowClient) mutate(ctx context.Context, m *MaintenanceWindowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MaintenanceWindow mutation op: %q", m.Op())
+	}
+}
+
+// OperatorClient is a client for the Operator schema.
+type OperatorClient struct {
+	config
+}
+
+// NewOperatorClient returns a client for the Operator from the given config.
+func NewOperatorClient(c config) *OperatorClient {
+	return &OperatorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `operator.Hooks(f(g(h())))`.
+func (c *OperatorClient) Use(hooks ...Hook) {
+	c.hooks.Operator = append(c.hooks.Operator, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `operator.Intercept(f(g(h())))`.
+func (c *OperatorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Operator = append(c.inters.Operator, interceptors...)
+}
+
+// Create returns a builder for creating a Operator entity.
+func (c *OperatorClient) Create() *OperatorCreate {
+	mutation := newOperatorMutation(c.config, OpCreate)
+	return &OperatorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Operator entities.
+func (c *OperatorClient) CreateBulk(builders ...*OperatorCreate) *OperatorCreateBulk {
+	return &OperatorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OperatorClient) MapCreateBulk(slice any, setFunc func(*OperatorCreate, int)) *OperatorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OperatorCreateBulk{err: fmt.Errorf("calling to OperatorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OperatorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OperatorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Operator.
+func (c *OperatorClient) Update() *OperatorUpdate {
+	mutation := newOperatorMutation(c.config, OpUpdate)
+	return &OperatorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OperatorClient) UpdateOne(_m *Operator) *OperatorUpdateOne {
+	mutation := newOperatorMutation(c.config, OpUpdateOne, withOperator(_m))
+	return &OperatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OperatorClient) UpdateOneID(id string) *OperatorUpdateOne {
+	mutation := newOperatorMutation(c.config, OpUpdateOne, withOperatorID(id))
+	return &OperatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Operator.
+func (c *OperatorClient) Delete() *OperatorDelete {
+	mutation := newOperatorMutation(c.config, OpDelete)
+	return &OperatorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OperatorClient) DeleteOne(_m *Operator) *OperatorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OperatorClient) DeleteOneID(id string) *OperatorDeleteOne {
+	builder := c.Delete().Where(operator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OperatorDeleteOne{builder}
+}
+
+// Query returns a query builder for Operator.
+func (c *OperatorClient) Query() *OperatorQuery {
+	return &OperatorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOperator},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Operator entity by its id.
+func (c *OperatorClient) Get(ctx context.Context, id string) (*Operator, error) {
+	return c.Query().Where(operator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OperatorClient) GetX(ctx context.Context, id string) *Operator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OperatorClient) Hooks() []Hook {
+	return c.hooks.Operator
+}
+
+// Interceptors returns the client interceptors.
+func (c *OperatorClient) Interceptors() []Interceptor {
+	return c.inters.Operator
+}
+
+func (c *OperatorClient) mutate(ctx context.Context, m *OperatorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OperatorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OperatorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OperatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OperatorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Operator mutation op: %q", m.Op())
+	}
+}
+
+// StoredKeyClient is a client for the StoredKey schema.
+type StoredKeyClient struct {
+	config
+}
+
+// NewStoredKeyClient returns a client for the StoredKey from the given config.
+func NewStoredKeyClient(c config) *StoredKeyClient {
+	return &StoredKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storedkey.Hooks(f(g(h())))`.
+func (c *StoredKeyClient) Use(hooks ...Hook) {
+	c.hooks.StoredKey = append(c.hooks.StoredKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storedkey.Intercept(f(g(h())))`.
+func (c *StoredKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StoredKey = append(c.inters.StoredKey, interceptors...)
+}
+
+// Create returns a builder for creating a StoredKey entity.
+func (c *StoredKeyClient) Create() *StoredKeyCreate {
+	mutation := newStoredKeyMutation(c.config, OpCreate)
+	return &StoredKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StoredKey entities.
+func (c *StoredKeyClient) CreateBulk(builders ...*StoredKeyCreate) *StoredKeyCreateBulk {
+	return &StoredKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StoredKeyClient) MapCreateBulk(slice any, setFunc func(*StoredKeyCreate, int)) *StoredKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StoredKeyCreateBulk{err: fmt.Errorf("calling to StoredKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StoredKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StoredKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StoredKey.
+func (c *StoredKeyClient) Update() *StoredKeyUpdate {
+	mutation := newStoredKeyMutation(c.config, OpUpdate)
+	return &StoredKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StoredKeyClient) UpdateOne(_m *StoredKey) *StoredKeyUpdateOne {
+	mutation := newStoredKeyMutation(c.config, OpUpdateOne, withStoredKey(_m))
+	return &StoredKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StoredKeyClient) UpdateOneID(id int) *StoredKeyUpdateOne {
+	mutation := newStoredKeyMutation(c.config, OpUpdateOne, withStoredKeyID(id))
+	return &StoredKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StoredKey.
+func (c *StoredKeyClient) Delete() *StoredKeyDelete {
+	mutation := newStoredKeyMutation(c.config, OpDelete)
+	return &StoredKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StoredKeyClient) DeleteOne(_m *StoredKey) *StoredKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StoredKeyClient) DeleteOneID(id int) *StoredKeyDeleteOne {
+	builder := c.Delete().Where(storedkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StoredKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for StoredKey.
+func (c *StoredKeyClient) Query() *StoredKeyQuery {
+	return &StoredKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStoredKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StoredKey entity by its id.
+func (c *StoredKeyClient) Get(ctx context.Context, id int) (*StoredKey, error) {
+	return c.Query().Where(storedkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StoredKeyClient) GetX(ctx context.Context, id int) *StoredKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StoredKeyClient) Hooks() []Hook {
+	return c.hooks.StoredKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *StoredKeyClient) Interceptors() []Interceptor {
+	return c.inters.StoredKey
+}
+
+func (c *StoredKeyClient) mutate(ctx context.Context, m *StoredKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StoredKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StoredKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StoredKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StoredKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StoredKey mutation op: %q", m.Op())
+	}
+}
+
+// TangKeyClient is a client for the TangKey schema.
+type TangKeyClient struct {
+	config
+}
+
+// NewTangKeyClient returns a client for the TangKey from the given config.
+func NewTangKeyClient(c config) *TangKeyClient {
+	return &TangKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tangkey.Hooks(f(g(h())))`.
+func (c *TangKeyClient) Use(hooks ...Hook) {
+	c.hooks.TangKey = append(c.hooks.TangKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tangkey.Intercept(f(g(h())))`.
+func (c *TangKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TangKey = append(c.inters.TangKey, interceptors...)
+}
+
+// Create returns a builder for creating a TangKey entity.
+func (c *TangKeyClient) Create() *TangKeyCreate {
+	mutation := newTangKeyMutation(c.config, OpCreate)
+	return &TangKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TangKey entities.
+func (c *TangKeyClient) CreateBulk(builders ...*TangKeyCreate) *TangKeyCreateBulk {
+	return &TangKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TangKeyClient) MapCreateBulk(slice any, setFunc func(*TangKeyCreate, int)) *TangKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TangKeyCreateBulk{err: fmt.Errorf("calling to TangKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TangKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TangKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TangKey.
+func (c *TangKeyClient) Update() *TangKeyUpdate {
+	mutation := newTangKeyMutation(c.config, OpUpdate)
+	return &TangKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TangKeyClient) UpdateOne(_m *TangKey) *TangKeyUpdateOne {
+	mutation := newTangKeyMutation(c.config, OpUpdateOne, withTangKey(_m))
+	return &TangKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TangKeyClient) UpdateOneID(id int) *TangKeyUpdateOne {
+	mutation := newTangKeyMutation(c.config, OpUpdateOne, withTangKeyID(id))
+	return &TangKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TangKey.
+func (c *TangKeyClient) Delete() *TangKeyDelete {
+	mutation := newTangKeyMutation(c.config, OpDelete)
+	return &TangKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TangKeyClient) DeleteOne(_m *TangKey) *TangKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TangKeyClient) DeleteOneID(id int) *TangKeyDeleteOne {
+	builder := c.Delete().Where(tangkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TangKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for TangKey.
+func (c *TangKeyClient) Query() *TangKeyQuery {
+	return &TangKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTangKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TangKey entity by its id.
+func (c *TangKeyClient) Get(ctx context.Context, id int) (*TangKey, error) {
+	return c.Query().Where(tangkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TangKeyClient) GetX(ctx context.Context, id int) *TangKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TangKeyClient) Hooks() []Hook {
+	return c.hooks.TangKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *TangKeyClient) Interceptors() []Interceptor {
+	return c.inters.TangKey
+}
+
+func (c *TangKeyClient) mutate(ctx context.Context, m *TangKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TangKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TangKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TangKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TangKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TangKey mutation op: %q", m.Op())
+	}
+}
+
+// UnlockPolicyClient is a client for the UnlockPolicy schema.
+type UnlockPolicyClient struct {
+	config
+}
+
+// NewUnlockPolicyClient returns a client for the UnlockPolicy from the given config.
+func NewUnlockPolicyClient(c config) *UnlockPolicyClient {
+	return &UnlockPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `unlockpolicy.Hooks(f(g(h())))`.
+func (c *UnlockPolicyClient) Use(hooks ...Hook) {
+	c.hooks.UnlockPolicy = append(c.hooks.UnlockPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `unlockpolicy.Intercept(f(g(h())))`.
+func (c *UnlockPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UnlockPolicy = append(c.inters.UnlockPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a UnlockPolicy entity.
+func (c *UnlockPolicyClient) Create() *UnlockPolicyCreate {
+	mutation := newUnlockPolicyMutation(c.config, OpCreate)
+	return &UnlockPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UnlockPolicy entities.
+func (c *UnlockPolicyClient) CreateBulk(builders ...*UnlockPolicyCreate) *UnlockPolicyCreateBulk {
+	return &UnlockPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UnlockPolicyClient) MapCreateBulk(slice any, setFunc func(*UnlockPolicyCreate, int)) *UnlockPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UnlockPolicyCreateBulk{err: fmt.Errorf("calling to UnlockPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UnlockPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UnlockPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UnlockPolicy.
+func (c *UnlockPolicyClient) Update() *UnlockPolicyUpdate {
+	mutation := newUnlockPolicyMutation(c.config, OpUpdate)
+	return &UnlockPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UnlockPolicyClient) UpdateOne(_m *UnlockPolicy) *UnlockPolicyUpdateOne {
+	mutation := newUnlockPolicyMutation(c.config, OpUpdateOne, withUnlockPolicy(_m))
+	return &UnlockPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UnlockPolicyClient) UpdateOneID(id int) *UnlockPolicyUpdateOne {
+	mutation := newUnlockPolicyMutation(c.config, OpUpdateOne, withUnlockPolicyID(id))
+	return &UnlockPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UnlockPolicy.
+func (c *UnlockPolicyClient) Delete() *UnlockPolicyDelete {
+	mutation := newUnlockPolicyMutation(c.config, OpDelete)
+	return &UnlockPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UnlockPolicyClient) DeleteOne(_m *UnlockPolicy) *UnlockPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UnlockPolicyClient) DeleteOneID(id int) *UnlockPolicyDeleteOne {
+	builder := c.Delete().Where(unlockpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UnlockPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for UnlockPolicy.
+func (c *UnlockPolicyClient) Query() *UnlockPolicyQuery {
+	return &UnlockPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUnlockPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UnlockPolicy entity by its id.
+func (c *UnlockPolicyClient) Get(ctx context.Context, id int) (*UnlockPolicy, error) {
+	return c.Query().Where(unlockpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UnlockPolicyClient) GetX(ctx context.Context, id int) *UnlockPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UnlockPolicyClient) Hooks() []Hook {
+	return c.hooks.UnlockPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *UnlockPolicyClient) Interceptors() []Interceptor {
+	return c.inters.UnlockPolicy
+}
+
+func (c *UnlockPolicyClient) mutate(ctx context.Context, m *UnlockPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UnlockPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UnlockPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UnlockPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UnlockPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UnlockPolicy mutation op: %q", m.Op())
+	}
+}
+
+// VaultClient is a client for the Vault schema.
+type VaultClient struct {
+	config
+}
+
+// NewVaultClient returns a client for the Vault from the given config.
+func NewVaultClient(c config) *VaultClient {
+	return &VaultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vault.Hooks(f(g(h())))`.
+func (c *VaultClient) Use(hooks ...Hook) {
+	c.hooks.Vault = append(c.hooks.Vault, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vault.Intercept(f(g(h())))`.
+func (c *VaultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vault = append(c.inters.Vault, interceptors...)
+}
+
+// Create returns a builder for creating a Vault entity.
+func (c *VaultClient) Create() *VaultCreate {
+	mutation := newVaultMutation(c.config, OpCreate)
+	return &VaultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Vault entities.
+func (c *VaultClient) CreateBulk(builders ...*VaultCreate) *VaultCreateBulk {
+	return &VaultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VaultClient) MapCreateBulk(slice any, setFunc func(*VaultCreate, int)) *VaultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VaultCreateBulk{err: fmt.Errorf("calling to VaultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VaultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VaultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Vault.
+func (c *VaultClient) Update() *VaultUpdate {
+	mutation := newVaultMutation(c.config, OpUpdate)
+	return &VaultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VaultClient) UpdateOne(_m *Vault) *VaultUpdateOne {
+	mutation := newVaultMutation(c.config, OpUpdateOne, withVault(_m))
+	return &VaultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VaultClient) UpdateOneID(id int) *VaultUpdateOne {
+	mutation := newVaultMutation(c.config, OpUpdateOne, withVaultID(id))
+	return &VaultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Vault.
+func (c *VaultClient) Delete() *VaultDelete {
+	mutation := newVaultMutation(c.config, OpDelete)
+	return &VaultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VaultClient) DeleteOne(_m *Vault) *VaultDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VaultClient) DeleteOneID(id int) *VaultDeleteOne {
+	builder := c.Delete().Where(vault.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VaultDeleteOne{builder}
+}
+
+// Query returns a query builder for Vault.
+func (c *VaultClient) Query() *VaultQuery {
+	return &VaultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVault},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Vault entity by its id.
+func (c *VaultClient) Get(ctx context.Context, id int) (*Vault, error) {
+	return c.Query().Where(vault.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VaultClient) GetX(ctx context.Context, id int) *Vault {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VaultClient) Hooks() []Hook {
+	return c.hooks.Vault
+}
+
+// Interceptors returns the client interceptors.
+func (c *VaultClient) Interceptors() []Interceptor {
+	return c.inters.Vault
+}
+
+func (c *VaultClient) mutate(ctx context.Context, m *VaultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VaultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VaultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VaultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VaultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vault mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Machine []ent.Hook
+		AuditEvent, BootMeasurement, EnrollmentToken, Machine, MachineAlias,
+		MachineGroup, MaintenanceWindow, Operator, StoredKey, TangKey, UnlockPolicy,
+		Vault []ent.Hook
 	}
 	inters struct {
-		Machine []ent.Interceptor
+		AuditEvent, BootMeasurement, EnrollmentToken, Machine, MachineAlias,
+		MachineGroup, MaintenanceWindow, Operator, StoredKey, TangKey, UnlockPolicy,
+		Vault []ent.Interceptor
 	}
 )
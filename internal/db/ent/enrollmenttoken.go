@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
+)
+
+// EnrollmentToken is the model entity for the EnrollmentToken schema.
+type EnrollmentToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SHA256 hash of the token, the token itself is never stored
+	TokenHash []byte `json:"-"`
+	// Name of the machine enrolled with this token
+	MachineName string `json:"machine_name,omitempty"`
+	// Labels of the machine enrolled with this token
+	Labels map[string]string `json:"labels,omitempty"`
+	// Number of distinct operators that must approve the sessions of the machine enrolled with this token
+	RequiredApprovals int `json:"required_approvals,omitempty"`
+	// Require an operator to confirm the machine enrolled with this token before it can request keys
+	RequireConfirmation bool `json:"require_confirmation,omitempty"`
+	// When this token can no longer be used
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// When this token was used, tokens can only be used once
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// Fingerprint of the machine enrolled with this token, if used
+	MachineID string `json:"machine_id,omitempty"`
+	// When this token was created
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnrollmentToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case enrollmenttoken.FieldTokenHash, enrollmenttoken.FieldLabels:
+			values[i] = new([]byte)
+		case enrollmenttoken.FieldRequireConfirmation:
+			values[i] = new(sql.NullBool)
+		case enrollmenttoken.FieldID, enrollmenttoken.FieldRequiredApprovals:
+			values[i] = new(sql.NullInt64)
+		case enrollmenttoken.FieldMachineName, enrollmenttoken.FieldMachineID:
+			values[i] = new(sql.NullString)
+		case enrollmenttoken.FieldExpiresAt, enrollmenttoken.FieldUsedAt, enrollmenttoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnrollmentToken fields.
+func (_m *EnrollmentToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case enrollmenttoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case enrollmenttoken.FieldTokenHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value != nil {
+				_m.TokenHash = *value
+			}
+		case enrollmenttoken.FieldMachineName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_name", values[i])
+			} else if value.Valid {
+				_m.MachineName = value.String
+			}
+		case enrollmenttoken.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case enrollmenttoken.FieldRequiredApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_approvals", values[i])
+			} else if value.Valid {
+				_m.RequiredApprovals = int(value.Int64)
+			}
+		case enrollmenttoken.FieldRequireConfirmation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_confirmation", values[i])
+			} else if value.Valid {
+				_m.RequireConfirmation = value.Bool
+			}
+		case enrollmenttoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case enrollmenttoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case enrollmenttoken.FieldMachineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value.Valid {
+				_m.MachineID = value.String
+			}
+		case enrollmenttoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnrollmentToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnrollmentToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EnrollmentToken.
+// Note that you need to call EnrollmentToken.Unwrap() before calling this method if this EnrollmentToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnrollmentToken) Update() *EnrollmentTokenUpdateOne {
+	return NewEnrollmentTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnrollmentToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnrollmentToken) Unwrap() *EnrollmentToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnrollmentToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnrollmentToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnrollmentToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("machine_name=")
+	builder.WriteString(_m.MachineName)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("required_approvals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredApprovals))
+	builder.WriteString(", ")
+	builder.WriteString("require_confirmation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireConfirmation))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(_m.MachineID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnrollmentTokens is a parsable slice of EnrollmentToken.
+type EnrollmentTokens []*EnrollmentToken
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrollmenttoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the enrollmenttoken type in the database.
+	Label = "enrollment_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTokenHash holds the string denoting the token_hash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldMachineName holds the string denoting the machine_name field in the database.
+	FieldMachineName = "machine_name"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldRequiredApprovals holds the string denoting the required_approvals field in the database.
+	FieldRequiredApprovals = "required_approvals"
+	// FieldRequireConfirmation holds the string denoting the require_confirmation field in the database.
+	FieldRequireConfirmation = "require_confirmation"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldUsedAt holds the string denoting the used_at field in the database.
+	FieldUsedAt = "used_at"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the enrollmenttoken in the database.
+	Table = "enrollment_tokens"
+)
+
+// Columns holds all SQL columns for enrollmenttoken fields.
+var Columns = []string{
+	FieldID,
+	FieldTokenHash,
+	FieldMachineName,
+	FieldLabels,
+	FieldRequiredApprovals,
+	FieldRequireConfirmation,
+	FieldExpiresAt,
+	FieldUsedAt,
+	FieldMachineID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MachineNameValidator is a validator for the "machine_name" field. It is called by the builders before save.
+	MachineNameValidator func(string) error
+	// DefaultRequiredApprovals holds the default value on creation for the "required_approvals" field.
+	DefaultRequiredApprovals int
+	// RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	RequiredApprovalsValidator func(int) error
+	// DefaultRequireConfirmation holds the default value on creation for the "require_confirmation" field.
+	DefaultRequireConfirmation bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EnrollmentToken queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMachineName orders the results by the machine_name field.
+func ByMachineName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineName, opts...).ToFunc()
+}
+
+// ByRequiredApprovals orders the results by the required_approvals field.
+func ByRequiredApprovals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredApprovals, opts...).ToFunc()
+}
+
+// ByRequireConfirmation orders the results by the require_confirmation field.
+func ByRequireConfirmation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireConfirmation, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByUsedAt orders the results by the used_at field.
+func ByUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedAt, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
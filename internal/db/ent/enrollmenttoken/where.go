@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrollmenttoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldID, id))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// MachineName applies equality check predicate on the "machine_name" field. It's identical to MachineNameEQ.
+func MachineName(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldMachineName, v))
+}
+
+// RequiredApprovals applies equality check predicate on the "required_approvals" field. It's identical to RequiredApprovalsEQ.
+func RequiredApprovals(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// RequireConfirmation applies equality check predicate on the "require_confirmation" field. It's identical to RequireConfirmationEQ.
+func RequireConfirmation(v bool) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldRequireConfirmation, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldMachineID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...[]byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...[]byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v []byte) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// MachineNameEQ applies the EQ predicate on the "machine_name" field.
+func MachineNameEQ(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldMachineName, v))
+}
+
+// MachineNameNEQ applies the NEQ predicate on the "machine_name" field.
+func MachineNameNEQ(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldMachineName, v))
+}
+
+// MachineNameIn applies the In predicate on the "machine_name" field.
+func MachineNameIn(vs ...string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldMachineName, vs...))
+}
+
+// MachineNameNotIn applies the NotIn predicate on the "machine_name" field.
+func MachineNameNotIn(vs ...string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldMachineName, vs...))
+}
+
+// MachineNameGT applies the GT predicate on the "machine_name" field.
+func MachineNameGT(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldMachineName, v))
+}
+
+// MachineNameGTE applies the GTE predicate on the "machine_name" field.
+func MachineNameGTE(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldMachineName, v))
+}
+
+// MachineNameLT applies the LT predicate on the "machine_name" field.
+func MachineNameLT(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldMachineName, v))
+}
+
+// MachineNameLTE applies the LTE predicate on the "machine_name" field.
+func MachineNameLTE(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldMachineName, v))
+}
+
+// MachineNameContains applies the Contains predicate on the "machine_name" field.
+func MachineNameContains(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldContains(FieldMachineName, v))
+}
+
+// MachineNameHasPrefix applies the HasPrefix predicate on the "machine_name" field.
+func MachineNameHasPrefix(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldHasPrefix(FieldMachineName, v))
+}
+
+// MachineNameHasSuffix applies the HasSuffix predicate on the "machine_name" field.
+func MachineNameHasSuffix(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldHasSuffix(FieldMachineName, v))
+}
+
+// MachineNameEqualFold applies the EqualFold predicate on the "machine_name" field.
+func MachineNameEqualFold(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEqualFold(FieldMachineName, v))
+}
+
+// MachineNameContainsFold applies the ContainsFold predicate on the "machine_name" field.
+func MachineNameContainsFold(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldContainsFold(FieldMachineName, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotNull(FieldLabels))
+}
+
+// RequiredApprovalsEQ applies the EQ predicate on the "required_approvals" field.
+func RequiredApprovalsEQ(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsNEQ applies the NEQ predicate on the "required_approvals" field.
+func RequiredApprovalsNEQ(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsIn applies the In predicate on the "required_approvals" field.
+func RequiredApprovalsIn(vs ...int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsNotIn applies the NotIn predicate on the "required_approvals" field.
+func RequiredApprovalsNotIn(vs ...int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsGT applies the GT predicate on the "required_approvals" field.
+func RequiredApprovalsGT(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsGTE applies the GTE predicate on the "required_approvals" field.
+func RequiredApprovalsGTE(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLT applies the LT predicate on the "required_approvals" field.
+func RequiredApprovalsLT(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLTE applies the LTE predicate on the "required_approvals" field.
+func RequiredApprovalsLTE(v int) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldRequiredApprovals, v))
+}
+
+// RequireConfirmationEQ applies the EQ predicate on the "require_confirmation" field.
+func RequireConfirmationEQ(v bool) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldRequireConfirmation, v))
+}
+
+// RequireConfirmationNEQ applies the NEQ predicate on the "require_confirmation" field.
+func RequireConfirmationNEQ(v bool) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldRequireConfirmation, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotNull(FieldUsedAt))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// MachineIDGT applies the GT predicate on the "machine_id" field.
+func MachineIDGT(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldMachineID, v))
+}
+
+// MachineIDGTE applies the GTE predicate on the "machine_id" field.
+func MachineIDGTE(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldMachineID, v))
+}
+
+// MachineIDLT applies the LT predicate on the "machine_id" field.
+func MachineIDLT(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldMachineID, v))
+}
+
+// MachineIDLTE applies the LTE predicate on the "machine_id" field.
+func MachineIDLTE(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldMachineID, v))
+}
+
+// MachineIDContains applies the Contains predicate on the "machine_id" field.
+func MachineIDContains(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldContains(FieldMachineID, v))
+}
+
+// MachineIDHasPrefix applies the HasPrefix predicate on the "machine_id" field.
+func MachineIDHasPrefix(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldHasPrefix(FieldMachineID, v))
+}
+
+// MachineIDHasSuffix applies the HasSuffix predicate on the "machine_id" field.
+func MachineIDHasSuffix(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldHasSuffix(FieldMachineID, v))
+}
+
+// MachineIDIsNil applies the IsNil predicate on the "machine_id" field.
+func MachineIDIsNil() predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIsNull(FieldMachineID))
+}
+
+// MachineIDNotNil applies the NotNil predicate on the "machine_id" field.
+func MachineIDNotNil() predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotNull(FieldMachineID))
+}
+
+// MachineIDEqualFold applies the EqualFold predicate on the "machine_id" field.
+func MachineIDEqualFold(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEqualFold(FieldMachineID, v))
+}
+
+// MachineIDContainsFold applies the ContainsFold predicate on the "machine_id" field.
+func MachineIDContainsFold(v string) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldContainsFold(FieldMachineID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnrollmentToken) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnrollmentToken) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnrollmentToken) predicate.EnrollmentToken {
+	return predicate.EnrollmentToken(sql.NotPredicates(p))
+}
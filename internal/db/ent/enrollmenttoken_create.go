@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
+)
+
+// EnrollmentTokenCreate is the builder for creating a EnrollmentToken entity.
+type EnrollmentTokenCreate struct {
+	config
+	mutation *EnrollmentTokenMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *EnrollmentTokenCreate) SetTokenHash(v []byte) *EnrollmentTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetMachineName sets the "machine_name" field.
+func (_c *EnrollmentTokenCreate) SetMachineName(v string) *EnrollmentTokenCreate {
+	_c.mutation.SetMachineName(v)
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *EnrollmentTokenCreate) SetLabels(v map[string]string) *EnrollmentTokenCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_c *EnrollmentTokenCreate) SetRequiredApprovals(v int) *EnrollmentTokenCreate {
+	_c.mutation.SetRequiredApprovals(v)
+	return _c
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_c *EnrollmentTokenCreate) SetNillableRequiredApprovals(v *int) *EnrollmentTokenCreate {
+	if v != nil {
+		_c.SetRequiredApprovals(*v)
+	}
+	return _c
+}
+
+// SetRequireConfirmation sets the "require_confirmation" field.
+func (_c *EnrollmentTokenCreate) SetRequireConfirmation(v bool) *EnrollmentTokenCreate {
+	_c.mutation.SetRequireConfirmation(v)
+	return _c
+}
+
+// SetNillableRequireConfirmation sets the "require_confirmation" field if the given value is not nil.
+func (_c *EnrollmentTokenCreate) SetNillableRequireConfirmation(v *bool) *EnrollmentTokenCreate {
+	if v != nil {
+		_c.SetRequireConfirmation(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EnrollmentTokenCreate) SetExpiresAt(v time.Time) *EnrollmentTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *EnrollmentTokenCreate) SetUsedAt(v time.Time) *EnrollmentTokenCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *EnrollmentTokenCreate) SetNillableUsedAt(v *time.Time) *EnrollmentTokenCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *EnrollmentTokenCreate) SetMachineID(v string) *EnrollmentTokenCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_c *EnrollmentTokenCreate) SetNillableMachineID(v *string) *EnrollmentTokenCreate {
+	if v != nil {
+		_c.SetMachineID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnrollmentTokenCreate) SetCreatedAt(v time.Time) *EnrollmentTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnrollmentTokenCreate) SetNillableCreatedAt(v *time.Time) *EnrollmentTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EnrollmentTokenMutation object of the builder.
+func (_c *EnrollmentTokenCreate) Mutation() *EnrollmentTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the EnrollmentToken in the database.
+func (_c *EnrollmentTokenCreate) Save(ctx context.Context) (*EnrollmentToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnrollmentTokenCreate) SaveX(ctx context.Context) *EnrollmentToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnrollmentTokenCreate) defaults() {
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		v := enrollmenttoken.DefaultRequiredApprovals
+		_c.mutation.SetRequiredApprovals(v)
+	}
+	if _, ok := _c.mutation.RequireConfirmation(); !ok {
+		v := enrollmenttoken.DefaultRequireConfirmation
+		_c.mutation.SetRequireConfirmation(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := enrollmenttoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnrollmentTokenCreate) check() error {
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "EnrollmentToken.token_hash"`)}
+	}
+	if _, ok := _c.mutation.MachineName(); !ok {
+		return &ValidationError{Name: "machine_name", err: errors.New(`ent: missing required field "EnrollmentToken.machine_name"`)}
+	}
+	if v, ok := _c.mutation.MachineName(); ok {
+		if err := enrollmenttoken.MachineNameValidator(v); err != nil {
+			return &ValidationError{Name: "machine_name", err: fmt.Errorf(`ent: validator failed for field "EnrollmentToken.machine_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		return &ValidationError{Name: "required_approvals", err: errors.New(`ent: missing required field "EnrollmentToken.required_approvals"`)}
+	}
+	if v, ok := _c.mutation.RequiredApprovals(); ok {
+		if err := enrollmenttoken.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "EnrollmentToken.required_approvals": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequireConfirmation(); !ok {
+		return &ValidationError{Name: "require_confirmation", err: errors.New(`ent: missing required field "EnrollmentToken.require_confirmation"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EnrollmentToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnrollmentToken.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EnrollmentTokenCreate) sqlSave(ctx context.Context) (*EnrollmentToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnrollmentTokenCreate) createSpec() (*EnrollmentToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnrollmentToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(enrollmenttoken.Table, sqlgraph.NewFieldSpec(enrollmenttoken.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(enrollmenttoken.FieldTokenHash, field.TypeBytes, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.MachineName(); ok {
+		_spec.SetField(enrollmenttoken.FieldMachineName, field.TypeString, value)
+		_node.MachineName = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(enrollmenttoken.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.RequiredApprovals(); ok {
+		_spec.SetField(enrollmenttoken.FieldRequiredApprovals, field.TypeInt, value)
+		_node.RequiredApprovals = value
+	}
+	if value, ok := _c.mutation.RequireConfirmation(); ok {
+		_spec.SetField(enrollmenttoken.FieldRequireConfirmation, field.TypeBool, value)
+		_node.RequireConfirmation = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.MachineID(); ok {
+		_spec.SetField(enrollmenttoken.FieldMachineID, field.TypeString, value)
+		_node.MachineID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EnrollmentTokenCreateBulk is the builder for creating many EnrollmentToken entities in bulk.
+type EnrollmentTokenCreateBulk struct {
+	config
+	err      error
+	builders []*EnrollmentTokenCreate
+}
+
+// Save creates the EnrollmentToken entities in the database.
+func (_c *EnrollmentTokenCreateBulk) Save(ctx context.Context) ([]*EnrollmentToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnrollmentToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnrollmentTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnrollmentTokenCreateBulk) SaveX(ctx context.Context) []*EnrollmentToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// EnrollmentTokenUpdate is the builder for updating EnrollmentToken entities.
+type EnrollmentTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnrollmentTokenMutation
+}
+
+// Where appends a list predicates to the EnrollmentTokenUpdate builder.
+func (_u *EnrollmentTokenUpdate) Where(ps ...predicate.EnrollmentToken) *EnrollmentTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *EnrollmentTokenUpdate) SetTokenHash(v []byte) *EnrollmentTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetMachineName sets the "machine_name" field.
+func (_u *EnrollmentTokenUpdate) SetMachineName(v string) *EnrollmentTokenUpdate {
+	_u.mutation.SetMachineName(v)
+	return _u
+}
+
+// SetNillableMachineName sets the "machine_name" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdate) SetNillableMachineName(v *string) *EnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetMachineName(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *EnrollmentTokenUpdate) SetLabels(v map[string]string) *EnrollmentTokenUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *EnrollmentTokenUpdate) ClearLabels() *EnrollmentTokenUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *EnrollmentTokenUpdate) SetRequiredApprovals(v int) *EnrollmentTokenUpdate {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdate) SetNillableRequiredApprovals(v *int) *EnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *EnrollmentTokenUpdate) AddRequiredApprovals(v int) *EnrollmentTokenUpdate {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetRequireConfirmation sets the "require_confirmation" field.
+func (_u *EnrollmentTokenUpdate) SetRequireConfirmation(v bool) *EnrollmentTokenUpdate {
+	_u.mutation.SetRequireConfirmation(v)
+	return _u
+}
+
+// SetNillableRequireConfirmation sets the "require_confirmation" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdate) SetNillableRequireConfirmation(v *bool) *EnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetRequireConfirmation(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EnrollmentTokenUpdate) SetExpiresAt(v time.Time) *EnrollmentTokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdate) SetNillableExpiresAt(v *time.Time) *EnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *EnrollmentTokenUpdate) SetUsedAt(v time.Time) *EnrollmentTokenUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdate) SetNillableUsedAt(v *time.Time) *EnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *EnrollmentTokenUpdate) ClearUsedAt() *EnrollmentTokenUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *EnrollmentTokenUpdate) SetMachineID(v string) *EnrollmentTokenUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdate) SetNillableMachineID(v *string) *EnrollmentTokenUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (_u *EnrollmentTokenUpdate) ClearMachineID() *EnrollmentTokenUpdate {
+	_u.mutation.ClearMachineID()
+	return _u
+}
+
+// Mutation returns the EnrollmentTokenMutation object of the builder.
+func (_u *EnrollmentTokenUpdate) Mutation() *EnrollmentTokenMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnrollmentTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnrollmentTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentTokenUpdate) check() error {
+	if v, ok := _u.mutation.MachineName(); ok {
+		if err := enrollmenttoken.MachineNameValidator(v); err != nil {
+			return &ValidationError{Name: "machine_name", err: fmt.Errorf(`ent: validator failed for field "EnrollmentToken.machine_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := enrollmenttoken.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "EnrollmentToken.required_approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EnrollmentTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmenttoken.Table, enrollmenttoken.Columns, sqlgraph.NewFieldSpec(enrollmenttoken.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(enrollmenttoken.FieldTokenHash, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.MachineName(); ok {
+		_spec.SetField(enrollmenttoken.FieldMachineName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(enrollmenttoken.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(enrollmenttoken.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(enrollmenttoken.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(enrollmenttoken.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RequireConfirmation(); ok {
+		_spec.SetField(enrollmenttoken.FieldRequireConfirmation, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(enrollmenttoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(enrollmenttoken.FieldMachineID, field.TypeString, value)
+	}
+	if _u.mutation.MachineIDCleared() {
+		_spec.ClearField(enrollmenttoken.FieldMachineID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmenttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnrollmentTokenUpdateOne is the builder for updating a single EnrollmentToken entity.
+type EnrollmentTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnrollmentTokenMutation
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *EnrollmentTokenUpdateOne) SetTokenHash(v []byte) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetMachineName sets the "machine_name" field.
+func (_u *EnrollmentTokenUpdateOne) SetMachineName(v string) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetMachineName(v)
+	return _u
+}
+
+// SetNillableMachineName sets the "machine_name" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdateOne) SetNillableMachineName(v *string) *EnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetMachineName(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *EnrollmentTokenUpdateOne) SetLabels(v map[string]string) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *EnrollmentTokenUpdateOne) ClearLabels() *EnrollmentTokenUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *EnrollmentTokenUpdateOne) SetRequiredApprovals(v int) *EnrollmentTokenUpdateOne {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdateOne) SetNillableRequiredApprovals(v *int) *EnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *EnrollmentTokenUpdateOne) AddRequiredApprovals(v int) *EnrollmentTokenUpdateOne {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetRequireConfirmation sets the "require_confirmation" field.
+func (_u *EnrollmentTokenUpdateOne) SetRequireConfirmation(v bool) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetRequireConfirmation(v)
+	return _u
+}
+
+// SetNillableRequireConfirmation sets the "require_confirmation" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdateOne) SetNillableRequireConfirmation(v *bool) *EnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetRequireConfirmation(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EnrollmentTokenUpdateOne) SetExpiresAt(v time.Time) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdateOne) SetNillableExpiresAt(v *time.Time) *EnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *EnrollmentTokenUpdateOne) SetUsedAt(v time.Time) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdateOne) SetNillableUsedAt(v *time.Time) *EnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *EnrollmentTokenUpdateOne) ClearUsedAt() *EnrollmentTokenUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *EnrollmentTokenUpdateOne) SetMachineID(v string) *EnrollmentTokenUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *EnrollmentTokenUpdateOne) SetNillableMachineID(v *string) *EnrollmentTokenUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (_u *EnrollmentTokenUpdateOne) ClearMachineID() *EnrollmentTokenUpdateOne {
+	_u.mutation.ClearMachineID()
+	return _u
+}
+
+// Mutation returns the EnrollmentTokenMutation object of the builder.
+func (_u *EnrollmentTokenUpdateOne) Mutation() *EnrollmentTokenMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EnrollmentTokenUpdate builder.
+func (_u *EnrollmentTokenUpdateOne) Where(ps ...predicate.EnrollmentToken) *EnrollmentTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnrollmentTokenUpdateOne) Select(field string, fields ...string) *EnrollmentTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnrollmentToken entity.
+func (_u *EnrollmentTokenUpdateOne) Save(ctx context.Context) (*EnrollmentToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentTokenUpdateOne) SaveX(ctx context.Context) *EnrollmentToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnrollmentTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.MachineName(); ok {
+		if err := enrollmenttoken.MachineNameValidator(v); err != nil {
+			return &ValidationError{Name: "machine_name", err: fmt.Errorf(`ent: validator failed for field "EnrollmentToken.machine_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := enrollmenttoken.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "EnrollmentToken.required_approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EnrollmentTokenUpdateOne) sqlSave(ctx context.Context) (_node *EnrollmentToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmenttoken.Table, enrollmenttoken.Columns, sqlgraph.NewFieldSpec(enrollmenttoken.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnrollmentToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, enrollmenttoken.FieldID)
+		for _, f := range fields {
+			if !enrollmenttoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != enrollmenttoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(enrollmenttoken.FieldTokenHash, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.MachineName(); ok {
+		_spec.SetField(enrollmenttoken.FieldMachineName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(enrollmenttoken.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(enrollmenttoken.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(enrollmenttoken.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(enrollmenttoken.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RequireConfirmation(); ok {
+		_spec.SetField(enrollmenttoken.FieldRequireConfirmation, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(enrollmenttoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(enrollmenttoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(enrollmenttoken.FieldMachineID, field.TypeString, value)
+	}
+	if _u.mutation.MachineIDCleared() {
+		_spec.ClearField(enrollmenttoken.FieldMachineID, field.TypeString)
+	}
+	_node = &EnrollmentToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmenttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
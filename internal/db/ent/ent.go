@@ -12,7 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
 	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+	"git.rgst.io/homelab/klefki/internal/db/ent/operator"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"git.rgst.io/homelab/klefki/internal/db/ent/vault"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -70,13 +81,24 @@ var (
 )
 
 // checkColumn checks if the column exists in the given table.
-func checkColumn(table, column string) error {
+func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			machine.Table: machine.ValidColumn,
+			auditevent.Table:        auditevent.ValidColumn,
+			bootmeasurement.Table:   bootmeasurement.ValidColumn,
+			enrollmenttoken.Table:   enrollmenttoken.ValidColumn,
+			machine.Table:           machine.ValidColumn,
+			machinealias.Table:      machinealias.ValidColumn,
+			machinegroup.Table:      machinegroup.ValidColumn,
+			maintenancewindow.Table: maintenancewindow.ValidColumn,
+			operator.Table:          operator.ValidColumn,
+			storedkey.Table:         storedkey.ValidColumn,
+			tangkey.Table:           tangkey.ValidColumn,
+			unlockpolicy.Table:      unlockpolicy.ValidColumn,
+			vault.Table:             vault.ValidColumn,
 		})
 	})
-	return columnCheck(table, column)
+	return columnCheck(t, c)
 }
 
 // Asc applies the given fields in ASC order.
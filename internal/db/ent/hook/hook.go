@@ -9,6 +9,42 @@ import (
 	"git.rgst.io/homelab/klefki/internal/db/ent"
 )
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *ent.AuditEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEventMutation", m)
+}
+
+// The BootMeasurementFunc type is an adapter to allow the use of ordinary
+// function as BootMeasurement mutator.
+type BootMeasurementFunc func(context.Context, *ent.BootMeasurementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BootMeasurementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BootMeasurementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BootMeasurementMutation", m)
+}
+
+// The EnrollmentTokenFunc type is an adapter to allow the use of ordinary
+// function as EnrollmentToken mutator.
+type EnrollmentTokenFunc func(context.Context, *ent.EnrollmentTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnrollmentTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnrollmentTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnrollmentTokenMutation", m)
+}
+
 // The MachineFunc type is an adapter to allow the use of ordinary
 // function as Machine mutator.
 type MachineFunc func(context.Context, *ent.MachineMutation) (ent.Value, error)
@@ -21,6 +57,102 @@ func (f MachineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MachineMutation", m)
 }
 
+// The MachineAliasFunc type is an adapter to allow the use of ordinary
+// function as MachineAlias mutator.
+type MachineAliasFunc func(context.Context, *ent.MachineAliasMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MachineAliasFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MachineAliasMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MachineAliasMutation", m)
+}
+
+// The MachineGroupFunc type is an adapter to allow the use of ordinary
+// function as MachineGroup mutator.
+type MachineGroupFunc func(context.Context, *ent.MachineGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MachineGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MachineGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MachineGroupMutation", m)
+}
+
+// The MaintenanceWindowFunc type is an adapter to allow the use of ordinary
+// function as MaintenanceWindow mutator.
+type MaintenanceWindowFunc func(context.Context, *ent.MaintenanceWindowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MaintenanceWindowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MaintenanceWindowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MaintenanceWindowMutation", m)
+}
+
+// The OperatorFunc type is an adapter to allow the use of ordinary
+// function as Operator mutator.
+type OperatorFunc func(context.Context, *ent.OperatorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OperatorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OperatorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OperatorMutation", m)
+}
+
+// The StoredKeyFunc type is an adapter to allow the use of ordinary
+// function as StoredKey mutator.
+type StoredKeyFunc func(context.Context, *ent.StoredKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StoredKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StoredKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StoredKeyMutation", m)
+}
+
+// The TangKeyFunc type is an adapter to allow the use of ordinary
+// function as TangKey mutator.
+type TangKeyFunc func(context.Context, *ent.TangKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TangKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TangKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TangKeyMutation", m)
+}
+
+// The UnlockPolicyFunc type is an adapter to allow the use of ordinary
+// function as UnlockPolicy mutator.
+type UnlockPolicyFunc func(context.Context, *ent.UnlockPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UnlockPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UnlockPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UnlockPolicyMutation", m)
+}
+
+// The VaultFunc type is an adapter to allow the use of ordinary
+// function as Vault mutator.
+type VaultFunc func(context.Context, *ent.VaultMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VaultFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VaultMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VaultMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
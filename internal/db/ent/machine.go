@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -15,15 +17,47 @@ import (
 type Machine struct {
 	config `json:"-"`
 	// ID of the ent.
-	// Fingerprint of the public key
+	// Stable ID of the machine, which doesn't change when its key is rotated
 	ID string `json:"id,omitempty"`
 	// User friendly name of this machine (e.g., hostname)
 	Name string `json:"name,omitempty"`
+	// Free-form description of this machine
+	Description string `json:"description,omitempty"`
 	// Public key of the machine
 	PublicKey []byte `json:"public_key,omitempty"`
-	// When this machine was added in UTC
-	CreatedAt    string `json:"created_at,omitempty"`
-	selectValues sql.SelectValues
+	// Fingerprint of the public key, which machines are looked up by
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// When this machine was added
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Number of distinct operators that must approve a session before its key is released
+	RequiredApprovals int `json:"required_approvals,omitempty"`
+	// Number of Shamir shares that must be submitted to recover the key, zero if the key isn't split
+	ShareThreshold int `json:"share_threshold,omitempty"`
+	// Index of the Shamir share of the key held by this server, zero if it holds the whole key
+	ShareIndex int `json:"share_index,omitempty"`
+	// Release the key stored in the vault without an operator submitting it
+	AutoUnlock bool `json:"auto_unlock,omitempty"`
+	// Labels of the machine, used by policies
+	Labels map[string]string `json:"labels,omitempty"`
+	// Hardware attributes the machine is bound to, set on first use
+	Hardware map[string]string `json:"hardware,omitempty"`
+	// Mismatching hardware attributes last reported, to be accepted by an operator
+	PendingHardware map[string]string `json:"pending_hardware,omitempty"`
+	// What happens when the reported hardware attributes don't match
+	HardwareBinding machine.HardwareBinding `json:"hardware_binding,omitempty"`
+	// When this machine last requested its key
+	LastAskedAt *time.Time `json:"last_asked_at,omitempty"`
+	// When a key was last released to this machine
+	LastUnlockedAt *time.Time `json:"last_unlocked_at,omitempty"`
+	// Whether the machine can request keys. Quarantined machines have their requests recorded, but never receive a key
+	State machine.State `json:"state,omitempty"`
+	// When the machine stops being able to request keys, if ever
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Names of the secrets of the machine (e.g., one per volume), the first being its default one. If empty, it has a single secret
+	Secrets []string `json:"secrets,omitempty"`
+	// Whether this machine enrolled itself and awaits confirmation by an operator before it can request keys
+	PendingConfirmation bool `json:"pending_confirmation,omitempty"`
+	selectValues        sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -31,10 +65,16 @@ func (*Machine) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case machine.FieldPublicKey:
+		case machine.FieldPublicKey, machine.FieldLabels, machine.FieldHardware, machine.FieldPendingHardware, machine.FieldSecrets:
 			values[i] = new([]byte)
-		case machine.FieldID, machine.FieldName, machine.FieldCreatedAt:
+		case machine.FieldAutoUnlock, machine.FieldPendingConfirmation:
+			values[i] = new(sql.NullBool)
+		case machine.FieldRequiredApprovals, machine.FieldShareThreshold, machine.FieldShareIndex:
+			values[i] = new(sql.NullInt64)
+		case machine.FieldID, machine.FieldName, machine.FieldDescription, machine.FieldFingerprint, machine.FieldHardwareBinding, machine.FieldState:
 			values[i] = new(sql.NullString)
+		case machine.FieldCreatedAt, machine.FieldLastAskedAt, machine.FieldLastUnlockedAt, machine.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -44,7 +84,7 @@ func (*Machine) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the Machine fields.
-func (m *Machine) assignValues(columns []string, values []any) error {
+func (_m *Machine) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -54,28 +94,135 @@ func (m *Machine) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field id", values[i])
 			} else if value.Valid {
-				m.ID = value.String
+				_m.ID = value.String
 			}
 		case machine.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
-				m.Name = value.String
+				_m.Name = value.String
+			}
+		case machine.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
 			}
 		case machine.FieldPublicKey:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field public_key", values[i])
 			} else if value != nil {
-				m.PublicKey = *value
+				_m.PublicKey = *value
 			}
-		case machine.FieldCreatedAt:
+		case machine.FieldFingerprint:
 			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				_m.Fingerprint = value.String
+			}
+		case machine.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
-				m.CreatedAt = value.String
+				_m.CreatedAt = value.Time
+			}
+		case machine.FieldRequiredApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_approvals", values[i])
+			} else if value.Valid {
+				_m.RequiredApprovals = int(value.Int64)
+			}
+		case machine.FieldShareThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_threshold", values[i])
+			} else if value.Valid {
+				_m.ShareThreshold = int(value.Int64)
+			}
+		case machine.FieldShareIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_index", values[i])
+			} else if value.Valid {
+				_m.ShareIndex = int(value.Int64)
+			}
+		case machine.FieldAutoUnlock:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_unlock", values[i])
+			} else if value.Valid {
+				_m.AutoUnlock = value.Bool
+			}
+		case machine.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case machine.FieldHardware:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Hardware); err != nil {
+					return fmt.Errorf("unmarshal field hardware: %w", err)
+				}
+			}
+		case machine.FieldPendingHardware:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_hardware", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PendingHardware); err != nil {
+					return fmt.Errorf("unmarshal field pending_hardware: %w", err)
+				}
+			}
+		case machine.FieldHardwareBinding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_binding", values[i])
+			} else if value.Valid {
+				_m.HardwareBinding = machine.HardwareBinding(value.String)
+			}
+		case machine.FieldLastAskedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_asked_at", values[i])
+			} else if value.Valid {
+				_m.LastAskedAt = new(time.Time)
+				*_m.LastAskedAt = value.Time
+			}
+		case machine.FieldLastUnlockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_unlocked_at", values[i])
+			} else if value.Valid {
+				_m.LastUnlockedAt = new(time.Time)
+				*_m.LastUnlockedAt = value.Time
+			}
+		case machine.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = machine.State(value.String)
+			}
+		case machine.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case machine.FieldSecrets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secrets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Secrets); err != nil {
+					return fmt.Errorf("unmarshal field secrets: %w", err)
+				}
+			}
+		case machine.FieldPendingConfirmation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_confirmation", values[i])
+			} else if value.Valid {
+				_m.PendingConfirmation = value.Bool
 			}
 		default:
-			m.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -83,41 +230,95 @@ func (m *Machine) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the Machine.
 // This includes values selected through modifiers, order, etc.
-func (m *Machine) Value(name string) (ent.Value, error) {
-	return m.selectValues.Get(name)
+func (_m *Machine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // Update returns a builder for updating this Machine.
 // Note that you need to call Machine.Unwrap() before calling this method if this Machine
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (m *Machine) Update() *MachineUpdateOne {
-	return NewMachineClient(m.config).UpdateOne(m)
+func (_m *Machine) Update() *MachineUpdateOne {
+	return NewMachineClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the Machine entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (m *Machine) Unwrap() *Machine {
-	_tx, ok := m.config.driver.(*txDriver)
+func (_m *Machine) Unwrap() *Machine {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: Machine is not a transactional entity")
 	}
-	m.config.driver = _tx.drv
-	return m
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (m *Machine) String() string {
+func (_m *Machine) String() string {
 	var builder strings.Builder
 	builder.WriteString("Machine(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("name=")
-	builder.WriteString(m.Name)
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
 	builder.WriteString("public_key=")
-	builder.WriteString(fmt.Sprintf("%v", m.PublicKey))
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(_m.Fingerprint)
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
-	builder.WriteString(m.CreatedAt)
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("required_approvals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredApprovals))
+	builder.WriteString(", ")
+	builder.WriteString("share_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShareThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("share_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShareIndex))
+	builder.WriteString(", ")
+	builder.WriteString("auto_unlock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoUnlock))
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("hardware=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hardware))
+	builder.WriteString(", ")
+	builder.WriteString("pending_hardware=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PendingHardware))
+	builder.WriteString(", ")
+	builder.WriteString("hardware_binding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HardwareBinding))
+	builder.WriteString(", ")
+	if v := _m.LastAskedAt; v != nil {
+		builder.WriteString("last_asked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastUnlockedAt; v != nil {
+		builder.WriteString("last_unlocked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.State))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("secrets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Secrets))
+	builder.WriteString(", ")
+	builder.WriteString("pending_confirmation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PendingConfirmation))
 	builder.WriteByte(')')
 	return builder.String()
 }
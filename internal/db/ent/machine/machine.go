@@ -3,6 +3,9 @@
 package machine
 
 import (
+	"fmt"
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -13,10 +16,42 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
 	// FieldPublicKey holds the string denoting the public_key field in the database.
 	FieldPublicKey = "public_key"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldRequiredApprovals holds the string denoting the required_approvals field in the database.
+	FieldRequiredApprovals = "required_approvals"
+	// FieldShareThreshold holds the string denoting the share_threshold field in the database.
+	FieldShareThreshold = "share_threshold"
+	// FieldShareIndex holds the string denoting the share_index field in the database.
+	FieldShareIndex = "share_index"
+	// FieldAutoUnlock holds the string denoting the auto_unlock field in the database.
+	FieldAutoUnlock = "auto_unlock"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldHardware holds the string denoting the hardware field in the database.
+	FieldHardware = "hardware"
+	// FieldPendingHardware holds the string denoting the pending_hardware field in the database.
+	FieldPendingHardware = "pending_hardware"
+	// FieldHardwareBinding holds the string denoting the hardware_binding field in the database.
+	FieldHardwareBinding = "hardware_binding"
+	// FieldLastAskedAt holds the string denoting the last_asked_at field in the database.
+	FieldLastAskedAt = "last_asked_at"
+	// FieldLastUnlockedAt holds the string denoting the last_unlocked_at field in the database.
+	FieldLastUnlockedAt = "last_unlocked_at"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldSecrets holds the string denoting the secrets field in the database.
+	FieldSecrets = "secrets"
+	// FieldPendingConfirmation holds the string denoting the pending_confirmation field in the database.
+	FieldPendingConfirmation = "pending_confirmation"
 	// Table holds the table name of the machine in the database.
 	Table = "machines"
 )
@@ -25,8 +60,24 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldDescription,
 	FieldPublicKey,
+	FieldFingerprint,
 	FieldCreatedAt,
+	FieldRequiredApprovals,
+	FieldShareThreshold,
+	FieldShareIndex,
+	FieldAutoUnlock,
+	FieldLabels,
+	FieldHardware,
+	FieldPendingHardware,
+	FieldHardwareBinding,
+	FieldLastAskedAt,
+	FieldLastUnlockedAt,
+	FieldState,
+	FieldExpiresAt,
+	FieldSecrets,
+	FieldPendingConfirmation,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -41,9 +92,80 @@ func ValidColumn(column string) bool {
 
 var (
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt string
+	DefaultCreatedAt func() time.Time
+	// DefaultRequiredApprovals holds the default value on creation for the "required_approvals" field.
+	DefaultRequiredApprovals int
+	// RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	RequiredApprovalsValidator func(int) error
+	// DefaultShareThreshold holds the default value on creation for the "share_threshold" field.
+	DefaultShareThreshold int
+	// ShareThresholdValidator is a validator for the "share_threshold" field. It is called by the builders before save.
+	ShareThresholdValidator func(int) error
+	// DefaultShareIndex holds the default value on creation for the "share_index" field.
+	DefaultShareIndex int
+	// ShareIndexValidator is a validator for the "share_index" field. It is called by the builders before save.
+	ShareIndexValidator func(int) error
+	// DefaultAutoUnlock holds the default value on creation for the "auto_unlock" field.
+	DefaultAutoUnlock bool
+	// SecretsValidator is a validator for the "secrets" field. It is called by the builders before save.
+	SecretsValidator func([]string) error
+	// DefaultPendingConfirmation holds the default value on creation for the "pending_confirmation" field.
+	DefaultPendingConfirmation bool
+)
+
+// HardwareBinding defines the type for the "hardware_binding" enum field.
+type HardwareBinding string
+
+// HardwareBindingWarn is the default value of the HardwareBinding enum.
+const DefaultHardwareBinding = HardwareBindingWarn
+
+// HardwareBinding values.
+const (
+	HardwareBindingWarn    HardwareBinding = "warn"
+	HardwareBindingEnforce HardwareBinding = "enforce"
 )
 
+func (hb HardwareBinding) String() string {
+	return string(hb)
+}
+
+// HardwareBindingValidator is a validator for the "hardware_binding" field enum values. It is called by the builders before save.
+func HardwareBindingValidator(hb HardwareBinding) error {
+	switch hb {
+	case HardwareBindingWarn, HardwareBindingEnforce:
+		return nil
+	default:
+		return fmt.Errorf("machine: invalid enum value for hardware_binding field: %q", hb)
+	}
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateActive is the default value of the State enum.
+const DefaultState = StateActive
+
+// State values.
+const (
+	StateActive      State = "active"
+	StateDisabled    State = "disabled"
+	StateQuarantined State = "quarantined"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateActive, StateDisabled, StateQuarantined:
+		return nil
+	default:
+		return fmt.Errorf("machine: invalid enum value for state field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Machine queries.
 type OrderOption func(*sql.Selector)
 
@@ -57,7 +179,67 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByRequiredApprovals orders the results by the required_approvals field.
+func ByRequiredApprovals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredApprovals, opts...).ToFunc()
+}
+
+// ByShareThreshold orders the results by the share_threshold field.
+func ByShareThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareThreshold, opts...).ToFunc()
+}
+
+// ByShareIndex orders the results by the share_index field.
+func ByShareIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareIndex, opts...).ToFunc()
+}
+
+// ByAutoUnlock orders the results by the auto_unlock field.
+func ByAutoUnlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoUnlock, opts...).ToFunc()
+}
+
+// ByHardwareBinding orders the results by the hardware_binding field.
+func ByHardwareBinding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardwareBinding, opts...).ToFunc()
+}
+
+// ByLastAskedAt orders the results by the last_asked_at field.
+func ByLastAskedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAskedAt, opts...).ToFunc()
+}
+
+// ByLastUnlockedAt orders the results by the last_unlocked_at field.
+func ByLastUnlockedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUnlockedAt, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByPendingConfirmation orders the results by the pending_confirmation field.
+func ByPendingConfirmation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingConfirmation, opts...).ToFunc()
+}
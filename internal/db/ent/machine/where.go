@@ -3,6 +3,8 @@
 package machine
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
 )
@@ -67,16 +69,66 @@ func Name(v string) predicate.Machine {
 	return predicate.Machine(sql.FieldEQ(FieldName, v))
 }
 
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldDescription, v))
+}
+
 // PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
 func PublicKey(v []byte) predicate.Machine {
 	return predicate.Machine(sql.FieldEQ(FieldPublicKey, v))
 }
 
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldFingerprint, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v string) predicate.Machine {
+func CreatedAt(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// RequiredApprovals applies equality check predicate on the "required_approvals" field. It's identical to RequiredApprovalsEQ.
+func RequiredApprovals(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// ShareThreshold applies equality check predicate on the "share_threshold" field. It's identical to ShareThresholdEQ.
+func ShareThreshold(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldShareThreshold, v))
+}
+
+// ShareIndex applies equality check predicate on the "share_index" field. It's identical to ShareIndexEQ.
+func ShareIndex(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldShareIndex, v))
+}
+
+// AutoUnlock applies equality check predicate on the "auto_unlock" field. It's identical to AutoUnlockEQ.
+func AutoUnlock(v bool) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldAutoUnlock, v))
+}
+
+// LastAskedAt applies equality check predicate on the "last_asked_at" field. It's identical to LastAskedAtEQ.
+func LastAskedAt(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldLastAskedAt, v))
+}
+
+// LastUnlockedAt applies equality check predicate on the "last_unlocked_at" field. It's identical to LastUnlockedAtEQ.
+func LastUnlockedAt(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldLastUnlockedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// PendingConfirmation applies equality check predicate on the "pending_confirmation" field. It's identical to PendingConfirmationEQ.
+func PendingConfirmation(v bool) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldPendingConfirmation, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Machine {
 	return predicate.Machine(sql.FieldEQ(FieldName, v))
@@ -142,6 +194,81 @@ func NameContainsFold(v string) predicate.Machine {
 	return predicate.Machine(sql.FieldContainsFold(FieldName, v))
 }
 
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldContainsFold(FieldDescription, v))
+}
+
 // PublicKeyEQ applies the EQ predicate on the "public_key" field.
 func PublicKeyEQ(v []byte) predicate.Machine {
 	return predicate.Machine(sql.FieldEQ(FieldPublicKey, v))
@@ -182,69 +309,489 @@ func PublicKeyLTE(v []byte) predicate.Machine {
 	return predicate.Machine(sql.FieldLTE(FieldPublicKey, v))
 }
 
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldFingerprint))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.Machine {
+	return predicate.Machine(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v string) predicate.Machine {
+func CreatedAtEQ(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldEQ(FieldCreatedAt, v))
 }
 
 // CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v string) predicate.Machine {
+func CreatedAtNEQ(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldNEQ(FieldCreatedAt, v))
 }
 
 // CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...string) predicate.Machine {
+func CreatedAtIn(vs ...time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldIn(FieldCreatedAt, vs...))
 }
 
 // CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...string) predicate.Machine {
+func CreatedAtNotIn(vs ...time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldNotIn(FieldCreatedAt, vs...))
 }
 
 // CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v string) predicate.Machine {
+func CreatedAtGT(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldGT(FieldCreatedAt, v))
 }
 
 // CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v string) predicate.Machine {
+func CreatedAtGTE(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldGTE(FieldCreatedAt, v))
 }
 
 // CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v string) predicate.Machine {
+func CreatedAtLT(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldLT(FieldCreatedAt, v))
 }
 
 // CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v string) predicate.Machine {
+func CreatedAtLTE(v time.Time) predicate.Machine {
 	return predicate.Machine(sql.FieldLTE(FieldCreatedAt, v))
 }
 
-// CreatedAtContains applies the Contains predicate on the "created_at" field.
-func CreatedAtContains(v string) predicate.Machine {
-	return predicate.Machine(sql.FieldContains(FieldCreatedAt, v))
+// RequiredApprovalsEQ applies the EQ predicate on the "required_approvals" field.
+func RequiredApprovalsEQ(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsNEQ applies the NEQ predicate on the "required_approvals" field.
+func RequiredApprovalsNEQ(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsIn applies the In predicate on the "required_approvals" field.
+func RequiredApprovalsIn(vs ...int) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsNotIn applies the NotIn predicate on the "required_approvals" field.
+func RequiredApprovalsNotIn(vs ...int) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsGT applies the GT predicate on the "required_approvals" field.
+func RequiredApprovalsGT(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsGTE applies the GTE predicate on the "required_approvals" field.
+func RequiredApprovalsGTE(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLT applies the LT predicate on the "required_approvals" field.
+func RequiredApprovalsLT(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLTE applies the LTE predicate on the "required_approvals" field.
+func RequiredApprovalsLTE(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldRequiredApprovals, v))
+}
+
+// ShareThresholdEQ applies the EQ predicate on the "share_threshold" field.
+func ShareThresholdEQ(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldShareThreshold, v))
+}
+
+// ShareThresholdNEQ applies the NEQ predicate on the "share_threshold" field.
+func ShareThresholdNEQ(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldShareThreshold, v))
+}
+
+// ShareThresholdIn applies the In predicate on the "share_threshold" field.
+func ShareThresholdIn(vs ...int) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldShareThreshold, vs...))
+}
+
+// ShareThresholdNotIn applies the NotIn predicate on the "share_threshold" field.
+func ShareThresholdNotIn(vs ...int) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldShareThreshold, vs...))
+}
+
+// ShareThresholdGT applies the GT predicate on the "share_threshold" field.
+func ShareThresholdGT(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldShareThreshold, v))
+}
+
+// ShareThresholdGTE applies the GTE predicate on the "share_threshold" field.
+func ShareThresholdGTE(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldShareThreshold, v))
+}
+
+// ShareThresholdLT applies the LT predicate on the "share_threshold" field.
+func ShareThresholdLT(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldShareThreshold, v))
+}
+
+// ShareThresholdLTE applies the LTE predicate on the "share_threshold" field.
+func ShareThresholdLTE(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldShareThreshold, v))
+}
+
+// ShareIndexEQ applies the EQ predicate on the "share_index" field.
+func ShareIndexEQ(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldShareIndex, v))
+}
+
+// ShareIndexNEQ applies the NEQ predicate on the "share_index" field.
+func ShareIndexNEQ(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldShareIndex, v))
+}
+
+// ShareIndexIn applies the In predicate on the "share_index" field.
+func ShareIndexIn(vs ...int) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldShareIndex, vs...))
+}
+
+// ShareIndexNotIn applies the NotIn predicate on the "share_index" field.
+func ShareIndexNotIn(vs ...int) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldShareIndex, vs...))
+}
+
+// ShareIndexGT applies the GT predicate on the "share_index" field.
+func ShareIndexGT(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldShareIndex, v))
+}
+
+// ShareIndexGTE applies the GTE predicate on the "share_index" field.
+func ShareIndexGTE(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldShareIndex, v))
+}
+
+// ShareIndexLT applies the LT predicate on the "share_index" field.
+func ShareIndexLT(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldShareIndex, v))
+}
+
+// ShareIndexLTE applies the LTE predicate on the "share_index" field.
+func ShareIndexLTE(v int) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldShareIndex, v))
+}
+
+// AutoUnlockEQ applies the EQ predicate on the "auto_unlock" field.
+func AutoUnlockEQ(v bool) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldAutoUnlock, v))
+}
+
+// AutoUnlockNEQ applies the NEQ predicate on the "auto_unlock" field.
+func AutoUnlockNEQ(v bool) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldAutoUnlock, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldLabels))
+}
+
+// HardwareIsNil applies the IsNil predicate on the "hardware" field.
+func HardwareIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldHardware))
+}
+
+// HardwareNotNil applies the NotNil predicate on the "hardware" field.
+func HardwareNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldHardware))
+}
+
+// PendingHardwareIsNil applies the IsNil predicate on the "pending_hardware" field.
+func PendingHardwareIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldPendingHardware))
+}
+
+// PendingHardwareNotNil applies the NotNil predicate on the "pending_hardware" field.
+func PendingHardwareNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldPendingHardware))
+}
+
+// HardwareBindingEQ applies the EQ predicate on the "hardware_binding" field.
+func HardwareBindingEQ(v HardwareBinding) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldHardwareBinding, v))
+}
+
+// HardwareBindingNEQ applies the NEQ predicate on the "hardware_binding" field.
+func HardwareBindingNEQ(v HardwareBinding) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldHardwareBinding, v))
+}
+
+// HardwareBindingIn applies the In predicate on the "hardware_binding" field.
+func HardwareBindingIn(vs ...HardwareBinding) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldHardwareBinding, vs...))
+}
+
+// HardwareBindingNotIn applies the NotIn predicate on the "hardware_binding" field.
+func HardwareBindingNotIn(vs ...HardwareBinding) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldHardwareBinding, vs...))
+}
+
+// LastAskedAtEQ applies the EQ predicate on the "last_asked_at" field.
+func LastAskedAtEQ(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldLastAskedAt, v))
+}
+
+// LastAskedAtNEQ applies the NEQ predicate on the "last_asked_at" field.
+func LastAskedAtNEQ(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldLastAskedAt, v))
+}
+
+// LastAskedAtIn applies the In predicate on the "last_asked_at" field.
+func LastAskedAtIn(vs ...time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldLastAskedAt, vs...))
+}
+
+// LastAskedAtNotIn applies the NotIn predicate on the "last_asked_at" field.
+func LastAskedAtNotIn(vs ...time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldLastAskedAt, vs...))
+}
+
+// LastAskedAtGT applies the GT predicate on the "last_asked_at" field.
+func LastAskedAtGT(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldLastAskedAt, v))
+}
+
+// LastAskedAtGTE applies the GTE predicate on the "last_asked_at" field.
+func LastAskedAtGTE(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldLastAskedAt, v))
+}
+
+// LastAskedAtLT applies the LT predicate on the "last_asked_at" field.
+func LastAskedAtLT(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldLastAskedAt, v))
+}
+
+// LastAskedAtLTE applies the LTE predicate on the "last_asked_at" field.
+func LastAskedAtLTE(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldLastAskedAt, v))
+}
+
+// LastAskedAtIsNil applies the IsNil predicate on the "last_asked_at" field.
+func LastAskedAtIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldLastAskedAt))
+}
+
+// LastAskedAtNotNil applies the NotNil predicate on the "last_asked_at" field.
+func LastAskedAtNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldLastAskedAt))
+}
+
+// LastUnlockedAtEQ applies the EQ predicate on the "last_unlocked_at" field.
+func LastUnlockedAtEQ(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldLastUnlockedAt, v))
+}
+
+// LastUnlockedAtNEQ applies the NEQ predicate on the "last_unlocked_at" field.
+func LastUnlockedAtNEQ(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldLastUnlockedAt, v))
+}
+
+// LastUnlockedAtIn applies the In predicate on the "last_unlocked_at" field.
+func LastUnlockedAtIn(vs ...time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldLastUnlockedAt, vs...))
+}
+
+// LastUnlockedAtNotIn applies the NotIn predicate on the "last_unlocked_at" field.
+func LastUnlockedAtNotIn(vs ...time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldLastUnlockedAt, vs...))
+}
+
+// LastUnlockedAtGT applies the GT predicate on the "last_unlocked_at" field.
+func LastUnlockedAtGT(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldLastUnlockedAt, v))
+}
+
+// LastUnlockedAtGTE applies the GTE predicate on the "last_unlocked_at" field.
+func LastUnlockedAtGTE(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldLastUnlockedAt, v))
+}
+
+// LastUnlockedAtLT applies the LT predicate on the "last_unlocked_at" field.
+func LastUnlockedAtLT(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldLastUnlockedAt, v))
+}
+
+// LastUnlockedAtLTE applies the LTE predicate on the "last_unlocked_at" field.
+func LastUnlockedAtLTE(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldLastUnlockedAt, v))
+}
+
+// LastUnlockedAtIsNil applies the IsNil predicate on the "last_unlocked_at" field.
+func LastUnlockedAtIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldLastUnlockedAt))
+}
+
+// LastUnlockedAtNotNil applies the NotNil predicate on the "last_unlocked_at" field.
+func LastUnlockedAtNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldLastUnlockedAt))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldState, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Machine {
+	return predicate.Machine(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldExpiresAt))
 }
 
-// CreatedAtHasPrefix applies the HasPrefix predicate on the "created_at" field.
-func CreatedAtHasPrefix(v string) predicate.Machine {
-	return predicate.Machine(sql.FieldHasPrefix(FieldCreatedAt, v))
+// SecretsIsNil applies the IsNil predicate on the "secrets" field.
+func SecretsIsNil() predicate.Machine {
+	return predicate.Machine(sql.FieldIsNull(FieldSecrets))
 }
 
-// CreatedAtHasSuffix applies the HasSuffix predicate on the "created_at" field.
-func CreatedAtHasSuffix(v string) predicate.Machine {
-	return predicate.Machine(sql.FieldHasSuffix(FieldCreatedAt, v))
+// SecretsNotNil applies the NotNil predicate on the "secrets" field.
+func SecretsNotNil() predicate.Machine {
+	return predicate.Machine(sql.FieldNotNull(FieldSecrets))
 }
 
-// CreatedAtEqualFold applies the EqualFold predicate on the "created_at" field.
-func CreatedAtEqualFold(v string) predicate.Machine {
-	return predicate.Machine(sql.FieldEqualFold(FieldCreatedAt, v))
+// PendingConfirmationEQ applies the EQ predicate on the "pending_confirmation" field.
+func PendingConfirmationEQ(v bool) predicate.Machine {
+	return predicate.Machine(sql.FieldEQ(FieldPendingConfirmation, v))
 }
 
-// CreatedAtContainsFold applies the ContainsFold predicate on the "created_at" field.
-func CreatedAtContainsFold(v string) predicate.Machine {
-	return predicate.Machine(sql.FieldContainsFold(FieldCreatedAt, v))
+// PendingConfirmationNEQ applies the NEQ predicate on the "pending_confirmation" field.
+func PendingConfirmationNEQ(v bool) predicate.Machine {
+	return predicate.Machine(sql.FieldNEQ(FieldPendingConfirmation, v))
 }
 
 // And groups predicates with the AND operator between them.
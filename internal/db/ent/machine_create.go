@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -20,51 +21,243 @@ type MachineCreate struct {
 }
 
 // SetName sets the "name" field.
-func (mc *MachineCreate) SetName(s string) *MachineCreate {
-	mc.mutation.SetName(s)
-	return mc
+func (_c *MachineCreate) SetName(v string) *MachineCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *MachineCreate) SetDescription(v string) *MachineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableDescription(v *string) *MachineCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
 }
 
 // SetPublicKey sets the "public_key" field.
-func (mc *MachineCreate) SetPublicKey(b []byte) *MachineCreate {
-	mc.mutation.SetPublicKey(b)
-	return mc
+func (_c *MachineCreate) SetPublicKey(v []byte) *MachineCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *MachineCreate) SetFingerprint(v string) *MachineCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableFingerprint(v *string) *MachineCreate {
+	if v != nil {
+		_c.SetFingerprint(*v)
+	}
+	return _c
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (mc *MachineCreate) SetCreatedAt(s string) *MachineCreate {
-	mc.mutation.SetCreatedAt(s)
-	return mc
+func (_c *MachineCreate) SetCreatedAt(v time.Time) *MachineCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
 }
 
 // SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (mc *MachineCreate) SetNillableCreatedAt(s *string) *MachineCreate {
-	if s != nil {
-		mc.SetCreatedAt(*s)
+func (_c *MachineCreate) SetNillableCreatedAt(v *time.Time) *MachineCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_c *MachineCreate) SetRequiredApprovals(v int) *MachineCreate {
+	_c.mutation.SetRequiredApprovals(v)
+	return _c
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableRequiredApprovals(v *int) *MachineCreate {
+	if v != nil {
+		_c.SetRequiredApprovals(*v)
+	}
+	return _c
+}
+
+// SetShareThreshold sets the "share_threshold" field.
+func (_c *MachineCreate) SetShareThreshold(v int) *MachineCreate {
+	_c.mutation.SetShareThreshold(v)
+	return _c
+}
+
+// SetNillableShareThreshold sets the "share_threshold" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableShareThreshold(v *int) *MachineCreate {
+	if v != nil {
+		_c.SetShareThreshold(*v)
+	}
+	return _c
+}
+
+// SetShareIndex sets the "share_index" field.
+func (_c *MachineCreate) SetShareIndex(v int) *MachineCreate {
+	_c.mutation.SetShareIndex(v)
+	return _c
+}
+
+// SetNillableShareIndex sets the "share_index" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableShareIndex(v *int) *MachineCreate {
+	if v != nil {
+		_c.SetShareIndex(*v)
+	}
+	return _c
+}
+
+// SetAutoUnlock sets the "auto_unlock" field.
+func (_c *MachineCreate) SetAutoUnlock(v bool) *MachineCreate {
+	_c.mutation.SetAutoUnlock(v)
+	return _c
+}
+
+// SetNillableAutoUnlock sets the "auto_unlock" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableAutoUnlock(v *bool) *MachineCreate {
+	if v != nil {
+		_c.SetAutoUnlock(*v)
+	}
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *MachineCreate) SetLabels(v map[string]string) *MachineCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetHardware sets the "hardware" field.
+func (_c *MachineCreate) SetHardware(v map[string]string) *MachineCreate {
+	_c.mutation.SetHardware(v)
+	return _c
+}
+
+// SetPendingHardware sets the "pending_hardware" field.
+func (_c *MachineCreate) SetPendingHardware(v map[string]string) *MachineCreate {
+	_c.mutation.SetPendingHardware(v)
+	return _c
+}
+
+// SetHardwareBinding sets the "hardware_binding" field.
+func (_c *MachineCreate) SetHardwareBinding(v machine.HardwareBinding) *MachineCreate {
+	_c.mutation.SetHardwareBinding(v)
+	return _c
+}
+
+// SetNillableHardwareBinding sets the "hardware_binding" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableHardwareBinding(v *machine.HardwareBinding) *MachineCreate {
+	if v != nil {
+		_c.SetHardwareBinding(*v)
+	}
+	return _c
+}
+
+// SetLastAskedAt sets the "last_asked_at" field.
+func (_c *MachineCreate) SetLastAskedAt(v time.Time) *MachineCreate {
+	_c.mutation.SetLastAskedAt(v)
+	return _c
+}
+
+// SetNillableLastAskedAt sets the "last_asked_at" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableLastAskedAt(v *time.Time) *MachineCreate {
+	if v != nil {
+		_c.SetLastAskedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUnlockedAt sets the "last_unlocked_at" field.
+func (_c *MachineCreate) SetLastUnlockedAt(v time.Time) *MachineCreate {
+	_c.mutation.SetLastUnlockedAt(v)
+	return _c
+}
+
+// SetNillableLastUnlockedAt sets the "last_unlocked_at" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableLastUnlockedAt(v *time.Time) *MachineCreate {
+	if v != nil {
+		_c.SetLastUnlockedAt(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *MachineCreate) SetState(v machine.State) *MachineCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableState(v *machine.State) *MachineCreate {
+	if v != nil {
+		_c.SetState(*v)
 	}
-	return mc
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *MachineCreate) SetExpiresAt(v time.Time) *MachineCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableExpiresAt(v *time.Time) *MachineCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetSecrets sets the "secrets" field.
+func (_c *MachineCreate) SetSecrets(v []string) *MachineCreate {
+	_c.mutation.SetSecrets(v)
+	return _c
+}
+
+// SetPendingConfirmation sets the "pending_confirmation" field.
+func (_c *MachineCreate) SetPendingConfirmation(v bool) *MachineCreate {
+	_c.mutation.SetPendingConfirmation(v)
+	return _c
+}
+
+// SetNillablePendingConfirmation sets the "pending_confirmation" field if the given value is not nil.
+func (_c *MachineCreate) SetNillablePendingConfirmation(v *bool) *MachineCreate {
+	if v != nil {
+		_c.SetPendingConfirmation(*v)
+	}
+	return _c
 }
 
 // SetID sets the "id" field.
-func (mc *MachineCreate) SetID(s string) *MachineCreate {
-	mc.mutation.SetID(s)
-	return mc
+func (_c *MachineCreate) SetID(v string) *MachineCreate {
+	_c.mutation.SetID(v)
+	return _c
 }
 
 // Mutation returns the MachineMutation object of the builder.
-func (mc *MachineCreate) Mutation() *MachineMutation {
-	return mc.mutation
+func (_c *MachineCreate) Mutation() *MachineMutation {
+	return _c.mutation
 }
 
 // Save creates the Machine in the database.
-func (mc *MachineCreate) Save(ctx context.Context) (*Machine, error) {
-	mc.defaults()
-	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+func (_c *MachineCreate) Save(ctx context.Context) (*Machine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (mc *MachineCreate) SaveX(ctx context.Context) *Machine {
-	v, err := mc.Save(ctx)
+func (_c *MachineCreate) SaveX(ctx context.Context) *Machine {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -72,46 +265,125 @@ func (mc *MachineCreate) SaveX(ctx context.Context) *Machine {
 }
 
 // Exec executes the query.
-func (mc *MachineCreate) Exec(ctx context.Context) error {
-	_, err := mc.Save(ctx)
+func (_c *MachineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (mc *MachineCreate) ExecX(ctx context.Context) {
-	if err := mc.Exec(ctx); err != nil {
+func (_c *MachineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (mc *MachineCreate) defaults() {
-	if _, ok := mc.mutation.CreatedAt(); !ok {
-		v := machine.DefaultCreatedAt
-		mc.mutation.SetCreatedAt(v)
+func (_c *MachineCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := machine.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		v := machine.DefaultRequiredApprovals
+		_c.mutation.SetRequiredApprovals(v)
+	}
+	if _, ok := _c.mutation.ShareThreshold(); !ok {
+		v := machine.DefaultShareThreshold
+		_c.mutation.SetShareThreshold(v)
+	}
+	if _, ok := _c.mutation.ShareIndex(); !ok {
+		v := machine.DefaultShareIndex
+		_c.mutation.SetShareIndex(v)
+	}
+	if _, ok := _c.mutation.AutoUnlock(); !ok {
+		v := machine.DefaultAutoUnlock
+		_c.mutation.SetAutoUnlock(v)
+	}
+	if _, ok := _c.mutation.HardwareBinding(); !ok {
+		v := machine.DefaultHardwareBinding
+		_c.mutation.SetHardwareBinding(v)
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := machine.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.PendingConfirmation(); !ok {
+		v := machine.DefaultPendingConfirmation
+		_c.mutation.SetPendingConfirmation(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (mc *MachineCreate) check() error {
-	if _, ok := mc.mutation.Name(); !ok {
+func (_c *MachineCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Machine.name"`)}
 	}
-	if _, ok := mc.mutation.PublicKey(); !ok {
+	if _, ok := _c.mutation.PublicKey(); !ok {
 		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "Machine.public_key"`)}
 	}
-	if _, ok := mc.mutation.CreatedAt(); !ok {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Machine.created_at"`)}
 	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		return &ValidationError{Name: "required_approvals", err: errors.New(`ent: missing required field "Machine.required_approvals"`)}
+	}
+	if v, ok := _c.mutation.RequiredApprovals(); ok {
+		if err := machine.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "Machine.required_approvals": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ShareThreshold(); !ok {
+		return &ValidationError{Name: "share_threshold", err: errors.New(`ent: missing required field "Machine.share_threshold"`)}
+	}
+	if v, ok := _c.mutation.ShareThreshold(); ok {
+		if err := machine.ShareThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "share_threshold", err: fmt.Errorf(`ent: validator failed for field "Machine.share_threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ShareIndex(); !ok {
+		return &ValidationError{Name: "share_index", err: errors.New(`ent: missing required field "Machine.share_index"`)}
+	}
+	if v, ok := _c.mutation.ShareIndex(); ok {
+		if err := machine.ShareIndexValidator(v); err != nil {
+			return &ValidationError{Name: "share_index", err: fmt.Errorf(`ent: validator failed for field "Machine.share_index": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AutoUnlock(); !ok {
+		return &ValidationError{Name: "auto_unlock", err: errors.New(`ent: missing required field "Machine.auto_unlock"`)}
+	}
+	if _, ok := _c.mutation.HardwareBinding(); !ok {
+		return &ValidationError{Name: "hardware_binding", err: errors.New(`ent: missing required field "Machine.hardware_binding"`)}
+	}
+	if v, ok := _c.mutation.HardwareBinding(); ok {
+		if err := machine.HardwareBindingValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_binding", err: fmt.Errorf(`ent: validator failed for field "Machine.hardware_binding": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Machine.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := machine.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Machine.state": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Secrets(); ok {
+		if err := machine.SecretsValidator(v); err != nil {
+			return &ValidationError{Name: "secrets", err: fmt.Errorf(`ent: validator failed for field "Machine.secrets": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PendingConfirmation(); !ok {
+		return &ValidationError{Name: "pending_confirmation", err: errors.New(`ent: missing required field "Machine.pending_confirmation"`)}
+	}
 	return nil
 }
 
-func (mc *MachineCreate) sqlSave(ctx context.Context) (*Machine, error) {
-	if err := mc.check(); err != nil {
+func (_c *MachineCreate) sqlSave(ctx context.Context) (*Machine, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := mc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -124,32 +396,96 @@ func (mc *MachineCreate) sqlSave(ctx context.Context) (*Machine, error) {
 			return nil, fmt.Errorf("unexpected Machine.ID type: %T", _spec.ID.Value)
 		}
 	}
-	mc.mutation.id = &_node.ID
-	mc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (mc *MachineCreate) createSpec() (*Machine, *sqlgraph.CreateSpec) {
+func (_c *MachineCreate) createSpec() (*Machine, *sqlgraph.CreateSpec) {
 	var (
-		_node = &Machine{config: mc.config}
+		_node = &Machine{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(machine.Table, sqlgraph.NewFieldSpec(machine.FieldID, field.TypeString))
 	)
-	if id, ok := mc.mutation.ID(); ok {
+	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := mc.mutation.Name(); ok {
+	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(machine.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
-	if value, ok := mc.mutation.PublicKey(); ok {
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(machine.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
 		_spec.SetField(machine.FieldPublicKey, field.TypeBytes, value)
 		_node.PublicKey = value
 	}
-	if value, ok := mc.mutation.CreatedAt(); ok {
-		_spec.SetField(machine.FieldCreatedAt, field.TypeString, value)
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(machine.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(machine.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.RequiredApprovals(); ok {
+		_spec.SetField(machine.FieldRequiredApprovals, field.TypeInt, value)
+		_node.RequiredApprovals = value
+	}
+	if value, ok := _c.mutation.ShareThreshold(); ok {
+		_spec.SetField(machine.FieldShareThreshold, field.TypeInt, value)
+		_node.ShareThreshold = value
+	}
+	if value, ok := _c.mutation.ShareIndex(); ok {
+		_spec.SetField(machine.FieldShareIndex, field.TypeInt, value)
+		_node.ShareIndex = value
+	}
+	if value, ok := _c.mutation.AutoUnlock(); ok {
+		_spec.SetField(machine.FieldAutoUnlock, field.TypeBool, value)
+		_node.AutoUnlock = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(machine.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.Hardware(); ok {
+		_spec.SetField(machine.FieldHardware, field.TypeJSON, value)
+		_node.Hardware = value
+	}
+	if value, ok := _c.mutation.PendingHardware(); ok {
+		_spec.SetField(machine.FieldPendingHardware, field.TypeJSON, value)
+		_node.PendingHardware = value
+	}
+	if value, ok := _c.mutation.HardwareBinding(); ok {
+		_spec.SetField(machine.FieldHardwareBinding, field.TypeEnum, value)
+		_node.HardwareBinding = value
+	}
+	if value, ok := _c.mutation.LastAskedAt(); ok {
+		_spec.SetField(machine.FieldLastAskedAt, field.TypeTime, value)
+		_node.LastAskedAt = &value
+	}
+	if value, ok := _c.mutation.LastUnlockedAt(); ok {
+		_spec.SetField(machine.FieldLastUnlockedAt, field.TypeTime, value)
+		_node.LastUnlockedAt = &value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(machine.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(machine.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Secrets(); ok {
+		_spec.SetField(machine.FieldSecrets, field.TypeJSON, value)
+		_node.Secrets = value
+	}
+	if value, ok := _c.mutation.PendingConfirmation(); ok {
+		_spec.SetField(machine.FieldPendingConfirmation, field.TypeBool, value)
+		_node.PendingConfirmation = value
+	}
 	return _node, _spec
 }
 
@@ -161,16 +497,16 @@ type MachineCreateBulk struct {
 }
 
 // Save creates the Machine entities in the database.
-func (mcb *MachineCreateBulk) Save(ctx context.Context) ([]*Machine, error) {
-	if mcb.err != nil {
-		return nil, mcb.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
-	nodes := make([]*Machine, len(mcb.builders))
-	mutators := make([]Mutator, len(mcb.builders))
-	for i := range mcb.builders {
+func (_c *MachineCreateBulk) Save(ctx context.Context) ([]*Machine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Machine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
 		func(i int, root context.Context) {
-			builder := mcb.builders[i]
+			builder := _c.builders[i]
 			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*MachineMutation)
@@ -184,11 +520,11 @@ func (mcb *MachineCreateBulk) Save(ctx context.Context) ([]*Machine, error) {
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
 							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
@@ -208,7 +544,7 @@ func (mcb *MachineCreateBulk) Save(ctx context.Context) ([]*Machine, error) {
 		}(i, ctx)
 	}
 	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
 			return nil, err
 		}
 	}
@@ -216,8 +552,8 @@ func (mcb *MachineCreateBulk) Save(ctx context.Context) ([]*Machine, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (mcb *MachineCreateBulk) SaveX(ctx context.Context) []*Machine {
-	v, err := mcb.Save(ctx)
+func (_c *MachineCreateBulk) SaveX(ctx context.Context) []*Machine {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -225,14 +561,14 @@ func (mcb *MachineCreateBulk) SaveX(ctx context.Context) []*Machine {
 }
 
 // Exec executes the query.
-func (mcb *MachineCreateBulk) Exec(ctx context.Context) error {
-	_, err := mcb.Save(ctx)
+func (_c *MachineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (mcb *MachineCreateBulk) ExecX(ctx context.Context) {
-	if err := mcb.Exec(ctx); err != nil {
+func (_c *MachineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
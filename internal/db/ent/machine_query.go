@@ -28,40 +28,40 @@ type MachineQuery struct {
 }
 
 // Where adds a new predicate for the MachineQuery builder.
-func (mq *MachineQuery) Where(ps ...predicate.Machine) *MachineQuery {
-	mq.predicates = append(mq.predicates, ps...)
-	return mq
+func (_q *MachineQuery) Where(ps ...predicate.Machine) *MachineQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
 }
 
 // Limit the number of records to be returned by this query.
-func (mq *MachineQuery) Limit(limit int) *MachineQuery {
-	mq.ctx.Limit = &limit
-	return mq
+func (_q *MachineQuery) Limit(limit int) *MachineQuery {
+	_q.ctx.Limit = &limit
+	return _q
 }
 
 // Offset to start from.
-func (mq *MachineQuery) Offset(offset int) *MachineQuery {
-	mq.ctx.Offset = &offset
-	return mq
+func (_q *MachineQuery) Offset(offset int) *MachineQuery {
+	_q.ctx.Offset = &offset
+	return _q
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
-func (mq *MachineQuery) Unique(unique bool) *MachineQuery {
-	mq.ctx.Unique = &unique
-	return mq
+func (_q *MachineQuery) Unique(unique bool) *MachineQuery {
+	_q.ctx.Unique = &unique
+	return _q
 }
 
 // Order specifies how the records should be ordered.
-func (mq *MachineQuery) Order(o ...machine.OrderOption) *MachineQuery {
-	mq.order = append(mq.order, o...)
-	return mq
+func (_q *MachineQuery) Order(o ...machine.OrderOption) *MachineQuery {
+	_q.order = append(_q.order, o...)
+	return _q
 }
 
 // First returns the first Machine entity from the query.
 // Returns a *NotFoundError when no Machine was found.
-func (mq *MachineQuery) First(ctx context.Context) (*Machine, error) {
-	nodes, err := mq.Limit(1).All(setContextOp(ctx, mq.ctx, ent.OpQueryFirst))
+func (_q *MachineQuery) First(ctx context.Context) (*Machine, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -72,8 +72,8 @@ func (mq *MachineQuery) First(ctx context.Context) (*Machine, error) {
 }
 
 // FirstX is like First, but panics if an error occurs.
-func (mq *MachineQuery) FirstX(ctx context.Context) *Machine {
-	node, err := mq.First(ctx)
+func (_q *MachineQuery) FirstX(ctx context.Context) *Machine {
+	node, err := _q.First(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -82,9 +82,9 @@ func (mq *MachineQuery) FirstX(ctx context.Context) *Machine {
 
 // FirstID returns the first Machine ID from the query.
 // Returns a *NotFoundError when no Machine ID was found.
-func (mq *MachineQuery) FirstID(ctx context.Context) (id string, err error) {
+func (_q *MachineQuery) FirstID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryFirstID)); err != nil {
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -95,8 +95,8 @@ func (mq *MachineQuery) FirstID(ctx context.Context) (id string, err error) {
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (mq *MachineQuery) FirstIDX(ctx context.Context) string {
-	id, err := mq.FirstID(ctx)
+func (_q *MachineQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -106,8 +106,8 @@ func (mq *MachineQuery) FirstIDX(ctx context.Context) string {
 // Only returns a single Machine entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one Machine entity is found.
 // Returns a *NotFoundError when no Machine entities are found.
-func (mq *MachineQuery) Only(ctx context.Context) (*Machine, error) {
-	nodes, err := mq.Limit(2).All(setContextOp(ctx, mq.ctx, ent.OpQueryOnly))
+func (_q *MachineQuery) Only(ctx context.Context) (*Machine, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +122,8 @@ func (mq *MachineQuery) Only(ctx context.Context) (*Machine, error) {
 }
 
 // OnlyX is like Only, but panics if an error occurs.
-func (mq *MachineQuery) OnlyX(ctx context.Context) *Machine {
-	node, err := mq.Only(ctx)
+func (_q *MachineQuery) OnlyX(ctx context.Context) *Machine {
+	node, err := _q.Only(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -133,9 +133,9 @@ func (mq *MachineQuery) OnlyX(ctx context.Context) *Machine {
 // OnlyID is like Only, but returns the only Machine ID in the query.
 // Returns a *NotSingularError when more than one Machine ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (mq *MachineQuery) OnlyID(ctx context.Context) (id string, err error) {
+func (_q *MachineQuery) OnlyID(ctx context.Context) (id string, err error) {
 	var ids []string
-	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryOnlyID)); err != nil {
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -150,8 +150,8 @@ func (mq *MachineQuery) OnlyID(ctx context.Context) (id string, err error) {
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (mq *MachineQuery) OnlyIDX(ctx context.Context) string {
-	id, err := mq.OnlyID(ctx)
+func (_q *MachineQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -159,18 +159,18 @@ func (mq *MachineQuery) OnlyIDX(ctx context.Context) string {
 }
 
 // All executes the query and returns a list of Machines.
-func (mq *MachineQuery) All(ctx context.Context) ([]*Machine, error) {
-	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryAll)
-	if err := mq.prepareQuery(ctx); err != nil {
+func (_q *MachineQuery) All(ctx context.Context) ([]*Machine, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
 	qr := querierAll[[]*Machine, *MachineQuery]()
-	return withInterceptors[[]*Machine](ctx, mq, qr, mq.inters)
+	return withInterceptors[[]*Machine](ctx, _q, qr, _q.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (mq *MachineQuery) AllX(ctx context.Context) []*Machine {
-	nodes, err := mq.All(ctx)
+func (_q *MachineQuery) AllX(ctx context.Context) []*Machine {
+	nodes, err := _q.All(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -178,20 +178,20 @@ func (mq *MachineQuery) AllX(ctx context.Context) []*Machine {
 }
 
 // IDs executes the query and returns a list of Machine IDs.
-func (mq *MachineQuery) IDs(ctx context.Context) (ids []string, err error) {
-	if mq.ctx.Unique == nil && mq.path != nil {
-		mq.Unique(true)
+func (_q *MachineQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
 	}
-	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryIDs)
-	if err = mq.Select(machine.FieldID).Scan(ctx, &ids); err != nil {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(machine.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (mq *MachineQuery) IDsX(ctx context.Context) []string {
-	ids, err := mq.IDs(ctx)
+func (_q *MachineQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -199,17 +199,17 @@ func (mq *MachineQuery) IDsX(ctx context.Context) []string {
 }
 
 // Count returns the count of the given query.
-func (mq *MachineQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryCount)
-	if err := mq.prepareQuery(ctx); err != nil {
+func (_q *MachineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return withInterceptors[int](ctx, mq, querierCount[*MachineQuery](), mq.inters)
+	return withInterceptors[int](ctx, _q, querierCount[*MachineQuery](), _q.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
-func (mq *MachineQuery) CountX(ctx context.Context) int {
-	count, err := mq.Count(ctx)
+func (_q *MachineQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -217,9 +217,9 @@ func (mq *MachineQuery) CountX(ctx context.Context) int {
 }
 
 // Exist returns true if the query has elements in the graph.
-func (mq *MachineQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryExist)
-	switch _, err := mq.FirstID(ctx); {
+func (_q *MachineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -230,8 +230,8 @@ func (mq *MachineQuery) Exist(ctx context.Context) (bool, error) {
 }
 
 // ExistX is like Exist, but panics if an error occurs.
-func (mq *MachineQuery) ExistX(ctx context.Context) bool {
-	exist, err := mq.Exist(ctx)
+func (_q *MachineQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -240,19 +240,19 @@ func (mq *MachineQuery) ExistX(ctx context.Context) bool {
 
 // Clone returns a duplicate of the MachineQuery builder, including all associated steps. It can be
 // used to prepare common query builders and use them differently after the clone is made.
-func (mq *MachineQuery) Clone() *MachineQuery {
-	if mq == nil {
+func (_q *MachineQuery) Clone() *MachineQuery {
+	if _q == nil {
 		return nil
 	}
 	return &MachineQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]machine.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Machine{}, mq.predicates...),
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]machine.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.Machine{}, _q.predicates...),
 		// clone intermediate query.
-		sql:  mq.sql.Clone(),
-		path: mq.path,
+		sql:  _q.sql.Clone(),
+		path: _q.path,
 	}
 }
 
@@ -270,10 +270,10 @@ func (mq *MachineQuery) Clone() *MachineQuery {
 //		GroupBy(machine.FieldName).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-func (mq *MachineQuery) GroupBy(field string, fields ...string) *MachineGroupBy {
-	mq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &MachineGroupBy{build: mq}
-	grbuild.flds = &mq.ctx.Fields
+func (_q *MachineQuery) GroupBy(field string, fields ...string) *MachineGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MachineGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = machine.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
@@ -291,62 +291,62 @@ func (mq *MachineQuery) GroupBy(field string, fields ...string) *MachineGroupBy
 //	client.Machine.Query().
 //		Select(machine.FieldName).
 //		Scan(ctx, &v)
-func (mq *MachineQuery) Select(fields ...string) *MachineSelect {
-	mq.ctx.Fields = append(mq.ctx.Fields, fields...)
-	sbuild := &MachineSelect{MachineQuery: mq}
+func (_q *MachineQuery) Select(fields ...string) *MachineSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &MachineSelect{MachineQuery: _q}
 	sbuild.label = machine.Label
-	sbuild.flds, sbuild.scan = &mq.ctx.Fields, sbuild.Scan
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
 	return sbuild
 }
 
 // Aggregate returns a MachineSelect configured with the given aggregations.
-func (mq *MachineQuery) Aggregate(fns ...AggregateFunc) *MachineSelect {
-	return mq.Select().Aggregate(fns...)
+func (_q *MachineQuery) Aggregate(fns ...AggregateFunc) *MachineSelect {
+	return _q.Select().Aggregate(fns...)
 }
 
-func (mq *MachineQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range mq.inters {
+func (_q *MachineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
 		if inter == nil {
 			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
 		}
 		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, mq); err != nil {
+			if err := trv.Traverse(ctx, _q); err != nil {
 				return err
 			}
 		}
 	}
-	for _, f := range mq.ctx.Fields {
+	for _, f := range _q.ctx.Fields {
 		if !machine.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
-	if mq.path != nil {
-		prev, err := mq.path(ctx)
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
 		if err != nil {
 			return err
 		}
-		mq.sql = prev
+		_q.sql = prev
 	}
 	return nil
 }
 
-func (mq *MachineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Machine, error) {
+func (_q *MachineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Machine, error) {
 	var (
 		nodes = []*Machine{}
-		_spec = mq.querySpec()
+		_spec = _q.querySpec()
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Machine).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
-		node := &Machine{config: mq.config}
+		node := &Machine{config: _q.config}
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -355,24 +355,24 @@ func (mq *MachineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mach
 	return nodes, nil
 }
 
-func (mq *MachineQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := mq.querySpec()
-	_spec.Node.Columns = mq.ctx.Fields
-	if len(mq.ctx.Fields) > 0 {
-		_spec.Unique = mq.ctx.Unique != nil && *mq.ctx.Unique
+func (_q *MachineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
 	}
-	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
 }
 
-func (mq *MachineQuery) querySpec() *sqlgraph.QuerySpec {
+func (_q *MachineQuery) querySpec() *sqlgraph.QuerySpec {
 	_spec := sqlgraph.NewQuerySpec(machine.Table, machine.Columns, sqlgraph.NewFieldSpec(machine.FieldID, field.TypeString))
-	_spec.From = mq.sql
-	if unique := mq.ctx.Unique; unique != nil {
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
-	} else if mq.path != nil {
+	} else if _q.path != nil {
 		_spec.Unique = true
 	}
-	if fields := mq.ctx.Fields; len(fields) > 0 {
+	if fields := _q.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, machine.FieldID)
 		for i := range fields {
@@ -381,20 +381,20 @@ func (mq *MachineQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
-	if ps := mq.predicates; len(ps) > 0 {
+	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if limit := mq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		_spec.Limit = *limit
 	}
-	if offset := mq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		_spec.Offset = *offset
 	}
-	if ps := mq.order; len(ps) > 0 {
+	if ps := _q.order; len(ps) > 0 {
 		_spec.Order = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
@@ -404,33 +404,33 @@ func (mq *MachineQuery) querySpec() *sqlgraph.QuerySpec {
 	return _spec
 }
 
-func (mq *MachineQuery) sqlQuery(ctx context.Context) *sql.Selector {
-	builder := sql.Dialect(mq.driver.Dialect())
+func (_q *MachineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
 	t1 := builder.Table(machine.Table)
-	columns := mq.ctx.Fields
+	columns := _q.ctx.Fields
 	if len(columns) == 0 {
 		columns = machine.Columns
 	}
 	selector := builder.Select(t1.Columns(columns...)...).From(t1)
-	if mq.sql != nil {
-		selector = mq.sql
+	if _q.sql != nil {
+		selector = _q.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
-	if mq.ctx.Unique != nil && *mq.ctx.Unique {
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
-	for _, p := range mq.predicates {
+	for _, p := range _q.predicates {
 		p(selector)
 	}
-	for _, p := range mq.order {
+	for _, p := range _q.order {
 		p(selector)
 	}
-	if offset := mq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		// limit is mandatory for offset clause. We start
 		// with default value, and override it below if needed.
 		selector.Offset(*offset).Limit(math.MaxInt32)
 	}
-	if limit := mq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		selector.Limit(*limit)
 	}
 	return selector
@@ -443,41 +443,41 @@ type MachineGroupBy struct {
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
-func (mgb *MachineGroupBy) Aggregate(fns ...AggregateFunc) *MachineGroupBy {
-	mgb.fns = append(mgb.fns, fns...)
-	return mgb
+func (_g *MachineGroupBy) Aggregate(fns ...AggregateFunc) *MachineGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (mgb *MachineGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, mgb.build.ctx, ent.OpQueryGroupBy)
-	if err := mgb.build.prepareQuery(ctx); err != nil {
+func (_g *MachineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*MachineQuery, *MachineGroupBy](ctx, mgb.build, mgb, mgb.build.inters, v)
+	return scanWithInterceptors[*MachineQuery, *MachineGroupBy](ctx, _g.build, _g, _g.build.inters, v)
 }
 
-func (mgb *MachineGroupBy) sqlScan(ctx context.Context, root *MachineQuery, v any) error {
+func (_g *MachineGroupBy) sqlScan(ctx context.Context, root *MachineQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
-	aggregation := make([]string, 0, len(mgb.fns))
-	for _, fn := range mgb.fns {
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
 	if len(selector.SelectedColumns()) == 0 {
-		columns := make([]string, 0, len(*mgb.flds)+len(mgb.fns))
-		for _, f := range *mgb.flds {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
 			columns = append(columns, selector.C(f))
 		}
 		columns = append(columns, aggregation...)
 		selector.Select(columns...)
 	}
-	selector.GroupBy(selector.Columns(*mgb.flds...)...)
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
 	if err := selector.Err(); err != nil {
 		return err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := mgb.build.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -491,27 +491,27 @@ type MachineSelect struct {
 }
 
 // Aggregate adds the given aggregation functions to the selector query.
-func (ms *MachineSelect) Aggregate(fns ...AggregateFunc) *MachineSelect {
-	ms.fns = append(ms.fns, fns...)
-	return ms
+func (_s *MachineSelect) Aggregate(fns ...AggregateFunc) *MachineSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (ms *MachineSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, ms.ctx, ent.OpQuerySelect)
-	if err := ms.prepareQuery(ctx); err != nil {
+func (_s *MachineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*MachineQuery, *MachineSelect](ctx, ms.MachineQuery, ms, ms.inters, v)
+	return scanWithInterceptors[*MachineQuery, *MachineSelect](ctx, _s.MachineQuery, _s, _s.inters, v)
 }
 
-func (ms *MachineSelect) sqlScan(ctx context.Context, root *MachineQuery, v any) error {
+func (_s *MachineSelect) sqlScan(ctx context.Context, root *MachineQuery, v any) error {
 	selector := root.sqlQuery(ctx)
-	aggregation := make([]string, 0, len(ms.fns))
-	for _, fn := range ms.fns {
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
-	switch n := len(*ms.selector.flds); {
+	switch n := len(*_s.selector.flds); {
 	case n == 0 && len(aggregation) > 0:
 		selector.Select(aggregation...)
 	case n != 0 && len(aggregation) > 0:
@@ -519,7 +519,7 @@ func (ms *MachineSelect) sqlScan(ctx context.Context, root *MachineQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
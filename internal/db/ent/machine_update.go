@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
 	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
@@ -22,58 +24,317 @@ type MachineUpdate struct {
 }
 
 // Where appends a list predicates to the MachineUpdate builder.
-func (mu *MachineUpdate) Where(ps ...predicate.Machine) *MachineUpdate {
-	mu.mutation.Where(ps...)
-	return mu
+func (_u *MachineUpdate) Where(ps ...predicate.Machine) *MachineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetName sets the "name" field.
-func (mu *MachineUpdate) SetName(s string) *MachineUpdate {
-	mu.mutation.SetName(s)
-	return mu
+func (_u *MachineUpdate) SetName(v string) *MachineUpdate {
+	_u.mutation.SetName(v)
+	return _u
 }
 
 // SetNillableName sets the "name" field if the given value is not nil.
-func (mu *MachineUpdate) SetNillableName(s *string) *MachineUpdate {
-	if s != nil {
-		mu.SetName(*s)
+func (_u *MachineUpdate) SetNillableName(v *string) *MachineUpdate {
+	if v != nil {
+		_u.SetName(*v)
 	}
-	return mu
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MachineUpdate) SetDescription(v string) *MachineUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableDescription(v *string) *MachineUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MachineUpdate) ClearDescription() *MachineUpdate {
+	_u.mutation.ClearDescription()
+	return _u
 }
 
 // SetPublicKey sets the "public_key" field.
-func (mu *MachineUpdate) SetPublicKey(b []byte) *MachineUpdate {
-	mu.mutation.SetPublicKey(b)
-	return mu
+func (_u *MachineUpdate) SetPublicKey(v []byte) *MachineUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *MachineUpdate) SetFingerprint(v string) *MachineUpdate {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableFingerprint(v *string) *MachineUpdate {
+	if v != nil {
+		_u.SetFingerprint(*v)
+	}
+	return _u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (_u *MachineUpdate) ClearFingerprint() *MachineUpdate {
+	_u.mutation.ClearFingerprint()
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *MachineUpdate) SetRequiredApprovals(v int) *MachineUpdate {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableRequiredApprovals(v *int) *MachineUpdate {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (mu *MachineUpdate) SetCreatedAt(s string) *MachineUpdate {
-	mu.mutation.SetCreatedAt(s)
-	return mu
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *MachineUpdate) AddRequiredApprovals(v int) *MachineUpdate {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (mu *MachineUpdate) SetNillableCreatedAt(s *string) *MachineUpdate {
-	if s != nil {
-		mu.SetCreatedAt(*s)
+// SetShareThreshold sets the "share_threshold" field.
+func (_u *MachineUpdate) SetShareThreshold(v int) *MachineUpdate {
+	_u.mutation.ResetShareThreshold()
+	_u.mutation.SetShareThreshold(v)
+	return _u
+}
+
+// SetNillableShareThreshold sets the "share_threshold" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableShareThreshold(v *int) *MachineUpdate {
+	if v != nil {
+		_u.SetShareThreshold(*v)
 	}
-	return mu
+	return _u
+}
+
+// AddShareThreshold adds value to the "share_threshold" field.
+func (_u *MachineUpdate) AddShareThreshold(v int) *MachineUpdate {
+	_u.mutation.AddShareThreshold(v)
+	return _u
+}
+
+// SetShareIndex sets the "share_index" field.
+func (_u *MachineUpdate) SetShareIndex(v int) *MachineUpdate {
+	_u.mutation.ResetShareIndex()
+	_u.mutation.SetShareIndex(v)
+	return _u
+}
+
+// SetNillableShareIndex sets the "share_index" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableShareIndex(v *int) *MachineUpdate {
+	if v != nil {
+		_u.SetShareIndex(*v)
+	}
+	return _u
+}
+
+// AddShareIndex adds value to the "share_index" field.
+func (_u *MachineUpdate) AddShareIndex(v int) *MachineUpdate {
+	_u.mutation.AddShareIndex(v)
+	return _u
+}
+
+// SetAutoUnlock sets the "auto_unlock" field.
+func (_u *MachineUpdate) SetAutoUnlock(v bool) *MachineUpdate {
+	_u.mutation.SetAutoUnlock(v)
+	return _u
+}
+
+// SetNillableAutoUnlock sets the "auto_unlock" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableAutoUnlock(v *bool) *MachineUpdate {
+	if v != nil {
+		_u.SetAutoUnlock(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *MachineUpdate) SetLabels(v map[string]string) *MachineUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *MachineUpdate) ClearLabels() *MachineUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetHardware sets the "hardware" field.
+func (_u *MachineUpdate) SetHardware(v map[string]string) *MachineUpdate {
+	_u.mutation.SetHardware(v)
+	return _u
+}
+
+// ClearHardware clears the value of the "hardware" field.
+func (_u *MachineUpdate) ClearHardware() *MachineUpdate {
+	_u.mutation.ClearHardware()
+	return _u
+}
+
+// SetPendingHardware sets the "pending_hardware" field.
+func (_u *MachineUpdate) SetPendingHardware(v map[string]string) *MachineUpdate {
+	_u.mutation.SetPendingHardware(v)
+	return _u
+}
+
+// ClearPendingHardware clears the value of the "pending_hardware" field.
+func (_u *MachineUpdate) ClearPendingHardware() *MachineUpdate {
+	_u.mutation.ClearPendingHardware()
+	return _u
+}
+
+// SetHardwareBinding sets the "hardware_binding" field.
+func (_u *MachineUpdate) SetHardwareBinding(v machine.HardwareBinding) *MachineUpdate {
+	_u.mutation.SetHardwareBinding(v)
+	return _u
+}
+
+// SetNillableHardwareBinding sets the "hardware_binding" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableHardwareBinding(v *machine.HardwareBinding) *MachineUpdate {
+	if v != nil {
+		_u.SetHardwareBinding(*v)
+	}
+	return _u
+}
+
+// SetLastAskedAt sets the "last_asked_at" field.
+func (_u *MachineUpdate) SetLastAskedAt(v time.Time) *MachineUpdate {
+	_u.mutation.SetLastAskedAt(v)
+	return _u
+}
+
+// SetNillableLastAskedAt sets the "last_asked_at" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableLastAskedAt(v *time.Time) *MachineUpdate {
+	if v != nil {
+		_u.SetLastAskedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastAskedAt clears the value of the "last_asked_at" field.
+func (_u *MachineUpdate) ClearLastAskedAt() *MachineUpdate {
+	_u.mutation.ClearLastAskedAt()
+	return _u
+}
+
+// SetLastUnlockedAt sets the "last_unlocked_at" field.
+func (_u *MachineUpdate) SetLastUnlockedAt(v time.Time) *MachineUpdate {
+	_u.mutation.SetLastUnlockedAt(v)
+	return _u
+}
+
+// SetNillableLastUnlockedAt sets the "last_unlocked_at" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableLastUnlockedAt(v *time.Time) *MachineUpdate {
+	if v != nil {
+		_u.SetLastUnlockedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUnlockedAt clears the value of the "last_unlocked_at" field.
+func (_u *MachineUpdate) ClearLastUnlockedAt() *MachineUpdate {
+	_u.mutation.ClearLastUnlockedAt()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *MachineUpdate) SetState(v machine.State) *MachineUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableState(v *machine.State) *MachineUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MachineUpdate) SetExpiresAt(v time.Time) *MachineUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableExpiresAt(v *time.Time) *MachineUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *MachineUpdate) ClearExpiresAt() *MachineUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSecrets sets the "secrets" field.
+func (_u *MachineUpdate) SetSecrets(v []string) *MachineUpdate {
+	_u.mutation.SetSecrets(v)
+	return _u
+}
+
+// AppendSecrets appends value to the "secrets" field.
+func (_u *MachineUpdate) AppendSecrets(v []string) *MachineUpdate {
+	_u.mutation.AppendSecrets(v)
+	return _u
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (_u *MachineUpdate) ClearSecrets() *MachineUpdate {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// SetPendingConfirmation sets the "pending_confirmation" field.
+func (_u *MachineUpdate) SetPendingConfirmation(v bool) *MachineUpdate {
+	_u.mutation.SetPendingConfirmation(v)
+	return _u
+}
+
+// SetNillablePendingConfirmation sets the "pending_confirmation" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillablePendingConfirmation(v *bool) *MachineUpdate {
+	if v != nil {
+		_u.SetPendingConfirmation(*v)
+	}
+	return _u
 }
 
 // Mutation returns the MachineMutation object of the builder.
-func (mu *MachineUpdate) Mutation() *MachineMutation {
-	return mu.mutation
+func (_u *MachineUpdate) Mutation() *MachineMutation {
+	return _u.mutation
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
-func (mu *MachineUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+func (_u *MachineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (mu *MachineUpdate) SaveX(ctx context.Context) int {
-	affected, err := mu.Save(ctx)
+func (_u *MachineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -81,37 +342,161 @@ func (mu *MachineUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (mu *MachineUpdate) Exec(ctx context.Context) error {
-	_, err := mu.Save(ctx)
+func (_u *MachineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (mu *MachineUpdate) ExecX(ctx context.Context) {
-	if err := mu.Exec(ctx); err != nil {
+func (_u *MachineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
-func (mu *MachineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+// check runs all checks and user-defined validators on the builder.
+func (_u *MachineUpdate) check() error {
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := machine.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "Machine.required_approvals": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShareThreshold(); ok {
+		if err := machine.ShareThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "share_threshold", err: fmt.Errorf(`ent: validator failed for field "Machine.share_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShareIndex(); ok {
+		if err := machine.ShareIndexValidator(v); err != nil {
+			return &ValidationError{Name: "share_index", err: fmt.Errorf(`ent: validator failed for field "Machine.share_index": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HardwareBinding(); ok {
+		if err := machine.HardwareBindingValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_binding", err: fmt.Errorf(`ent: validator failed for field "Machine.hardware_binding": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := machine.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Machine.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secrets(); ok {
+		if err := machine.SecretsValidator(v); err != nil {
+			return &ValidationError{Name: "secrets", err: fmt.Errorf(`ent: validator failed for field "Machine.secrets": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MachineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(machine.Table, machine.Columns, sqlgraph.NewFieldSpec(machine.FieldID, field.TypeString))
-	if ps := mu.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := mu.mutation.Name(); ok {
+	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(machine.FieldName, field.TypeString, value)
 	}
-	if value, ok := mu.mutation.PublicKey(); ok {
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(machine.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(machine.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
 		_spec.SetField(machine.FieldPublicKey, field.TypeBytes, value)
 	}
-	if value, ok := mu.mutation.CreatedAt(); ok {
-		_spec.SetField(machine.FieldCreatedAt, field.TypeString, value)
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(machine.FieldFingerprint, field.TypeString, value)
+	}
+	if _u.mutation.FingerprintCleared() {
+		_spec.ClearField(machine.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(machine.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(machine.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShareThreshold(); ok {
+		_spec.SetField(machine.FieldShareThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShareThreshold(); ok {
+		_spec.AddField(machine.FieldShareThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShareIndex(); ok {
+		_spec.SetField(machine.FieldShareIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShareIndex(); ok {
+		_spec.AddField(machine.FieldShareIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AutoUnlock(); ok {
+		_spec.SetField(machine.FieldAutoUnlock, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(machine.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(machine.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Hardware(); ok {
+		_spec.SetField(machine.FieldHardware, field.TypeJSON, value)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+	if _u.mutation.HardwareCleared() {
+		_spec.ClearField(machine.FieldHardware, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PendingHardware(); ok {
+		_spec.SetField(machine.FieldPendingHardware, field.TypeJSON, value)
+	}
+	if _u.mutation.PendingHardwareCleared() {
+		_spec.ClearField(machine.FieldPendingHardware, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HardwareBinding(); ok {
+		_spec.SetField(machine.FieldHardwareBinding, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastAskedAt(); ok {
+		_spec.SetField(machine.FieldLastAskedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastAskedAtCleared() {
+		_spec.ClearField(machine.FieldLastAskedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUnlockedAt(); ok {
+		_spec.SetField(machine.FieldLastUnlockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUnlockedAtCleared() {
+		_spec.ClearField(machine.FieldLastUnlockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(machine.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(machine.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(machine.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Secrets(); ok {
+		_spec.SetField(machine.FieldSecrets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecrets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, machine.FieldSecrets, value)
+		})
+	}
+	if _u.mutation.SecretsCleared() {
+		_spec.ClearField(machine.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PendingConfirmation(); ok {
+		_spec.SetField(machine.FieldPendingConfirmation, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{machine.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -119,8 +504,8 @@ func (mu *MachineUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	mu.mutation.done = true
-	return n, nil
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // MachineUpdateOne is the builder for updating a single Machine entity.
@@ -132,65 +517,324 @@ type MachineUpdateOne struct {
 }
 
 // SetName sets the "name" field.
-func (muo *MachineUpdateOne) SetName(s string) *MachineUpdateOne {
-	muo.mutation.SetName(s)
-	return muo
+func (_u *MachineUpdateOne) SetName(v string) *MachineUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
 }
 
 // SetNillableName sets the "name" field if the given value is not nil.
-func (muo *MachineUpdateOne) SetNillableName(s *string) *MachineUpdateOne {
-	if s != nil {
-		muo.SetName(*s)
+func (_u *MachineUpdateOne) SetNillableName(v *string) *MachineUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
 	}
-	return muo
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MachineUpdateOne) SetDescription(v string) *MachineUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableDescription(v *string) *MachineUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MachineUpdateOne) ClearDescription() *MachineUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
 }
 
 // SetPublicKey sets the "public_key" field.
-func (muo *MachineUpdateOne) SetPublicKey(b []byte) *MachineUpdateOne {
-	muo.mutation.SetPublicKey(b)
-	return muo
+func (_u *MachineUpdateOne) SetPublicKey(v []byte) *MachineUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *MachineUpdateOne) SetFingerprint(v string) *MachineUpdateOne {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableFingerprint(v *string) *MachineUpdateOne {
+	if v != nil {
+		_u.SetFingerprint(*v)
+	}
+	return _u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (_u *MachineUpdateOne) ClearFingerprint() *MachineUpdateOne {
+	_u.mutation.ClearFingerprint()
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *MachineUpdateOne) SetRequiredApprovals(v int) *MachineUpdateOne {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableRequiredApprovals(v *int) *MachineUpdateOne {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *MachineUpdateOne) AddRequiredApprovals(v int) *MachineUpdateOne {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetShareThreshold sets the "share_threshold" field.
+func (_u *MachineUpdateOne) SetShareThreshold(v int) *MachineUpdateOne {
+	_u.mutation.ResetShareThreshold()
+	_u.mutation.SetShareThreshold(v)
+	return _u
+}
+
+// SetNillableShareThreshold sets the "share_threshold" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableShareThreshold(v *int) *MachineUpdateOne {
+	if v != nil {
+		_u.SetShareThreshold(*v)
+	}
+	return _u
+}
+
+// AddShareThreshold adds value to the "share_threshold" field.
+func (_u *MachineUpdateOne) AddShareThreshold(v int) *MachineUpdateOne {
+	_u.mutation.AddShareThreshold(v)
+	return _u
+}
+
+// SetShareIndex sets the "share_index" field.
+func (_u *MachineUpdateOne) SetShareIndex(v int) *MachineUpdateOne {
+	_u.mutation.ResetShareIndex()
+	_u.mutation.SetShareIndex(v)
+	return _u
+}
+
+// SetNillableShareIndex sets the "share_index" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableShareIndex(v *int) *MachineUpdateOne {
+	if v != nil {
+		_u.SetShareIndex(*v)
+	}
+	return _u
+}
+
+// AddShareIndex adds value to the "share_index" field.
+func (_u *MachineUpdateOne) AddShareIndex(v int) *MachineUpdateOne {
+	_u.mutation.AddShareIndex(v)
+	return _u
+}
+
+// SetAutoUnlock sets the "auto_unlock" field.
+func (_u *MachineUpdateOne) SetAutoUnlock(v bool) *MachineUpdateOne {
+	_u.mutation.SetAutoUnlock(v)
+	return _u
+}
+
+// SetNillableAutoUnlock sets the "auto_unlock" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableAutoUnlock(v *bool) *MachineUpdateOne {
+	if v != nil {
+		_u.SetAutoUnlock(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *MachineUpdateOne) SetLabels(v map[string]string) *MachineUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *MachineUpdateOne) ClearLabels() *MachineUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetHardware sets the "hardware" field.
+func (_u *MachineUpdateOne) SetHardware(v map[string]string) *MachineUpdateOne {
+	_u.mutation.SetHardware(v)
+	return _u
+}
+
+// ClearHardware clears the value of the "hardware" field.
+func (_u *MachineUpdateOne) ClearHardware() *MachineUpdateOne {
+	_u.mutation.ClearHardware()
+	return _u
+}
+
+// SetPendingHardware sets the "pending_hardware" field.
+func (_u *MachineUpdateOne) SetPendingHardware(v map[string]string) *MachineUpdateOne {
+	_u.mutation.SetPendingHardware(v)
+	return _u
+}
+
+// ClearPendingHardware clears the value of the "pending_hardware" field.
+func (_u *MachineUpdateOne) ClearPendingHardware() *MachineUpdateOne {
+	_u.mutation.ClearPendingHardware()
+	return _u
+}
+
+// SetHardwareBinding sets the "hardware_binding" field.
+func (_u *MachineUpdateOne) SetHardwareBinding(v machine.HardwareBinding) *MachineUpdateOne {
+	_u.mutation.SetHardwareBinding(v)
+	return _u
+}
+
+// SetNillableHardwareBinding sets the "hardware_binding" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableHardwareBinding(v *machine.HardwareBinding) *MachineUpdateOne {
+	if v != nil {
+		_u.SetHardwareBinding(*v)
+	}
+	return _u
+}
+
+// SetLastAskedAt sets the "last_asked_at" field.
+func (_u *MachineUpdateOne) SetLastAskedAt(v time.Time) *MachineUpdateOne {
+	_u.mutation.SetLastAskedAt(v)
+	return _u
+}
+
+// SetNillableLastAskedAt sets the "last_asked_at" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableLastAskedAt(v *time.Time) *MachineUpdateOne {
+	if v != nil {
+		_u.SetLastAskedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastAskedAt clears the value of the "last_asked_at" field.
+func (_u *MachineUpdateOne) ClearLastAskedAt() *MachineUpdateOne {
+	_u.mutation.ClearLastAskedAt()
+	return _u
+}
+
+// SetLastUnlockedAt sets the "last_unlocked_at" field.
+func (_u *MachineUpdateOne) SetLastUnlockedAt(v time.Time) *MachineUpdateOne {
+	_u.mutation.SetLastUnlockedAt(v)
+	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (muo *MachineUpdateOne) SetCreatedAt(s string) *MachineUpdateOne {
-	muo.mutation.SetCreatedAt(s)
-	return muo
+// SetNillableLastUnlockedAt sets the "last_unlocked_at" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableLastUnlockedAt(v *time.Time) *MachineUpdateOne {
+	if v != nil {
+		_u.SetLastUnlockedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUnlockedAt clears the value of the "last_unlocked_at" field.
+func (_u *MachineUpdateOne) ClearLastUnlockedAt() *MachineUpdateOne {
+	_u.mutation.ClearLastUnlockedAt()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *MachineUpdateOne) SetState(v machine.State) *MachineUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (muo *MachineUpdateOne) SetNillableCreatedAt(s *string) *MachineUpdateOne {
-	if s != nil {
-		muo.SetCreatedAt(*s)
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableState(v *machine.State) *MachineUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
 	}
-	return muo
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MachineUpdateOne) SetExpiresAt(v time.Time) *MachineUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableExpiresAt(v *time.Time) *MachineUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *MachineUpdateOne) ClearExpiresAt() *MachineUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSecrets sets the "secrets" field.
+func (_u *MachineUpdateOne) SetSecrets(v []string) *MachineUpdateOne {
+	_u.mutation.SetSecrets(v)
+	return _u
+}
+
+// AppendSecrets appends value to the "secrets" field.
+func (_u *MachineUpdateOne) AppendSecrets(v []string) *MachineUpdateOne {
+	_u.mutation.AppendSecrets(v)
+	return _u
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (_u *MachineUpdateOne) ClearSecrets() *MachineUpdateOne {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// SetPendingConfirmation sets the "pending_confirmation" field.
+func (_u *MachineUpdateOne) SetPendingConfirmation(v bool) *MachineUpdateOne {
+	_u.mutation.SetPendingConfirmation(v)
+	return _u
+}
+
+// SetNillablePendingConfirmation sets the "pending_confirmation" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillablePendingConfirmation(v *bool) *MachineUpdateOne {
+	if v != nil {
+		_u.SetPendingConfirmation(*v)
+	}
+	return _u
 }
 
 // Mutation returns the MachineMutation object of the builder.
-func (muo *MachineUpdateOne) Mutation() *MachineMutation {
-	return muo.mutation
+func (_u *MachineUpdateOne) Mutation() *MachineMutation {
+	return _u.mutation
 }
 
 // Where appends a list predicates to the MachineUpdate builder.
-func (muo *MachineUpdateOne) Where(ps ...predicate.Machine) *MachineUpdateOne {
-	muo.mutation.Where(ps...)
-	return muo
+func (_u *MachineUpdateOne) Where(ps ...predicate.Machine) *MachineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
-func (muo *MachineUpdateOne) Select(field string, fields ...string) *MachineUpdateOne {
-	muo.fields = append([]string{field}, fields...)
-	return muo
+func (_u *MachineUpdateOne) Select(field string, fields ...string) *MachineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
 }
 
 // Save executes the query and returns the updated Machine entity.
-func (muo *MachineUpdateOne) Save(ctx context.Context) (*Machine, error) {
-	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+func (_u *MachineUpdateOne) Save(ctx context.Context) (*Machine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (muo *MachineUpdateOne) SaveX(ctx context.Context) *Machine {
-	node, err := muo.Save(ctx)
+func (_u *MachineUpdateOne) SaveX(ctx context.Context) *Machine {
+	node, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -198,26 +842,64 @@ func (muo *MachineUpdateOne) SaveX(ctx context.Context) *Machine {
 }
 
 // Exec executes the query on the entity.
-func (muo *MachineUpdateOne) Exec(ctx context.Context) error {
-	_, err := muo.Save(ctx)
+func (_u *MachineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (muo *MachineUpdateOne) ExecX(ctx context.Context) {
-	if err := muo.Exec(ctx); err != nil {
+func (_u *MachineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
-func (muo *MachineUpdateOne) sqlSave(ctx context.Context) (_node *Machine, err error) {
+// check runs all checks and user-defined validators on the builder.
+func (_u *MachineUpdateOne) check() error {
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := machine.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "Machine.required_approvals": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShareThreshold(); ok {
+		if err := machine.ShareThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "share_threshold", err: fmt.Errorf(`ent: validator failed for field "Machine.share_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShareIndex(); ok {
+		if err := machine.ShareIndexValidator(v); err != nil {
+			return &ValidationError{Name: "share_index", err: fmt.Errorf(`ent: validator failed for field "Machine.share_index": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HardwareBinding(); ok {
+		if err := machine.HardwareBindingValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_binding", err: fmt.Errorf(`ent: validator failed for field "Machine.hardware_binding": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := machine.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Machine.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secrets(); ok {
+		if err := machine.SecretsValidator(v); err != nil {
+			return &ValidationError{Name: "secrets", err: fmt.Errorf(`ent: validator failed for field "Machine.secrets": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MachineUpdateOne) sqlSave(ctx context.Context) (_node *Machine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(machine.Table, machine.Columns, sqlgraph.NewFieldSpec(machine.FieldID, field.TypeString))
-	id, ok := muo.mutation.ID()
+	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Machine.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
-	if fields := muo.fields; len(fields) > 0 {
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, machine.FieldID)
 		for _, f := range fields {
@@ -229,26 +911,112 @@ func (muo *MachineUpdateOne) sqlSave(ctx context.Context) (_node *Machine, err e
 			}
 		}
 	}
-	if ps := muo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := muo.mutation.Name(); ok {
+	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(machine.FieldName, field.TypeString, value)
 	}
-	if value, ok := muo.mutation.PublicKey(); ok {
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(machine.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(machine.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
 		_spec.SetField(machine.FieldPublicKey, field.TypeBytes, value)
 	}
-	if value, ok := muo.mutation.CreatedAt(); ok {
-		_spec.SetField(machine.FieldCreatedAt, field.TypeString, value)
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(machine.FieldFingerprint, field.TypeString, value)
+	}
+	if _u.mutation.FingerprintCleared() {
+		_spec.ClearField(machine.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(machine.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(machine.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShareThreshold(); ok {
+		_spec.SetField(machine.FieldShareThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShareThreshold(); ok {
+		_spec.AddField(machine.FieldShareThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShareIndex(); ok {
+		_spec.SetField(machine.FieldShareIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShareIndex(); ok {
+		_spec.AddField(machine.FieldShareIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AutoUnlock(); ok {
+		_spec.SetField(machine.FieldAutoUnlock, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(machine.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(machine.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Hardware(); ok {
+		_spec.SetField(machine.FieldHardware, field.TypeJSON, value)
+	}
+	if _u.mutation.HardwareCleared() {
+		_spec.ClearField(machine.FieldHardware, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PendingHardware(); ok {
+		_spec.SetField(machine.FieldPendingHardware, field.TypeJSON, value)
+	}
+	if _u.mutation.PendingHardwareCleared() {
+		_spec.ClearField(machine.FieldPendingHardware, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HardwareBinding(); ok {
+		_spec.SetField(machine.FieldHardwareBinding, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastAskedAt(); ok {
+		_spec.SetField(machine.FieldLastAskedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastAskedAtCleared() {
+		_spec.ClearField(machine.FieldLastAskedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUnlockedAt(); ok {
+		_spec.SetField(machine.FieldLastUnlockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUnlockedAtCleared() {
+		_spec.ClearField(machine.FieldLastUnlockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(machine.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(machine.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(machine.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Secrets(); ok {
+		_spec.SetField(machine.FieldSecrets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecrets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, machine.FieldSecrets, value)
+		})
+	}
+	if _u.mutation.SecretsCleared() {
+		_spec.ClearField(machine.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PendingConfirmation(); ok {
+		_spec.SetField(machine.FieldPendingConfirmation, field.TypeBool, value)
 	}
-	_node = &Machine{config: muo.config}
+	_node = &Machine{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{machine.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -256,6 +1024,6 @@ func (muo *MachineUpdateOne) sqlSave(ctx context.Context) (_node *Machine, err e
 		}
 		return nil, err
 	}
-	muo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+)
+
+// MachineAlias is the model entity for the MachineAlias schema.
+type MachineAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Fingerprint of the rotated public key
+	ID string `json:"id,omitempty"`
+	// ID of the machine the key belonged to
+	MachineID string `json:"machine_id,omitempty"`
+	// Rotated public key of the machine, accepted until the alias expires
+	PublicKey []byte `json:"public_key,omitempty"`
+	// When the rotated key stops being accepted
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// When the key was rotated
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MachineAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case machinealias.FieldPublicKey:
+			values[i] = new([]byte)
+		case machinealias.FieldID, machinealias.FieldMachineID:
+			values[i] = new(sql.NullString)
+		case machinealias.FieldExpiresAt, machinealias.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MachineAlias fields.
+func (_m *MachineAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case machinealias.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case machinealias.FieldMachineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value.Valid {
+				_m.MachineID = value.String
+			}
+		case machinealias.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				_m.PublicKey = *value
+			}
+		case machinealias.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case machinealias.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MachineAlias.
+// This includes values selected through modifiers, order, etc.
+func (_m *MachineAlias) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MachineAlias.
+// Note that you need to call MachineAlias.Unwrap() before calling this method if this MachineAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MachineAlias) Update() *MachineAliasUpdateOne {
+	return NewMachineAliasClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MachineAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MachineAlias) Unwrap() *MachineAlias {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MachineAlias is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MachineAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("MachineAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("machine_id=")
+	builder.WriteString(_m.MachineID)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MachineAliasSlice is a parsable slice of MachineAlias.
+type MachineAliasSlice []*MachineAlias
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// MachineAliasUpdate is the builder for updating MachineAlias entities.
+type MachineAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MachineAliasMutation
+}
+
+// Where appends a list predicates to the MachineAliasUpdate builder.
+func (_u *MachineAliasUpdate) Where(ps ...predicate.MachineAlias) *MachineAliasUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *MachineAliasUpdate) SetMachineID(v string) *MachineAliasUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *MachineAliasUpdate) SetNillableMachineID(v *string) *MachineAliasUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *MachineAliasUpdate) SetPublicKey(v []byte) *MachineAliasUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MachineAliasUpdate) SetExpiresAt(v time.Time) *MachineAliasUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MachineAliasUpdate) SetNillableExpiresAt(v *time.Time) *MachineAliasUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MachineAliasMutation object of the builder.
+func (_u *MachineAliasUpdate) Mutation() *MachineAliasMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MachineAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MachineAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MachineAliasUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MachineAliasUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *MachineAliasUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(machinealias.Table, machinealias.Columns, sqlgraph.NewFieldSpec(machinealias.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(machinealias.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(machinealias.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(machinealias.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{machinealias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MachineAliasUpdateOne is the builder for updating a single MachineAlias entity.
+type MachineAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MachineAliasMutation
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *MachineAliasUpdateOne) SetMachineID(v string) *MachineAliasUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *MachineAliasUpdateOne) SetNillableMachineID(v *string) *MachineAliasUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *MachineAliasUpdateOne) SetPublicKey(v []byte) *MachineAliasUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MachineAliasUpdateOne) SetExpiresAt(v time.Time) *MachineAliasUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MachineAliasUpdateOne) SetNillableExpiresAt(v *time.Time) *MachineAliasUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MachineAliasMutation object of the builder.
+func (_u *MachineAliasUpdateOne) Mutation() *MachineAliasMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MachineAliasUpdate builder.
+func (_u *MachineAliasUpdateOne) Where(ps ...predicate.MachineAlias) *MachineAliasUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MachineAliasUpdateOne) Select(field string, fields ...string) *MachineAliasUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MachineAlias entity.
+func (_u *MachineAliasUpdateOne) Save(ctx context.Context) (*MachineAlias, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MachineAliasUpdateOne) SaveX(ctx context.Context) *MachineAlias {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MachineAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MachineAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *MachineAliasUpdateOne) sqlSave(ctx context.Context) (_node *MachineAlias, err error) {
+	_spec := sqlgraph.NewUpdateSpec(machinealias.Table, machinealias.Columns, sqlgraph.NewFieldSpec(machinealias.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MachineAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, machinealias.FieldID)
+		for _, f := range fields {
+			if !machinealias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != machinealias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(machinealias.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(machinealias.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(machinealias.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &MachineAlias{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{machinealias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
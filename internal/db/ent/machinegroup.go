@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+)
+
+// MachineGroup is the model entity for the MachineGroup schema.
+type MachineGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User friendly name of this group
+	Name string `json:"name,omitempty"`
+	// Free-form description of this group
+	Description string `json:"description,omitempty"`
+	// IDs of the machines in this group
+	MachineIds []string `json:"machine_ids,omitempty"`
+	// Label selector of the machines in this group, in addition to machine_ids
+	Selector string `json:"selector,omitempty"`
+	// When this group was added
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MachineGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case machinegroup.FieldMachineIds:
+			values[i] = new([]byte)
+		case machinegroup.FieldID:
+			values[i] = new(sql.NullInt64)
+		case machinegroup.FieldName, machinegroup.FieldDescription, machinegroup.FieldSelector:
+			values[i] = new(sql.NullString)
+		case machinegroup.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MachineGroup fields.
+func (_m *MachineGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case machinegroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case machinegroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case machinegroup.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case machinegroup.FieldMachineIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MachineIds); err != nil {
+					return fmt.Errorf("unmarshal field machine_ids: %w", err)
+				}
+			}
+		case machinegroup.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case machinegroup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MachineGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *MachineGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MachineGroup.
+// Note that you need to call MachineGroup.Unwrap() before calling this method if this MachineGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MachineGroup) Update() *MachineGroupUpdateOne {
+	return NewMachineGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MachineGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MachineGroup) Unwrap() *MachineGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MachineGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MachineGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("MachineGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("machine_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachineIds))
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MachineGroups is a parsable slice of MachineGroup.
+type MachineGroups []*MachineGroup
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// MachineGroupUpdate is the builder for updating MachineGroup entities.
+type MachineGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MachineGroupMutation
+}
+
+// Where appends a list predicates to the MachineGroupUpdate builder.
+func (_u *MachineGroupUpdate) Where(ps ...predicate.MachineGroup) *MachineGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MachineGroupUpdate) SetName(v string) *MachineGroupUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MachineGroupUpdate) SetNillableName(v *string) *MachineGroupUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MachineGroupUpdate) SetDescription(v string) *MachineGroupUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MachineGroupUpdate) SetNillableDescription(v *string) *MachineGroupUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MachineGroupUpdate) ClearDescription() *MachineGroupUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_u *MachineGroupUpdate) SetMachineIds(v []string) *MachineGroupUpdate {
+	_u.mutation.SetMachineIds(v)
+	return _u
+}
+
+// AppendMachineIds appends value to the "machine_ids" field.
+func (_u *MachineGroupUpdate) AppendMachineIds(v []string) *MachineGroupUpdate {
+	_u.mutation.AppendMachineIds(v)
+	return _u
+}
+
+// ClearMachineIds clears the value of the "machine_ids" field.
+func (_u *MachineGroupUpdate) ClearMachineIds() *MachineGroupUpdate {
+	_u.mutation.ClearMachineIds()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *MachineGroupUpdate) SetSelector(v string) *MachineGroupUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *MachineGroupUpdate) SetNillableSelector(v *string) *MachineGroupUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *MachineGroupUpdate) ClearSelector() *MachineGroupUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// Mutation returns the MachineGroupMutation object of the builder.
+func (_u *MachineGroupUpdate) Mutation() *MachineGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MachineGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MachineGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MachineGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MachineGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MachineGroupUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := machinegroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MachineGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := machinegroup.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "MachineGroup.selector": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MachineGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(machinegroup.Table, machinegroup.Columns, sqlgraph.NewFieldSpec(machinegroup.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(machinegroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(machinegroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(machinegroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.MachineIds(); ok {
+		_spec.SetField(machinegroup.FieldMachineIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMachineIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, machinegroup.FieldMachineIds, value)
+		})
+	}
+	if _u.mutation.MachineIdsCleared() {
+		_spec.ClearField(machinegroup.FieldMachineIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(machinegroup.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(machinegroup.FieldSelector, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{machinegroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MachineGroupUpdateOne is the builder for updating a single MachineGroup entity.
+type MachineGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MachineGroupMutation
+}
+
+// SetName sets the "name" field.
+func (_u *MachineGroupUpdateOne) SetName(v string) *MachineGroupUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MachineGroupUpdateOne) SetNillableName(v *string) *MachineGroupUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MachineGroupUpdateOne) SetDescription(v string) *MachineGroupUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MachineGroupUpdateOne) SetNillableDescription(v *string) *MachineGroupUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MachineGroupUpdateOne) ClearDescription() *MachineGroupUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_u *MachineGroupUpdateOne) SetMachineIds(v []string) *MachineGroupUpdateOne {
+	_u.mutation.SetMachineIds(v)
+	return _u
+}
+
+// AppendMachineIds appends value to the "machine_ids" field.
+func (_u *MachineGroupUpdateOne) AppendMachineIds(v []string) *MachineGroupUpdateOne {
+	_u.mutation.AppendMachineIds(v)
+	return _u
+}
+
+// ClearMachineIds clears the value of the "machine_ids" field.
+func (_u *MachineGroupUpdateOne) ClearMachineIds() *MachineGroupUpdateOne {
+	_u.mutation.ClearMachineIds()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *MachineGroupUpdateOne) SetSelector(v string) *MachineGroupUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *MachineGroupUpdateOne) SetNillableSelector(v *string) *MachineGroupUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *MachineGroupUpdateOne) ClearSelector() *MachineGroupUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// Mutation returns the MachineGroupMutation object of the builder.
+func (_u *MachineGroupUpdateOne) Mutation() *MachineGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MachineGroupUpdate builder.
+func (_u *MachineGroupUpdateOne) Where(ps ...predicate.MachineGroup) *MachineGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MachineGroupUpdateOne) Select(field string, fields ...string) *MachineGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MachineGroup entity.
+func (_u *MachineGroupUpdateOne) Save(ctx context.Context) (*MachineGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MachineGroupUpdateOne) SaveX(ctx context.Context) *MachineGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MachineGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MachineGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MachineGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := machinegroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MachineGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := machinegroup.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "MachineGroup.selector": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MachineGroupUpdateOne) sqlSave(ctx context.Context) (_node *MachineGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(machinegroup.Table, machinegroup.Columns, sqlgraph.NewFieldSpec(machinegroup.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MachineGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, machinegroup.FieldID)
+		for _, f := range fields {
+			if !machinegroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != machinegroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(machinegroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(machinegroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(machinegroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.MachineIds(); ok {
+		_spec.SetField(machinegroup.FieldMachineIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMachineIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, machinegroup.FieldMachineIds, value)
+		})
+	}
+	if _u.mutation.MachineIdsCleared() {
+		_spec.ClearField(machinegroup.FieldMachineIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(machinegroup.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(machinegroup.FieldSelector, field.TypeString)
+	}
+	_node = &MachineGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{machinegroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+)
+
+// MaintenanceWindow is the model entity for the MaintenanceWindow schema.
+type MaintenanceWindow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User friendly name of this window
+	Name string `json:"name,omitempty"`
+	// Cron expression of when this window opens
+	Schedule string `json:"schedule,omitempty"`
+	// How long this window stays open for, in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// IDs of the machines this window applies to
+	MachineIds []string `json:"machine_ids,omitempty"`
+	// Label selector of the machines this window applies to (e.g., role=storage)
+	Selector string `json:"selector,omitempty"`
+	// When this window was added
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceWindow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldMachineIds:
+			values[i] = new([]byte)
+		case maintenancewindow.FieldID, maintenancewindow.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case maintenancewindow.FieldName, maintenancewindow.FieldSchedule, maintenancewindow.FieldSelector:
+			values[i] = new(sql.NullString)
+		case maintenancewindow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceWindow fields.
+func (_m *MaintenanceWindow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case maintenancewindow.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case maintenancewindow.FieldSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule", values[i])
+			} else if value.Valid {
+				_m.Schedule = value.String
+			}
+		case maintenancewindow.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				_m.Duration = value.Int64
+			}
+		case maintenancewindow.FieldMachineIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MachineIds); err != nil {
+					return fmt.Errorf("unmarshal field machine_ids: %w", err)
+				}
+			}
+		case maintenancewindow.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case maintenancewindow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MaintenanceWindow.
+// This includes values selected through modifiers, order, etc.
+func (_m *MaintenanceWindow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MaintenanceWindow.
+// Note that you need to call MaintenanceWindow.Unwrap() before calling this method if this MaintenanceWindow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MaintenanceWindow) Update() *MaintenanceWindowUpdateOne {
+	return NewMaintenanceWindowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MaintenanceWindow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MaintenanceWindow) Unwrap() *MaintenanceWindow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceWindow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MaintenanceWindow) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceWindow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("schedule=")
+	builder.WriteString(_m.Schedule)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("machine_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachineIds))
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceWindows is a parsable slice of MaintenanceWindow.
+type MaintenanceWindows []*MaintenanceWindow
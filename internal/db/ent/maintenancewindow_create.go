@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+)
+
+// MaintenanceWindowCreate is the builder for creating a MaintenanceWindow entity.
+type MaintenanceWindowCreate struct {
+	config
+	mutation *MaintenanceWindowMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *MaintenanceWindowCreate) SetName(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSchedule sets the "schedule" field.
+func (_c *MaintenanceWindowCreate) SetSchedule(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetSchedule(v)
+	return _c
+}
+
+// SetDuration sets the "duration" field.
+func (_c *MaintenanceWindowCreate) SetDuration(v int64) *MaintenanceWindowCreate {
+	_c.mutation.SetDuration(v)
+	return _c
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_c *MaintenanceWindowCreate) SetMachineIds(v []string) *MaintenanceWindowCreate {
+	_c.mutation.SetMachineIds(v)
+	return _c
+}
+
+// SetSelector sets the "selector" field.
+func (_c *MaintenanceWindowCreate) SetSelector(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetSelector(v)
+	return _c
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableSelector(v *string) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetSelector(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MaintenanceWindowCreate) SetCreatedAt(v time.Time) *MaintenanceWindowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableCreatedAt(v *time.Time) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_c *MaintenanceWindowCreate) Mutation() *MaintenanceWindowMutation {
+	return _c.mutation
+}
+
+// Save creates the MaintenanceWindow in the database.
+func (_c *MaintenanceWindowCreate) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MaintenanceWindowCreate) SaveX(ctx context.Context) *MaintenanceWindow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MaintenanceWindowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MaintenanceWindowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MaintenanceWindowCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := maintenancewindow.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MaintenanceWindowCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MaintenanceWindow.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := maintenancewindow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Schedule(); !ok {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required field "MaintenanceWindow.schedule"`)}
+	}
+	if v, ok := _c.mutation.Schedule(); ok {
+		if err := maintenancewindow.ScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.schedule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "MaintenanceWindow.duration"`)}
+	}
+	if v, ok := _c.mutation.Duration(); ok {
+		if err := maintenancewindow.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.duration": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MaintenanceWindow.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *MaintenanceWindowCreate) sqlSave(ctx context.Context) (*MaintenanceWindow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MaintenanceWindowCreate) createSpec() (*MaintenanceWindow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceWindow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Schedule(); ok {
+		_spec.SetField(maintenancewindow.FieldSchedule, field.TypeString, value)
+		_node.Schedule = value
+	}
+	if value, ok := _c.mutation.Duration(); ok {
+		_spec.SetField(maintenancewindow.FieldDuration, field.TypeInt64, value)
+		_node.Duration = value
+	}
+	if value, ok := _c.mutation.MachineIds(); ok {
+		_spec.SetField(maintenancewindow.FieldMachineIds, field.TypeJSON, value)
+		_node.MachineIds = value
+	}
+	if value, ok := _c.mutation.Selector(); ok {
+		_spec.SetField(maintenancewindow.FieldSelector, field.TypeString, value)
+		_node.Selector = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// MaintenanceWindowCreateBulk is the builder for creating many MaintenanceWindow entities in bulk.
+type MaintenanceWindowCreateBulk struct {
+	config
+	err      error
+	builders []*MaintenanceWindowCreate
+}
+
+// Save creates the MaintenanceWindow entities in the database.
+func (_c *MaintenanceWindowCreateBulk) Save(ctx context.Context) ([]*MaintenanceWindow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MaintenanceWindow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceWindowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MaintenanceWindowCreateBulk) SaveX(ctx context.Context) []*MaintenanceWindow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MaintenanceWindowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MaintenanceWindowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// MaintenanceWindowUpdate is the builder for updating MaintenanceWindow entities.
+type MaintenanceWindowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (_u *MaintenanceWindowUpdate) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MaintenanceWindowUpdate) SetName(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableName(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" field.
+func (_u *MaintenanceWindowUpdate) SetSchedule(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetSchedule(v)
+	return _u
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableSchedule(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetSchedule(*v)
+	}
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *MaintenanceWindowUpdate) SetDuration(v int64) *MaintenanceWindowUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableDuration(v *int64) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *MaintenanceWindowUpdate) AddDuration(v int64) *MaintenanceWindowUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_u *MaintenanceWindowUpdate) SetMachineIds(v []string) *MaintenanceWindowUpdate {
+	_u.mutation.SetMachineIds(v)
+	return _u
+}
+
+// AppendMachineIds appends value to the "machine_ids" field.
+func (_u *MaintenanceWindowUpdate) AppendMachineIds(v []string) *MaintenanceWindowUpdate {
+	_u.mutation.AppendMachineIds(v)
+	return _u
+}
+
+// ClearMachineIds clears the value of the "machine_ids" field.
+func (_u *MaintenanceWindowUpdate) ClearMachineIds() *MaintenanceWindowUpdate {
+	_u.mutation.ClearMachineIds()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *MaintenanceWindowUpdate) SetSelector(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableSelector(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *MaintenanceWindowUpdate) ClearSelector() *MaintenanceWindowUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_u *MaintenanceWindowUpdate) Mutation() *MaintenanceWindowMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MaintenanceWindowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MaintenanceWindowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaintenanceWindowUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := maintenancewindow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Schedule(); ok {
+		if err := maintenancewindow.ScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.schedule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Duration(); ok {
+		if err := maintenancewindow.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.duration": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MaintenanceWindowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Schedule(); ok {
+		_spec.SetField(maintenancewindow.FieldSchedule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(maintenancewindow.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(maintenancewindow.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MachineIds(); ok {
+		_spec.SetField(maintenancewindow.FieldMachineIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMachineIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, maintenancewindow.FieldMachineIds, value)
+		})
+	}
+	if _u.mutation.MachineIdsCleared() {
+		_spec.ClearField(maintenancewindow.FieldMachineIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(maintenancewindow.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(maintenancewindow.FieldSelector, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MaintenanceWindowUpdateOne is the builder for updating a single MaintenanceWindow entity.
+type MaintenanceWindowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// SetName sets the "name" field.
+func (_u *MaintenanceWindowUpdateOne) SetName(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableName(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" field.
+func (_u *MaintenanceWindowUpdateOne) SetSchedule(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetSchedule(v)
+	return _u
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableSchedule(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetSchedule(*v)
+	}
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *MaintenanceWindowUpdateOne) SetDuration(v int64) *MaintenanceWindowUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableDuration(v *int64) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *MaintenanceWindowUpdateOne) AddDuration(v int64) *MaintenanceWindowUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_u *MaintenanceWindowUpdateOne) SetMachineIds(v []string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetMachineIds(v)
+	return _u
+}
+
+// AppendMachineIds appends value to the "machine_ids" field.
+func (_u *MaintenanceWindowUpdateOne) AppendMachineIds(v []string) *MaintenanceWindowUpdateOne {
+	_u.mutation.AppendMachineIds(v)
+	return _u
+}
+
+// ClearMachineIds clears the value of the "machine_ids" field.
+func (_u *MaintenanceWindowUpdateOne) ClearMachineIds() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearMachineIds()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *MaintenanceWindowUpdateOne) SetSelector(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableSelector(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *MaintenanceWindowUpdateOne) ClearSelector() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_u *MaintenanceWindowUpdateOne) Mutation() *MaintenanceWindowMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (_u *MaintenanceWindowUpdateOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MaintenanceWindowUpdateOne) Select(field string, fields ...string) *MaintenanceWindowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MaintenanceWindow entity.
+func (_u *MaintenanceWindowUpdateOne) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdateOne) SaveX(ctx context.Context) *MaintenanceWindow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MaintenanceWindowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaintenanceWindowUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := maintenancewindow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Schedule(); ok {
+		if err := maintenancewindow.ScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.schedule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Duration(); ok {
+		if err := maintenancewindow.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.duration": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MaintenanceWindowUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceWindow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceWindow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for _, f := range fields {
+			if !maintenancewindow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Schedule(); ok {
+		_spec.SetField(maintenancewindow.FieldSchedule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(maintenancewindow.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(maintenancewindow.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MachineIds(); ok {
+		_spec.SetField(maintenancewindow.FieldMachineIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMachineIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, maintenancewindow.FieldMachineIds, value)
+		})
+	}
+	if _u.mutation.MachineIdsCleared() {
+		_spec.ClearField(maintenancewindow.FieldMachineIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(maintenancewindow.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(maintenancewindow.FieldSelector, field.TypeString)
+	}
+	_node = &MaintenanceWindow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
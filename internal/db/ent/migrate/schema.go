@@ -8,12 +8,79 @@ import (
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString},
+		{Name: "machine_id", Type: field.TypeString, Nullable: true},
+		{Name: "operator_id", Type: field.TypeString, Nullable: true},
+		{Name: "maintenance_window", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeString, Nullable: true},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+	}
+	// BootMeasurementsColumns holds the columns for the "boot_measurements" table.
+	BootMeasurementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "machine_id", Type: field.TypeString},
+		{Name: "kernel", Type: field.TypeString, Nullable: true},
+		{Name: "initramfs", Type: field.TypeString, Nullable: true},
+		{Name: "cmdline", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BootMeasurementsTable holds the schema information for the "boot_measurements" table.
+	BootMeasurementsTable = &schema.Table{
+		Name:       "boot_measurements",
+		Columns:    BootMeasurementsColumns,
+		PrimaryKey: []*schema.Column{BootMeasurementsColumns[0]},
+	}
+	// EnrollmentTokensColumns holds the columns for the "enrollment_tokens" table.
+	EnrollmentTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeBytes, Unique: true},
+		{Name: "machine_name", Type: field.TypeString},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "required_approvals", Type: field.TypeInt, Default: 0},
+		{Name: "require_confirmation", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "machine_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EnrollmentTokensTable holds the schema information for the "enrollment_tokens" table.
+	EnrollmentTokensTable = &schema.Table{
+		Name:       "enrollment_tokens",
+		Columns:    EnrollmentTokensColumns,
+		PrimaryKey: []*schema.Column{EnrollmentTokensColumns[0]},
+	}
 	// MachinesColumns holds the columns for the "machines" table.
 	MachinesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "public_key", Type: field.TypeBytes},
-		{Name: "created_at", Type: field.TypeString, Default: "2025-03-07T05:15:58Z"},
+		{Name: "fingerprint", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "required_approvals", Type: field.TypeInt, Default: 0},
+		{Name: "share_threshold", Type: field.TypeInt, Default: 0},
+		{Name: "share_index", Type: field.TypeInt, Default: 0},
+		{Name: "auto_unlock", Type: field.TypeBool, Default: false},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "hardware", Type: field.TypeJSON, Nullable: true},
+		{Name: "pending_hardware", Type: field.TypeJSON, Nullable: true},
+		{Name: "hardware_binding", Type: field.TypeEnum, Enums: []string{"warn", "enforce"}, Default: "warn"},
+		{Name: "last_asked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_unlocked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"active", "disabled", "quarantined"}, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true},
+		{Name: "pending_confirmation", Type: field.TypeBool, Default: false},
 	}
 	// MachinesTable holds the schema information for the "machines" table.
 	MachinesTable = &schema.Table{
@@ -21,9 +88,143 @@ var (
 		Columns:    MachinesColumns,
 		PrimaryKey: []*schema.Column{MachinesColumns[0]},
 	}
+	// MachineAliasColumns holds the columns for the "machine_alias" table.
+	MachineAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "machine_id", Type: field.TypeString},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MachineAliasTable holds the schema information for the "machine_alias" table.
+	MachineAliasTable = &schema.Table{
+		Name:       "machine_alias",
+		Columns:    MachineAliasColumns,
+		PrimaryKey: []*schema.Column{MachineAliasColumns[0]},
+	}
+	// MachineGroupsColumns holds the columns for the "machine_groups" table.
+	MachineGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "machine_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "selector", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MachineGroupsTable holds the schema information for the "machine_groups" table.
+	MachineGroupsTable = &schema.Table{
+		Name:       "machine_groups",
+		Columns:    MachineGroupsColumns,
+		PrimaryKey: []*schema.Column{MachineGroupsColumns[0]},
+	}
+	// MaintenanceWindowsColumns holds the columns for the "maintenance_windows" table.
+	MaintenanceWindowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "schedule", Type: field.TypeString},
+		{Name: "duration", Type: field.TypeInt64},
+		{Name: "machine_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "selector", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MaintenanceWindowsTable holds the schema information for the "maintenance_windows" table.
+	MaintenanceWindowsTable = &schema.Table{
+		Name:       "maintenance_windows",
+		Columns:    MaintenanceWindowsColumns,
+		PrimaryKey: []*schema.Column{MaintenanceWindowsColumns[0]},
+	}
+	// OperatorsColumns holds the columns for the "operators" table.
+	OperatorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OperatorsTable holds the schema information for the "operators" table.
+	OperatorsTable = &schema.Table{
+		Name:       "operators",
+		Columns:    OperatorsColumns,
+		PrimaryKey: []*schema.Column{OperatorsColumns[0]},
+	}
+	// StoredKeysColumns holds the columns for the "stored_keys" table.
+	StoredKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "machine_id", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString, Default: ""},
+		{Name: "ciphertext", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// StoredKeysTable holds the schema information for the "stored_keys" table.
+	StoredKeysTable = &schema.Table{
+		Name:       "stored_keys",
+		Columns:    StoredKeysColumns,
+		PrimaryKey: []*schema.Column{StoredKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "storedkey_machine_id_secret",
+				Unique:  true,
+				Columns: []*schema.Column{StoredKeysColumns[1], StoredKeysColumns[2]},
+			},
+		},
+	}
+	// TangKeysColumns holds the columns for the "tang_keys" table.
+	TangKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "use", Type: field.TypeEnum, Enums: []string{"sign", "exchange"}},
+		{Name: "machine_id", Type: field.TypeString, Nullable: true},
+		{Name: "private_key", Type: field.TypeBytes},
+		{Name: "advertised", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TangKeysTable holds the schema information for the "tang_keys" table.
+	TangKeysTable = &schema.Table{
+		Name:       "tang_keys",
+		Columns:    TangKeysColumns,
+		PrimaryKey: []*schema.Column{TangKeysColumns[0]},
+	}
+	// UnlockPoliciesColumns holds the columns for the "unlock_policies" table.
+	UnlockPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "expression", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"release", "require_approvals", "deny"}},
+		{Name: "required_approvals", Type: field.TypeInt, Default: 0},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UnlockPoliciesTable holds the schema information for the "unlock_policies" table.
+	UnlockPoliciesTable = &schema.Table{
+		Name:       "unlock_policies",
+		Columns:    UnlockPoliciesColumns,
+		PrimaryKey: []*schema.Column{UnlockPoliciesColumns[0]},
+	}
+	// VaultsColumns holds the columns for the "vaults" table.
+	VaultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_hash", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VaultsTable holds the schema information for the "vaults" table.
+	VaultsTable = &schema.Table{
+		Name:       "vaults",
+		Columns:    VaultsColumns,
+		PrimaryKey: []*schema.Column{VaultsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		BootMeasurementsTable,
+		EnrollmentTokensTable,
 		MachinesTable,
+		MachineAliasTable,
+		MachineGroupsTable,
+		MaintenanceWindowsTable,
+		OperatorsTable,
+		StoredKeysTable,
+		TangKeysTable,
+		UnlockPoliciesTable,
+		VaultsTable,
 	}
 )
 
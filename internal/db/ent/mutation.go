@@ -7,11 +7,23 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
 	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+	"git.rgst.io/homelab/klefki/internal/db/ent/operator"
 	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"git.rgst.io/homelab/klefki/internal/db/ent/vault"
 )
 
 const (
@@ -23,35 +35,7860 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeMachine = "Machine"
+	TypeAuditEvent        = "AuditEvent"
+	TypeBootMeasurement   = "BootMeasurement"
+	TypeEnrollmentToken   = "EnrollmentToken"
+	TypeMachine           = "Machine"
+	TypeMachineAlias      = "MachineAlias"
+	TypeMachineGroup      = "MachineGroup"
+	TypeMaintenanceWindow = "MaintenanceWindow"
+	TypeOperator          = "Operator"
+	TypeStoredKey         = "StoredKey"
+	TypeTangKey           = "TangKey"
+	TypeUnlockPolicy      = "UnlockPolicy"
+	TypeVault             = "Vault"
 )
 
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	created_at         *time.Time
+	_type              *string
+	machine_id         *string
+	operator_id        *string
+	maintenance_window *string
+	details            *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*AuditEvent, error)
+	predicates         []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id int) auditeventOption {
+	return func(m *AuditEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditEvent
+		)
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetType sets the "type" field.
+func (m *AuditEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *AuditEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *AuditEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *AuditEventMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *AuditEventMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (m *AuditEventMutation) ClearMachineID() {
+	m.machine_id = nil
+	m.clearedFields[auditevent.FieldMachineID] = struct{}{}
+}
+
+// MachineIDCleared returns if the "machine_id" field was cleared in this mutation.
+func (m *AuditEventMutation) MachineIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldMachineID]
+	return ok
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *AuditEventMutation) ResetMachineID() {
+	m.machine_id = nil
+	delete(m.clearedFields, auditevent.FieldMachineID)
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (m *AuditEventMutation) SetOperatorID(s string) {
+	m.operator_id = &s
+}
+
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *AuditEventMutation) OperatorID() (r string, exists bool) {
+	v := m.operator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperatorID returns the old "operator_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldOperatorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
+	}
+	return oldValue.OperatorID, nil
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (m *AuditEventMutation) ClearOperatorID() {
+	m.operator_id = nil
+	m.clearedFields[auditevent.FieldOperatorID] = struct{}{}
+}
+
+// OperatorIDCleared returns if the "operator_id" field was cleared in this mutation.
+func (m *AuditEventMutation) OperatorIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldOperatorID]
+	return ok
+}
+
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *AuditEventMutation) ResetOperatorID() {
+	m.operator_id = nil
+	delete(m.clearedFields, auditevent.FieldOperatorID)
+}
+
+// SetMaintenanceWindow sets the "maintenance_window" field.
+func (m *AuditEventMutation) SetMaintenanceWindow(s string) {
+	m.maintenance_window = &s
+}
+
+// MaintenanceWindow returns the value of the "maintenance_window" field in the mutation.
+func (m *AuditEventMutation) MaintenanceWindow() (r string, exists bool) {
+	v := m.maintenance_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaintenanceWindow returns the old "maintenance_window" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldMaintenanceWindow(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaintenanceWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaintenanceWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaintenanceWindow: %w", err)
+	}
+	return oldValue.MaintenanceWindow, nil
+}
+
+// ClearMaintenanceWindow clears the value of the "maintenance_window" field.
+func (m *AuditEventMutation) ClearMaintenanceWindow() {
+	m.maintenance_window = nil
+	m.clearedFields[auditevent.FieldMaintenanceWindow] = struct{}{}
+}
+
+// MaintenanceWindowCleared returns if the "maintenance_window" field was cleared in this mutation.
+func (m *AuditEventMutation) MaintenanceWindowCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldMaintenanceWindow]
+	return ok
+}
+
+// ResetMaintenanceWindow resets all changes to the "maintenance_window" field.
+func (m *AuditEventMutation) ResetMaintenanceWindow() {
+	m.maintenance_window = nil
+	delete(m.clearedFields, auditevent.FieldMaintenanceWindow)
+}
+
+// SetDetails sets the "details" field.
+func (m *AuditEventMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditEventMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *AuditEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditevent.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditevent.FieldDetails)
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, auditevent.FieldCreatedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, auditevent.FieldType)
+	}
+	if m.machine_id != nil {
+		fields = append(fields, auditevent.FieldMachineID)
+	}
+	if m.operator_id != nil {
+		fields = append(fields, auditevent.FieldOperatorID)
+	}
+	if m.maintenance_window != nil {
+		fields = append(fields, auditevent.FieldMaintenanceWindow)
+	}
+	if m.details != nil {
+		fields = append(fields, auditevent.FieldDetails)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditevent.FieldType:
+		return m.GetType()
+	case auditevent.FieldMachineID:
+		return m.MachineID()
+	case auditevent.FieldOperatorID:
+		return m.OperatorID()
+	case auditevent.FieldMaintenanceWindow:
+		return m.MaintenanceWindow()
+	case auditevent.FieldDetails:
+		return m.Details()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditevent.FieldType:
+		return m.OldType(ctx)
+	case auditevent.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case auditevent.FieldOperatorID:
+		return m.OldOperatorID(ctx)
+	case auditevent.FieldMaintenanceWindow:
+		return m.OldMaintenanceWindow(ctx)
+	case auditevent.FieldDetails:
+		return m.OldDetails(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case auditevent.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case auditevent.FieldOperatorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorID(v)
+		return nil
+	case auditevent.FieldMaintenanceWindow:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaintenanceWindow(v)
+		return nil
+	case auditevent.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditevent.FieldMachineID) {
+		fields = append(fields, auditevent.FieldMachineID)
+	}
+	if m.FieldCleared(auditevent.FieldOperatorID) {
+		fields = append(fields, auditevent.FieldOperatorID)
+	}
+	if m.FieldCleared(auditevent.FieldMaintenanceWindow) {
+		fields = append(fields, auditevent.FieldMaintenanceWindow)
+	}
+	if m.FieldCleared(auditevent.FieldDetails) {
+		fields = append(fields, auditevent.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEventMutation) ClearField(name string) error {
+	switch name {
+	case auditevent.FieldMachineID:
+		m.ClearMachineID()
+		return nil
+	case auditevent.FieldOperatorID:
+		m.ClearOperatorID()
+		return nil
+	case auditevent.FieldMaintenanceWindow:
+		m.ClearMaintenanceWindow()
+		return nil
+	case auditevent.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEventMutation) ResetField(name string) error {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditevent.FieldType:
+		m.ResetType()
+		return nil
+	case auditevent.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case auditevent.FieldOperatorID:
+		m.ResetOperatorID()
+		return nil
+	case auditevent.FieldMaintenanceWindow:
+		m.ResetMaintenanceWindow()
+		return nil
+	case auditevent.FieldDetails:
+		m.ResetDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
+}
+
+// BootMeasurementMutation represents an operation that mutates the BootMeasurement nodes in the graph.
+type BootMeasurementMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	machine_id    *string
+	kernel        *string
+	initramfs     *string
+	cmdline       *string
+	description   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*BootMeasurement, error)
+	predicates    []predicate.BootMeasurement
+}
+
+var _ ent.Mutation = (*BootMeasurementMutation)(nil)
+
+// bootmeasurementOption allows management of the mutation configuration using functional options.
+type bootmeasurementOption func(*BootMeasurementMutation)
+
+// newBootMeasurementMutation creates new mutation for the BootMeasurement entity.
+func newBootMeasurementMutation(c config, op Op, opts ...bootmeasurementOption) *BootMeasurementMutation {
+	m := &BootMeasurementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBootMeasurement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBootMeasurementID sets the ID field of the mutation.
+func withBootMeasurementID(id int) bootmeasurementOption {
+	return func(m *BootMeasurementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BootMeasurement
+		)
+		m.oldValue = func(ctx context.Context) (*BootMeasurement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BootMeasurement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBootMeasurement sets the old BootMeasurement of the mutation.
+func withBootMeasurement(node *BootMeasurement) bootmeasurementOption {
+	return func(m *BootMeasurementMutation) {
+		m.oldValue = func(context.Context) (*BootMeasurement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BootMeasurementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BootMeasurementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BootMeasurementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BootMeasurementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BootMeasurement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *BootMeasurementMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *BootMeasurementMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the BootMeasurement entity.
+// If the BootMeasurement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BootMeasurementMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *BootMeasurementMutation) ResetMachineID() {
+	m.machine_id = nil
+}
+
+// SetKernel sets the "kernel" field.
+func (m *BootMeasurementMutation) SetKernel(s string) {
+	m.kernel = &s
+}
+
+// Kernel returns the value of the "kernel" field in the mutation.
+func (m *BootMeasurementMutation) Kernel() (r string, exists bool) {
+	v := m.kernel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKernel returns the old "kernel" field's value of the BootMeasurement entity.
+// If the BootMeasurement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BootMeasurementMutation) OldKernel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKernel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKernel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKernel: %w", err)
+	}
+	return oldValue.Kernel, nil
+}
+
+// ClearKernel clears the value of the "kernel" field.
+func (m *BootMeasurementMutation) ClearKernel() {
+	m.kernel = nil
+	m.clearedFields[bootmeasurement.FieldKernel] = struct{}{}
+}
+
+// KernelCleared returns if the "kernel" field was cleared in this mutation.
+func (m *BootMeasurementMutation) KernelCleared() bool {
+	_, ok := m.clearedFields[bootmeasurement.FieldKernel]
+	return ok
+}
+
+// ResetKernel resets all changes to the "kernel" field.
+func (m *BootMeasurementMutation) ResetKernel() {
+	m.kernel = nil
+	delete(m.clearedFields, bootmeasurement.FieldKernel)
+}
+
+// SetInitramfs sets the "initramfs" field.
+func (m *BootMeasurementMutation) SetInitramfs(s string) {
+	m.initramfs = &s
+}
+
+// Initramfs returns the value of the "initramfs" field in the mutation.
+func (m *BootMeasurementMutation) Initramfs() (r string, exists bool) {
+	v := m.initramfs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitramfs returns the old "initramfs" field's value of the BootMeasurement entity.
+// If the BootMeasurement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BootMeasurementMutation) OldInitramfs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitramfs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitramfs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitramfs: %w", err)
+	}
+	return oldValue.Initramfs, nil
+}
+
+// ClearInitramfs clears the value of the "initramfs" field.
+func (m *BootMeasurementMutation) ClearInitramfs() {
+	m.initramfs = nil
+	m.clearedFields[bootmeasurement.FieldInitramfs] = struct{}{}
+}
+
+// InitramfsCleared returns if the "initramfs" field was cleared in this mutation.
+func (m *BootMeasurementMutation) InitramfsCleared() bool {
+	_, ok := m.clearedFields[bootmeasurement.FieldInitramfs]
+	return ok
+}
+
+// ResetInitramfs resets all changes to the "initramfs" field.
+func (m *BootMeasurementMutation) ResetInitramfs() {
+	m.initramfs = nil
+	delete(m.clearedFields, bootmeasurement.FieldInitramfs)
+}
+
+// SetCmdline sets the "cmdline" field.
+func (m *BootMeasurementMutation) SetCmdline(s string) {
+	m.cmdline = &s
+}
+
+// Cmdline returns the value of the "cmdline" field in the mutation.
+func (m *BootMeasurementMutation) Cmdline() (r string, exists bool) {
+	v := m.cmdline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCmdline returns the old "cmdline" field's value of the BootMeasurement entity.
+// If the BootMeasurement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BootMeasurementMutation) OldCmdline(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCmdline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCmdline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCmdline: %w", err)
+	}
+	return oldValue.Cmdline, nil
+}
+
+// ClearCmdline clears the value of the "cmdline" field.
+func (m *BootMeasurementMutation) ClearCmdline() {
+	m.cmdline = nil
+	m.clearedFields[bootmeasurement.FieldCmdline] = struct{}{}
+}
+
+// CmdlineCleared returns if the "cmdline" field was cleared in this mutation.
+func (m *BootMeasurementMutation) CmdlineCleared() bool {
+	_, ok := m.clearedFields[bootmeasurement.FieldCmdline]
+	return ok
+}
+
+// ResetCmdline resets all changes to the "cmdline" field.
+func (m *BootMeasurementMutation) ResetCmdline() {
+	m.cmdline = nil
+	delete(m.clearedFields, bootmeasurement.FieldCmdline)
+}
+
+// SetDescription sets the "description" field.
+func (m *BootMeasurementMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BootMeasurementMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BootMeasurement entity.
+// If the BootMeasurement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BootMeasurementMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BootMeasurementMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bootmeasurement.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BootMeasurementMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bootmeasurement.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BootMeasurementMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bootmeasurement.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BootMeasurementMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BootMeasurementMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BootMeasurement entity.
+// If the BootMeasurement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BootMeasurementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BootMeasurementMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the BootMeasurementMutation builder.
+func (m *BootMeasurementMutation) Where(ps ...predicate.BootMeasurement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BootMeasurementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BootMeasurementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BootMeasurement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BootMeasurementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BootMeasurementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BootMeasurement).
+func (m *BootMeasurementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BootMeasurementMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.machine_id != nil {
+		fields = append(fields, bootmeasurement.FieldMachineID)
+	}
+	if m.kernel != nil {
+		fields = append(fields, bootmeasurement.FieldKernel)
+	}
+	if m.initramfs != nil {
+		fields = append(fields, bootmeasurement.FieldInitramfs)
+	}
+	if m.cmdline != nil {
+		fields = append(fields, bootmeasurement.FieldCmdline)
+	}
+	if m.description != nil {
+		fields = append(fields, bootmeasurement.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, bootmeasurement.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BootMeasurementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bootmeasurement.FieldMachineID:
+		return m.MachineID()
+	case bootmeasurement.FieldKernel:
+		return m.Kernel()
+	case bootmeasurement.FieldInitramfs:
+		return m.Initramfs()
+	case bootmeasurement.FieldCmdline:
+		return m.Cmdline()
+	case bootmeasurement.FieldDescription:
+		return m.Description()
+	case bootmeasurement.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BootMeasurementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bootmeasurement.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case bootmeasurement.FieldKernel:
+		return m.OldKernel(ctx)
+	case bootmeasurement.FieldInitramfs:
+		return m.OldInitramfs(ctx)
+	case bootmeasurement.FieldCmdline:
+		return m.OldCmdline(ctx)
+	case bootmeasurement.FieldDescription:
+		return m.OldDescription(ctx)
+	case bootmeasurement.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BootMeasurement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BootMeasurementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bootmeasurement.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case bootmeasurement.FieldKernel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernel(v)
+		return nil
+	case bootmeasurement.FieldInitramfs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitramfs(v)
+		return nil
+	case bootmeasurement.FieldCmdline:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCmdline(v)
+		return nil
+	case bootmeasurement.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bootmeasurement.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BootMeasurement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BootMeasurementMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BootMeasurementMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BootMeasurementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BootMeasurement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BootMeasurementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bootmeasurement.FieldKernel) {
+		fields = append(fields, bootmeasurement.FieldKernel)
+	}
+	if m.FieldCleared(bootmeasurement.FieldInitramfs) {
+		fields = append(fields, bootmeasurement.FieldInitramfs)
+	}
+	if m.FieldCleared(bootmeasurement.FieldCmdline) {
+		fields = append(fields, bootmeasurement.FieldCmdline)
+	}
+	if m.FieldCleared(bootmeasurement.FieldDescription) {
+		fields = append(fields, bootmeasurement.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BootMeasurementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BootMeasurementMutation) ClearField(name string) error {
+	switch name {
+	case bootmeasurement.FieldKernel:
+		m.ClearKernel()
+		return nil
+	case bootmeasurement.FieldInitramfs:
+		m.ClearInitramfs()
+		return nil
+	case bootmeasurement.FieldCmdline:
+		m.ClearCmdline()
+		return nil
+	case bootmeasurement.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown BootMeasurement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BootMeasurementMutation) ResetField(name string) error {
+	switch name {
+	case bootmeasurement.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case bootmeasurement.FieldKernel:
+		m.ResetKernel()
+		return nil
+	case bootmeasurement.FieldInitramfs:
+		m.ResetInitramfs()
+		return nil
+	case bootmeasurement.FieldCmdline:
+		m.ResetCmdline()
+		return nil
+	case bootmeasurement.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bootmeasurement.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BootMeasurement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BootMeasurementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BootMeasurementMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BootMeasurementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BootMeasurementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BootMeasurementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BootMeasurementMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BootMeasurementMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BootMeasurement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BootMeasurementMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BootMeasurement edge %s", name)
+}
+
+// EnrollmentTokenMutation represents an operation that mutates the EnrollmentToken nodes in the graph.
+type EnrollmentTokenMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	token_hash            *[]byte
+	machine_name          *string
+	labels                *map[string]string
+	required_approvals    *int
+	addrequired_approvals *int
+	require_confirmation  *bool
+	expires_at            *time.Time
+	used_at               *time.Time
+	machine_id            *string
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*EnrollmentToken, error)
+	predicates            []predicate.EnrollmentToken
+}
+
+var _ ent.Mutation = (*EnrollmentTokenMutation)(nil)
+
+// enrollmenttokenOption allows management of the mutation configuration using functional options.
+type enrollmenttokenOption func(*EnrollmentTokenMutation)
+
+// newEnrollmentTokenMutation creates new mutation for the EnrollmentToken entity.
+func newEnrollmentTokenMutation(c config, op Op, opts ...enrollmenttokenOption) *EnrollmentTokenMutation {
+	m := &EnrollmentTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnrollmentToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnrollmentTokenID sets the ID field of the mutation.
+func withEnrollmentTokenID(id int) enrollmenttokenOption {
+	return func(m *EnrollmentTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnrollmentToken
+		)
+		m.oldValue = func(ctx context.Context) (*EnrollmentToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnrollmentToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnrollmentToken sets the old EnrollmentToken of the mutation.
+func withEnrollmentToken(node *EnrollmentToken) enrollmenttokenOption {
+	return func(m *EnrollmentTokenMutation) {
+		m.oldValue = func(context.Context) (*EnrollmentToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnrollmentTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnrollmentTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnrollmentTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnrollmentTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnrollmentToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *EnrollmentTokenMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *EnrollmentTokenMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *EnrollmentTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetMachineName sets the "machine_name" field.
+func (m *EnrollmentTokenMutation) SetMachineName(s string) {
+	m.machine_name = &s
+}
+
+// MachineName returns the value of the "machine_name" field in the mutation.
+func (m *EnrollmentTokenMutation) MachineName() (r string, exists bool) {
+	v := m.machine_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineName returns the old "machine_name" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldMachineName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineName: %w", err)
+	}
+	return oldValue.MachineName, nil
+}
+
+// ResetMachineName resets all changes to the "machine_name" field.
+func (m *EnrollmentTokenMutation) ResetMachineName() {
+	m.machine_name = nil
+}
+
+// SetLabels sets the "labels" field.
+func (m *EnrollmentTokenMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *EnrollmentTokenMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *EnrollmentTokenMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[enrollmenttoken.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *EnrollmentTokenMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[enrollmenttoken.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *EnrollmentTokenMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, enrollmenttoken.FieldLabels)
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (m *EnrollmentTokenMutation) SetRequiredApprovals(i int) {
+	m.required_approvals = &i
+	m.addrequired_approvals = nil
+}
+
+// RequiredApprovals returns the value of the "required_approvals" field in the mutation.
+func (m *EnrollmentTokenMutation) RequiredApprovals() (r int, exists bool) {
+	v := m.required_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiredApprovals returns the old "required_approvals" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldRequiredApprovals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiredApprovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiredApprovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiredApprovals: %w", err)
+	}
+	return oldValue.RequiredApprovals, nil
+}
+
+// AddRequiredApprovals adds i to the "required_approvals" field.
+func (m *EnrollmentTokenMutation) AddRequiredApprovals(i int) {
+	if m.addrequired_approvals != nil {
+		*m.addrequired_approvals += i
+	} else {
+		m.addrequired_approvals = &i
+	}
+}
+
+// AddedRequiredApprovals returns the value that was added to the "required_approvals" field in this mutation.
+func (m *EnrollmentTokenMutation) AddedRequiredApprovals() (r int, exists bool) {
+	v := m.addrequired_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequiredApprovals resets all changes to the "required_approvals" field.
+func (m *EnrollmentTokenMutation) ResetRequiredApprovals() {
+	m.required_approvals = nil
+	m.addrequired_approvals = nil
+}
+
+// SetRequireConfirmation sets the "require_confirmation" field.
+func (m *EnrollmentTokenMutation) SetRequireConfirmation(b bool) {
+	m.require_confirmation = &b
+}
+
+// RequireConfirmation returns the value of the "require_confirmation" field in the mutation.
+func (m *EnrollmentTokenMutation) RequireConfirmation() (r bool, exists bool) {
+	v := m.require_confirmation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequireConfirmation returns the old "require_confirmation" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldRequireConfirmation(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequireConfirmation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequireConfirmation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequireConfirmation: %w", err)
+	}
+	return oldValue.RequireConfirmation, nil
+}
+
+// ResetRequireConfirmation resets all changes to the "require_confirmation" field.
+func (m *EnrollmentTokenMutation) ResetRequireConfirmation() {
+	m.require_confirmation = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *EnrollmentTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EnrollmentTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EnrollmentTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *EnrollmentTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *EnrollmentTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *EnrollmentTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[enrollmenttoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *EnrollmentTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[enrollmenttoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *EnrollmentTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, enrollmenttoken.FieldUsedAt)
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *EnrollmentTokenMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *EnrollmentTokenMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (m *EnrollmentTokenMutation) ClearMachineID() {
+	m.machine_id = nil
+	m.clearedFields[enrollmenttoken.FieldMachineID] = struct{}{}
+}
+
+// MachineIDCleared returns if the "machine_id" field was cleared in this mutation.
+func (m *EnrollmentTokenMutation) MachineIDCleared() bool {
+	_, ok := m.clearedFields[enrollmenttoken.FieldMachineID]
+	return ok
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *EnrollmentTokenMutation) ResetMachineID() {
+	m.machine_id = nil
+	delete(m.clearedFields, enrollmenttoken.FieldMachineID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnrollmentTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnrollmentTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnrollmentToken entity.
+// If the EnrollmentToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnrollmentTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EnrollmentTokenMutation builder.
+func (m *EnrollmentTokenMutation) Where(ps ...predicate.EnrollmentToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnrollmentTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnrollmentTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnrollmentToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnrollmentTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnrollmentTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnrollmentToken).
+func (m *EnrollmentTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnrollmentTokenMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.token_hash != nil {
+		fields = append(fields, enrollmenttoken.FieldTokenHash)
+	}
+	if m.machine_name != nil {
+		fields = append(fields, enrollmenttoken.FieldMachineName)
+	}
+	if m.labels != nil {
+		fields = append(fields, enrollmenttoken.FieldLabels)
+	}
+	if m.required_approvals != nil {
+		fields = append(fields, enrollmenttoken.FieldRequiredApprovals)
+	}
+	if m.require_confirmation != nil {
+		fields = append(fields, enrollmenttoken.FieldRequireConfirmation)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, enrollmenttoken.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, enrollmenttoken.FieldUsedAt)
+	}
+	if m.machine_id != nil {
+		fields = append(fields, enrollmenttoken.FieldMachineID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, enrollmenttoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnrollmentTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case enrollmenttoken.FieldTokenHash:
+		return m.TokenHash()
+	case enrollmenttoken.FieldMachineName:
+		return m.MachineName()
+	case enrollmenttoken.FieldLabels:
+		return m.Labels()
+	case enrollmenttoken.FieldRequiredApprovals:
+		return m.RequiredApprovals()
+	case enrollmenttoken.FieldRequireConfirmation:
+		return m.RequireConfirmation()
+	case enrollmenttoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case enrollmenttoken.FieldUsedAt:
+		return m.UsedAt()
+	case enrollmenttoken.FieldMachineID:
+		return m.MachineID()
+	case enrollmenttoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnrollmentTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case enrollmenttoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case enrollmenttoken.FieldMachineName:
+		return m.OldMachineName(ctx)
+	case enrollmenttoken.FieldLabels:
+		return m.OldLabels(ctx)
+	case enrollmenttoken.FieldRequiredApprovals:
+		return m.OldRequiredApprovals(ctx)
+	case enrollmenttoken.FieldRequireConfirmation:
+		return m.OldRequireConfirmation(ctx)
+	case enrollmenttoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case enrollmenttoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case enrollmenttoken.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case enrollmenttoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnrollmentToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrollmentTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case enrollmenttoken.FieldTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case enrollmenttoken.FieldMachineName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineName(v)
+		return nil
+	case enrollmenttoken.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case enrollmenttoken.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiredApprovals(v)
+		return nil
+	case enrollmenttoken.FieldRequireConfirmation:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequireConfirmation(v)
+		return nil
+	case enrollmenttoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case enrollmenttoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case enrollmenttoken.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case enrollmenttoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnrollmentTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addrequired_approvals != nil {
+		fields = append(fields, enrollmenttoken.FieldRequiredApprovals)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnrollmentTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case enrollmenttoken.FieldRequiredApprovals:
+		return m.AddedRequiredApprovals()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrollmentTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case enrollmenttoken.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequiredApprovals(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnrollmentTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(enrollmenttoken.FieldLabels) {
+		fields = append(fields, enrollmenttoken.FieldLabels)
+	}
+	if m.FieldCleared(enrollmenttoken.FieldUsedAt) {
+		fields = append(fields, enrollmenttoken.FieldUsedAt)
+	}
+	if m.FieldCleared(enrollmenttoken.FieldMachineID) {
+		fields = append(fields, enrollmenttoken.FieldMachineID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnrollmentTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnrollmentTokenMutation) ClearField(name string) error {
+	switch name {
+	case enrollmenttoken.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case enrollmenttoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case enrollmenttoken.FieldMachineID:
+		m.ClearMachineID()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnrollmentTokenMutation) ResetField(name string) error {
+	switch name {
+	case enrollmenttoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case enrollmenttoken.FieldMachineName:
+		m.ResetMachineName()
+		return nil
+	case enrollmenttoken.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case enrollmenttoken.FieldRequiredApprovals:
+		m.ResetRequiredApprovals()
+		return nil
+	case enrollmenttoken.FieldRequireConfirmation:
+		m.ResetRequireConfirmation()
+		return nil
+	case enrollmenttoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case enrollmenttoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case enrollmenttoken.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case enrollmenttoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnrollmentTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnrollmentTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnrollmentTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnrollmentTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnrollmentTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnrollmentTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnrollmentTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EnrollmentToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnrollmentTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EnrollmentToken edge %s", name)
+}
+
 // MachineMutation represents an operation that mutates the Machine nodes in the graph.
 type MachineMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	name                  *string
+	description           *string
+	public_key            *[]byte
+	fingerprint           *string
+	created_at            *time.Time
+	required_approvals    *int
+	addrequired_approvals *int
+	share_threshold       *int
+	addshare_threshold    *int
+	share_index           *int
+	addshare_index        *int
+	auto_unlock           *bool
+	labels                *map[string]string
+	hardware              *map[string]string
+	pending_hardware      *map[string]string
+	hardware_binding      *machine.HardwareBinding
+	last_asked_at         *time.Time
+	last_unlocked_at      *time.Time
+	state                 *machine.State
+	expires_at            *time.Time
+	secrets               *[]string
+	appendsecrets         []string
+	pending_confirmation  *bool
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*Machine, error)
+	predicates            []predicate.Machine
+}
+
+var _ ent.Mutation = (*MachineMutation)(nil)
+
+// machineOption allows management of the mutation configuration using functional options.
+type machineOption func(*MachineMutation)
+
+// newMachineMutation creates new mutation for the Machine entity.
+func newMachineMutation(c config, op Op, opts ...machineOption) *MachineMutation {
+	m := &MachineMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMachine,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMachineID sets the ID field of the mutation.
+func withMachineID(id string) machineOption {
+	return func(m *MachineMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Machine
+		)
+		m.oldValue = func(ctx context.Context) (*Machine, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Machine.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMachine sets the old Machine of the mutation.
+func withMachine(node *Machine) machineOption {
+	return func(m *MachineMutation) {
+		m.oldValue = func(context.Context) (*Machine, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MachineMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MachineMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Machine entities.
+func (m *MachineMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MachineMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MachineMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Machine.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MachineMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MachineMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MachineMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *MachineMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MachineMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MachineMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[machine.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MachineMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[machine.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MachineMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, machine.FieldDescription)
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *MachineMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *MachineMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *MachineMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *MachineMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *MachineMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (m *MachineMutation) ClearFingerprint() {
+	m.fingerprint = nil
+	m.clearedFields[machine.FieldFingerprint] = struct{}{}
+}
+
+// FingerprintCleared returns if the "fingerprint" field was cleared in this mutation.
+func (m *MachineMutation) FingerprintCleared() bool {
+	_, ok := m.clearedFields[machine.FieldFingerprint]
+	return ok
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *MachineMutation) ResetFingerprint() {
+	m.fingerprint = nil
+	delete(m.clearedFields, machine.FieldFingerprint)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MachineMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MachineMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MachineMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (m *MachineMutation) SetRequiredApprovals(i int) {
+	m.required_approvals = &i
+	m.addrequired_approvals = nil
+}
+
+// RequiredApprovals returns the value of the "required_approvals" field in the mutation.
+func (m *MachineMutation) RequiredApprovals() (r int, exists bool) {
+	v := m.required_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiredApprovals returns the old "required_approvals" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldRequiredApprovals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiredApprovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiredApprovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiredApprovals: %w", err)
+	}
+	return oldValue.RequiredApprovals, nil
+}
+
+// AddRequiredApprovals adds i to the "required_approvals" field.
+func (m *MachineMutation) AddRequiredApprovals(i int) {
+	if m.addrequired_approvals != nil {
+		*m.addrequired_approvals += i
+	} else {
+		m.addrequired_approvals = &i
+	}
+}
+
+// AddedRequiredApprovals returns the value that was added to the "required_approvals" field in this mutation.
+func (m *MachineMutation) AddedRequiredApprovals() (r int, exists bool) {
+	v := m.addrequired_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequiredApprovals resets all changes to the "required_approvals" field.
+func (m *MachineMutation) ResetRequiredApprovals() {
+	m.required_approvals = nil
+	m.addrequired_approvals = nil
+}
+
+// SetShareThreshold sets the "share_threshold" field.
+func (m *MachineMutation) SetShareThreshold(i int) {
+	m.share_threshold = &i
+	m.addshare_threshold = nil
+}
+
+// ShareThreshold returns the value of the "share_threshold" field in the mutation.
+func (m *MachineMutation) ShareThreshold() (r int, exists bool) {
+	v := m.share_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareThreshold returns the old "share_threshold" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldShareThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareThreshold: %w", err)
+	}
+	return oldValue.ShareThreshold, nil
+}
+
+// AddShareThreshold adds i to the "share_threshold" field.
+func (m *MachineMutation) AddShareThreshold(i int) {
+	if m.addshare_threshold != nil {
+		*m.addshare_threshold += i
+	} else {
+		m.addshare_threshold = &i
+	}
+}
+
+// AddedShareThreshold returns the value that was added to the "share_threshold" field in this mutation.
+func (m *MachineMutation) AddedShareThreshold() (r int, exists bool) {
+	v := m.addshare_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShareThreshold resets all changes to the "share_threshold" field.
+func (m *MachineMutation) ResetShareThreshold() {
+	m.share_threshold = nil
+	m.addshare_threshold = nil
+}
+
+// SetShareIndex sets the "share_index" field.
+func (m *MachineMutation) SetShareIndex(i int) {
+	m.share_index = &i
+	m.addshare_index = nil
+}
+
+// ShareIndex returns the value of the "share_index" field in the mutation.
+func (m *MachineMutation) ShareIndex() (r int, exists bool) {
+	v := m.share_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareIndex returns the old "share_index" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldShareIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareIndex: %w", err)
+	}
+	return oldValue.ShareIndex, nil
+}
+
+// AddShareIndex adds i to the "share_index" field.
+func (m *MachineMutation) AddShareIndex(i int) {
+	if m.addshare_index != nil {
+		*m.addshare_index += i
+	} else {
+		m.addshare_index = &i
+	}
+}
+
+// AddedShareIndex returns the value that was added to the "share_index" field in this mutation.
+func (m *MachineMutation) AddedShareIndex() (r int, exists bool) {
+	v := m.addshare_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShareIndex resets all changes to the "share_index" field.
+func (m *MachineMutation) ResetShareIndex() {
+	m.share_index = nil
+	m.addshare_index = nil
+}
+
+// SetAutoUnlock sets the "auto_unlock" field.
+func (m *MachineMutation) SetAutoUnlock(b bool) {
+	m.auto_unlock = &b
+}
+
+// AutoUnlock returns the value of the "auto_unlock" field in the mutation.
+func (m *MachineMutation) AutoUnlock() (r bool, exists bool) {
+	v := m.auto_unlock
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoUnlock returns the old "auto_unlock" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldAutoUnlock(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoUnlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoUnlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoUnlock: %w", err)
+	}
+	return oldValue.AutoUnlock, nil
+}
+
+// ResetAutoUnlock resets all changes to the "auto_unlock" field.
+func (m *MachineMutation) ResetAutoUnlock() {
+	m.auto_unlock = nil
+}
+
+// SetLabels sets the "labels" field.
+func (m *MachineMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *MachineMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *MachineMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[machine.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *MachineMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[machine.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *MachineMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, machine.FieldLabels)
+}
+
+// SetHardware sets the "hardware" field.
+func (m *MachineMutation) SetHardware(value map[string]string) {
+	m.hardware = &value
+}
+
+// Hardware returns the value of the "hardware" field in the mutation.
+func (m *MachineMutation) Hardware() (r map[string]string, exists bool) {
+	v := m.hardware
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHardware returns the old "hardware" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldHardware(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHardware is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHardware requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHardware: %w", err)
+	}
+	return oldValue.Hardware, nil
+}
+
+// ClearHardware clears the value of the "hardware" field.
+func (m *MachineMutation) ClearHardware() {
+	m.hardware = nil
+	m.clearedFields[machine.FieldHardware] = struct{}{}
+}
+
+// HardwareCleared returns if the "hardware" field was cleared in this mutation.
+func (m *MachineMutation) HardwareCleared() bool {
+	_, ok := m.clearedFields[machine.FieldHardware]
+	return ok
+}
+
+// ResetHardware resets all changes to the "hardware" field.
+func (m *MachineMutation) ResetHardware() {
+	m.hardware = nil
+	delete(m.clearedFields, machine.FieldHardware)
+}
+
+// SetPendingHardware sets the "pending_hardware" field.
+func (m *MachineMutation) SetPendingHardware(value map[string]string) {
+	m.pending_hardware = &value
+}
+
+// PendingHardware returns the value of the "pending_hardware" field in the mutation.
+func (m *MachineMutation) PendingHardware() (r map[string]string, exists bool) {
+	v := m.pending_hardware
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingHardware returns the old "pending_hardware" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldPendingHardware(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingHardware is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingHardware requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingHardware: %w", err)
+	}
+	return oldValue.PendingHardware, nil
+}
+
+// ClearPendingHardware clears the value of the "pending_hardware" field.
+func (m *MachineMutation) ClearPendingHardware() {
+	m.pending_hardware = nil
+	m.clearedFields[machine.FieldPendingHardware] = struct{}{}
+}
+
+// PendingHardwareCleared returns if the "pending_hardware" field was cleared in this mutation.
+func (m *MachineMutation) PendingHardwareCleared() bool {
+	_, ok := m.clearedFields[machine.FieldPendingHardware]
+	return ok
+}
+
+// ResetPendingHardware resets all changes to the "pending_hardware" field.
+func (m *MachineMutation) ResetPendingHardware() {
+	m.pending_hardware = nil
+	delete(m.clearedFields, machine.FieldPendingHardware)
+}
+
+// SetHardwareBinding sets the "hardware_binding" field.
+func (m *MachineMutation) SetHardwareBinding(mb machine.HardwareBinding) {
+	m.hardware_binding = &mb
+}
+
+// HardwareBinding returns the value of the "hardware_binding" field in the mutation.
+func (m *MachineMutation) HardwareBinding() (r machine.HardwareBinding, exists bool) {
+	v := m.hardware_binding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHardwareBinding returns the old "hardware_binding" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldHardwareBinding(ctx context.Context) (v machine.HardwareBinding, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHardwareBinding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHardwareBinding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHardwareBinding: %w", err)
+	}
+	return oldValue.HardwareBinding, nil
+}
+
+// ResetHardwareBinding resets all changes to the "hardware_binding" field.
+func (m *MachineMutation) ResetHardwareBinding() {
+	m.hardware_binding = nil
+}
+
+// SetLastAskedAt sets the "last_asked_at" field.
+func (m *MachineMutation) SetLastAskedAt(t time.Time) {
+	m.last_asked_at = &t
+}
+
+// LastAskedAt returns the value of the "last_asked_at" field in the mutation.
+func (m *MachineMutation) LastAskedAt() (r time.Time, exists bool) {
+	v := m.last_asked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAskedAt returns the old "last_asked_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldLastAskedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAskedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAskedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAskedAt: %w", err)
+	}
+	return oldValue.LastAskedAt, nil
+}
+
+// ClearLastAskedAt clears the value of the "last_asked_at" field.
+func (m *MachineMutation) ClearLastAskedAt() {
+	m.last_asked_at = nil
+	m.clearedFields[machine.FieldLastAskedAt] = struct{}{}
+}
+
+// LastAskedAtCleared returns if the "last_asked_at" field was cleared in this mutation.
+func (m *MachineMutation) LastAskedAtCleared() bool {
+	_, ok := m.clearedFields[machine.FieldLastAskedAt]
+	return ok
+}
+
+// ResetLastAskedAt resets all changes to the "last_asked_at" field.
+func (m *MachineMutation) ResetLastAskedAt() {
+	m.last_asked_at = nil
+	delete(m.clearedFields, machine.FieldLastAskedAt)
+}
+
+// SetLastUnlockedAt sets the "last_unlocked_at" field.
+func (m *MachineMutation) SetLastUnlockedAt(t time.Time) {
+	m.last_unlocked_at = &t
+}
+
+// LastUnlockedAt returns the value of the "last_unlocked_at" field in the mutation.
+func (m *MachineMutation) LastUnlockedAt() (r time.Time, exists bool) {
+	v := m.last_unlocked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUnlockedAt returns the old "last_unlocked_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldLastUnlockedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUnlockedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUnlockedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUnlockedAt: %w", err)
+	}
+	return oldValue.LastUnlockedAt, nil
+}
+
+// ClearLastUnlockedAt clears the value of the "last_unlocked_at" field.
+func (m *MachineMutation) ClearLastUnlockedAt() {
+	m.last_unlocked_at = nil
+	m.clearedFields[machine.FieldLastUnlockedAt] = struct{}{}
+}
+
+// LastUnlockedAtCleared returns if the "last_unlocked_at" field was cleared in this mutation.
+func (m *MachineMutation) LastUnlockedAtCleared() bool {
+	_, ok := m.clearedFields[machine.FieldLastUnlockedAt]
+	return ok
+}
+
+// ResetLastUnlockedAt resets all changes to the "last_unlocked_at" field.
+func (m *MachineMutation) ResetLastUnlockedAt() {
+	m.last_unlocked_at = nil
+	delete(m.clearedFields, machine.FieldLastUnlockedAt)
+}
+
+// SetState sets the "state" field.
+func (m *MachineMutation) SetState(value machine.State) {
+	m.state = &value
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *MachineMutation) State() (r machine.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldState(ctx context.Context) (v machine.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *MachineMutation) ResetState() {
+	m.state = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *MachineMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *MachineMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *MachineMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[machine.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *MachineMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[machine.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *MachineMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, machine.FieldExpiresAt)
+}
+
+// SetSecrets sets the "secrets" field.
+func (m *MachineMutation) SetSecrets(s []string) {
+	m.secrets = &s
+	m.appendsecrets = nil
+}
+
+// Secrets returns the value of the "secrets" field in the mutation.
+func (m *MachineMutation) Secrets() (r []string, exists bool) {
+	v := m.secrets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecrets returns the old "secrets" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldSecrets(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecrets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecrets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecrets: %w", err)
+	}
+	return oldValue.Secrets, nil
+}
+
+// AppendSecrets adds s to the "secrets" field.
+func (m *MachineMutation) AppendSecrets(s []string) {
+	m.appendsecrets = append(m.appendsecrets, s...)
+}
+
+// AppendedSecrets returns the list of values that were appended to the "secrets" field in this mutation.
+func (m *MachineMutation) AppendedSecrets() ([]string, bool) {
+	if len(m.appendsecrets) == 0 {
+		return nil, false
+	}
+	return m.appendsecrets, true
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (m *MachineMutation) ClearSecrets() {
+	m.secrets = nil
+	m.appendsecrets = nil
+	m.clearedFields[machine.FieldSecrets] = struct{}{}
+}
+
+// SecretsCleared returns if the "secrets" field was cleared in this mutation.
+func (m *MachineMutation) SecretsCleared() bool {
+	_, ok := m.clearedFields[machine.FieldSecrets]
+	return ok
+}
+
+// ResetSecrets resets all changes to the "secrets" field.
+func (m *MachineMutation) ResetSecrets() {
+	m.secrets = nil
+	m.appendsecrets = nil
+	delete(m.clearedFields, machine.FieldSecrets)
+}
+
+// SetPendingConfirmation sets the "pending_confirmation" field.
+func (m *MachineMutation) SetPendingConfirmation(b bool) {
+	m.pending_confirmation = &b
+}
+
+// PendingConfirmation returns the value of the "pending_confirmation" field in the mutation.
+func (m *MachineMutation) PendingConfirmation() (r bool, exists bool) {
+	v := m.pending_confirmation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingConfirmation returns the old "pending_confirmation" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldPendingConfirmation(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingConfirmation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingConfirmation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingConfirmation: %w", err)
+	}
+	return oldValue.PendingConfirmation, nil
+}
+
+// ResetPendingConfirmation resets all changes to the "pending_confirmation" field.
+func (m *MachineMutation) ResetPendingConfirmation() {
+	m.pending_confirmation = nil
+}
+
+// Where appends a list predicates to the MachineMutation builder.
+func (m *MachineMutation) Where(ps ...predicate.Machine) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MachineMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MachineMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Machine, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MachineMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MachineMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Machine).
+func (m *MachineMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MachineMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.name != nil {
+		fields = append(fields, machine.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, machine.FieldDescription)
+	}
+	if m.public_key != nil {
+		fields = append(fields, machine.FieldPublicKey)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, machine.FieldFingerprint)
+	}
+	if m.created_at != nil {
+		fields = append(fields, machine.FieldCreatedAt)
+	}
+	if m.required_approvals != nil {
+		fields = append(fields, machine.FieldRequiredApprovals)
+	}
+	if m.share_threshold != nil {
+		fields = append(fields, machine.FieldShareThreshold)
+	}
+	if m.share_index != nil {
+		fields = append(fields, machine.FieldShareIndex)
+	}
+	if m.auto_unlock != nil {
+		fields = append(fields, machine.FieldAutoUnlock)
+	}
+	if m.labels != nil {
+		fields = append(fields, machine.FieldLabels)
+	}
+	if m.hardware != nil {
+		fields = append(fields, machine.FieldHardware)
+	}
+	if m.pending_hardware != nil {
+		fields = append(fields, machine.FieldPendingHardware)
+	}
+	if m.hardware_binding != nil {
+		fields = append(fields, machine.FieldHardwareBinding)
+	}
+	if m.last_asked_at != nil {
+		fields = append(fields, machine.FieldLastAskedAt)
+	}
+	if m.last_unlocked_at != nil {
+		fields = append(fields, machine.FieldLastUnlockedAt)
+	}
+	if m.state != nil {
+		fields = append(fields, machine.FieldState)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, machine.FieldExpiresAt)
+	}
+	if m.secrets != nil {
+		fields = append(fields, machine.FieldSecrets)
+	}
+	if m.pending_confirmation != nil {
+		fields = append(fields, machine.FieldPendingConfirmation)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MachineMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case machine.FieldName:
+		return m.Name()
+	case machine.FieldDescription:
+		return m.Description()
+	case machine.FieldPublicKey:
+		return m.PublicKey()
+	case machine.FieldFingerprint:
+		return m.Fingerprint()
+	case machine.FieldCreatedAt:
+		return m.CreatedAt()
+	case machine.FieldRequiredApprovals:
+		return m.RequiredApprovals()
+	case machine.FieldShareThreshold:
+		return m.ShareThreshold()
+	case machine.FieldShareIndex:
+		return m.ShareIndex()
+	case machine.FieldAutoUnlock:
+		return m.AutoUnlock()
+	case machine.FieldLabels:
+		return m.Labels()
+	case machine.FieldHardware:
+		return m.Hardware()
+	case machine.FieldPendingHardware:
+		return m.PendingHardware()
+	case machine.FieldHardwareBinding:
+		return m.HardwareBinding()
+	case machine.FieldLastAskedAt:
+		return m.LastAskedAt()
+	case machine.FieldLastUnlockedAt:
+		return m.LastUnlockedAt()
+	case machine.FieldState:
+		return m.State()
+	case machine.FieldExpiresAt:
+		return m.ExpiresAt()
+	case machine.FieldSecrets:
+		return m.Secrets()
+	case machine.FieldPendingConfirmation:
+		return m.PendingConfirmation()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MachineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case machine.FieldName:
+		return m.OldName(ctx)
+	case machine.FieldDescription:
+		return m.OldDescription(ctx)
+	case machine.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case machine.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case machine.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case machine.FieldRequiredApprovals:
+		return m.OldRequiredApprovals(ctx)
+	case machine.FieldShareThreshold:
+		return m.OldShareThreshold(ctx)
+	case machine.FieldShareIndex:
+		return m.OldShareIndex(ctx)
+	case machine.FieldAutoUnlock:
+		return m.OldAutoUnlock(ctx)
+	case machine.FieldLabels:
+		return m.OldLabels(ctx)
+	case machine.FieldHardware:
+		return m.OldHardware(ctx)
+	case machine.FieldPendingHardware:
+		return m.OldPendingHardware(ctx)
+	case machine.FieldHardwareBinding:
+		return m.OldHardwareBinding(ctx)
+	case machine.FieldLastAskedAt:
+		return m.OldLastAskedAt(ctx)
+	case machine.FieldLastUnlockedAt:
+		return m.OldLastUnlockedAt(ctx)
+	case machine.FieldState:
+		return m.OldState(ctx)
+	case machine.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case machine.FieldSecrets:
+		return m.OldSecrets(ctx)
+	case machine.FieldPendingConfirmation:
+		return m.OldPendingConfirmation(ctx)
+	}
+	return nil, fmt.Errorf("unknown Machine field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case machine.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case machine.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case machine.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case machine.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case machine.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case machine.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiredApprovals(v)
+		return nil
+	case machine.FieldShareThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareThreshold(v)
+		return nil
+	case machine.FieldShareIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareIndex(v)
+		return nil
+	case machine.FieldAutoUnlock:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoUnlock(v)
+		return nil
+	case machine.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case machine.FieldHardware:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHardware(v)
+		return nil
+	case machine.FieldPendingHardware:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingHardware(v)
+		return nil
+	case machine.FieldHardwareBinding:
+		v, ok := value.(machine.HardwareBinding)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHardwareBinding(v)
+		return nil
+	case machine.FieldLastAskedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAskedAt(v)
+		return nil
+	case machine.FieldLastUnlockedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUnlockedAt(v)
+		return nil
+	case machine.FieldState:
+		v, ok := value.(machine.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case machine.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case machine.FieldSecrets:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecrets(v)
+		return nil
+	case machine.FieldPendingConfirmation:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingConfirmation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Machine field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MachineMutation) AddedFields() []string {
+	var fields []string
+	if m.addrequired_approvals != nil {
+		fields = append(fields, machine.FieldRequiredApprovals)
+	}
+	if m.addshare_threshold != nil {
+		fields = append(fields, machine.FieldShareThreshold)
+	}
+	if m.addshare_index != nil {
+		fields = append(fields, machine.FieldShareIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MachineMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case machine.FieldRequiredApprovals:
+		return m.AddedRequiredApprovals()
+	case machine.FieldShareThreshold:
+		return m.AddedShareThreshold()
+	case machine.FieldShareIndex:
+		return m.AddedShareIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case machine.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequiredApprovals(v)
+		return nil
+	case machine.FieldShareThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShareThreshold(v)
+		return nil
+	case machine.FieldShareIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShareIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Machine numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MachineMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(machine.FieldDescription) {
+		fields = append(fields, machine.FieldDescription)
+	}
+	if m.FieldCleared(machine.FieldFingerprint) {
+		fields = append(fields, machine.FieldFingerprint)
+	}
+	if m.FieldCleared(machine.FieldLabels) {
+		fields = append(fields, machine.FieldLabels)
+	}
+	if m.FieldCleared(machine.FieldHardware) {
+		fields = append(fields, machine.FieldHardware)
+	}
+	if m.FieldCleared(machine.FieldPendingHardware) {
+		fields = append(fields, machine.FieldPendingHardware)
+	}
+	if m.FieldCleared(machine.FieldLastAskedAt) {
+		fields = append(fields, machine.FieldLastAskedAt)
+	}
+	if m.FieldCleared(machine.FieldLastUnlockedAt) {
+		fields = append(fields, machine.FieldLastUnlockedAt)
+	}
+	if m.FieldCleared(machine.FieldExpiresAt) {
+		fields = append(fields, machine.FieldExpiresAt)
+	}
+	if m.FieldCleared(machine.FieldSecrets) {
+		fields = append(fields, machine.FieldSecrets)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MachineMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MachineMutation) ClearField(name string) error {
+	switch name {
+	case machine.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case machine.FieldFingerprint:
+		m.ClearFingerprint()
+		return nil
+	case machine.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case machine.FieldHardware:
+		m.ClearHardware()
+		return nil
+	case machine.FieldPendingHardware:
+		m.ClearPendingHardware()
+		return nil
+	case machine.FieldLastAskedAt:
+		m.ClearLastAskedAt()
+		return nil
+	case machine.FieldLastUnlockedAt:
+		m.ClearLastUnlockedAt()
+		return nil
+	case machine.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case machine.FieldSecrets:
+		m.ClearSecrets()
+		return nil
+	}
+	return fmt.Errorf("unknown Machine nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MachineMutation) ResetField(name string) error {
+	switch name {
+	case machine.FieldName:
+		m.ResetName()
+		return nil
+	case machine.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case machine.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case machine.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case machine.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case machine.FieldRequiredApprovals:
+		m.ResetRequiredApprovals()
+		return nil
+	case machine.FieldShareThreshold:
+		m.ResetShareThreshold()
+		return nil
+	case machine.FieldShareIndex:
+		m.ResetShareIndex()
+		return nil
+	case machine.FieldAutoUnlock:
+		m.ResetAutoUnlock()
+		return nil
+	case machine.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case machine.FieldHardware:
+		m.ResetHardware()
+		return nil
+	case machine.FieldPendingHardware:
+		m.ResetPendingHardware()
+		return nil
+	case machine.FieldHardwareBinding:
+		m.ResetHardwareBinding()
+		return nil
+	case machine.FieldLastAskedAt:
+		m.ResetLastAskedAt()
+		return nil
+	case machine.FieldLastUnlockedAt:
+		m.ResetLastUnlockedAt()
+		return nil
+	case machine.FieldState:
+		m.ResetState()
+		return nil
+	case machine.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case machine.FieldSecrets:
+		m.ResetSecrets()
+		return nil
+	case machine.FieldPendingConfirmation:
+		m.ResetPendingConfirmation()
+		return nil
+	}
+	return fmt.Errorf("unknown Machine field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MachineMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MachineMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MachineMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MachineMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MachineMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MachineMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MachineMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Machine unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MachineMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Machine edge %s", name)
+}
+
+// MachineAliasMutation represents an operation that mutates the MachineAlias nodes in the graph.
+type MachineAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	machine_id    *string
+	public_key    *[]byte
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MachineAlias, error)
+	predicates    []predicate.MachineAlias
+}
+
+var _ ent.Mutation = (*MachineAliasMutation)(nil)
+
+// machinealiasOption allows management of the mutation configuration using functional options.
+type machinealiasOption func(*MachineAliasMutation)
+
+// newMachineAliasMutation creates new mutation for the MachineAlias entity.
+func newMachineAliasMutation(c config, op Op, opts ...machinealiasOption) *MachineAliasMutation {
+	m := &MachineAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMachineAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMachineAliasID sets the ID field of the mutation.
+func withMachineAliasID(id string) machinealiasOption {
+	return func(m *MachineAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MachineAlias
+		)
+		m.oldValue = func(ctx context.Context) (*MachineAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MachineAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMachineAlias sets the old MachineAlias of the mutation.
+func withMachineAlias(node *MachineAlias) machinealiasOption {
+	return func(m *MachineAliasMutation) {
+		m.oldValue = func(context.Context) (*MachineAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MachineAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MachineAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MachineAlias entities.
+func (m *MachineAliasMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MachineAliasMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MachineAliasMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MachineAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *MachineAliasMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *MachineAliasMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the MachineAlias entity.
+// If the MachineAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineAliasMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *MachineAliasMutation) ResetMachineID() {
+	m.machine_id = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *MachineAliasMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *MachineAliasMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the MachineAlias entity.
+// If the MachineAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineAliasMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *MachineAliasMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *MachineAliasMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *MachineAliasMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the MachineAlias entity.
+// If the MachineAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineAliasMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *MachineAliasMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MachineAliasMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MachineAliasMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MachineAlias entity.
+// If the MachineAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineAliasMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MachineAliasMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the MachineAliasMutation builder.
+func (m *MachineAliasMutation) Where(ps ...predicate.MachineAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MachineAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MachineAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MachineAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MachineAliasMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MachineAliasMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MachineAlias).
+func (m *MachineAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MachineAliasMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.machine_id != nil {
+		fields = append(fields, machinealias.FieldMachineID)
+	}
+	if m.public_key != nil {
+		fields = append(fields, machinealias.FieldPublicKey)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, machinealias.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, machinealias.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MachineAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case machinealias.FieldMachineID:
+		return m.MachineID()
+	case machinealias.FieldPublicKey:
+		return m.PublicKey()
+	case machinealias.FieldExpiresAt:
+		return m.ExpiresAt()
+	case machinealias.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MachineAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case machinealias.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case machinealias.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case machinealias.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case machinealias.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MachineAlias field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case machinealias.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case machinealias.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case machinealias.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case machinealias.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MachineAlias field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MachineAliasMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MachineAliasMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MachineAlias numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MachineAliasMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MachineAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MachineAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MachineAlias nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MachineAliasMutation) ResetField(name string) error {
+	switch name {
+	case machinealias.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case machinealias.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case machinealias.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case machinealias.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MachineAlias field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MachineAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MachineAliasMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MachineAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MachineAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MachineAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MachineAliasMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MachineAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MachineAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MachineAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MachineAlias edge %s", name)
+}
+
+// MachineGroupMutation represents an operation that mutates the MachineGroup nodes in the graph.
+type MachineGroupMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	description       *string
+	machine_ids       *[]string
+	appendmachine_ids []string
+	selector          *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*MachineGroup, error)
+	predicates        []predicate.MachineGroup
+}
+
+var _ ent.Mutation = (*MachineGroupMutation)(nil)
+
+// machinegroupOption allows management of the mutation configuration using functional options.
+type machinegroupOption func(*MachineGroupMutation)
+
+// newMachineGroupMutation creates new mutation for the MachineGroup entity.
+func newMachineGroupMutation(c config, op Op, opts ...machinegroupOption) *MachineGroupMutation {
+	m := &MachineGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMachineGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMachineGroupID sets the ID field of the mutation.
+func withMachineGroupID(id int) machinegroupOption {
+	return func(m *MachineGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MachineGroup
+		)
+		m.oldValue = func(ctx context.Context) (*MachineGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MachineGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMachineGroup sets the old MachineGroup of the mutation.
+func withMachineGroup(node *MachineGroup) machinegroupOption {
+	return func(m *MachineGroupMutation) {
+		m.oldValue = func(context.Context) (*MachineGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MachineGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MachineGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MachineGroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MachineGroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MachineGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MachineGroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MachineGroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MachineGroup entity.
+// If the MachineGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineGroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MachineGroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *MachineGroupMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MachineGroupMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the MachineGroup entity.
+// If the MachineGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MachineGroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[machinegroup.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MachineGroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[machinegroup.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MachineGroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, machinegroup.FieldDescription)
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (m *MachineGroupMutation) SetMachineIds(s []string) {
+	m.machine_ids = &s
+	m.appendmachine_ids = nil
+}
+
+// MachineIds returns the value of the "machine_ids" field in the mutation.
+func (m *MachineGroupMutation) MachineIds() (r []string, exists bool) {
+	v := m.machine_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineIds returns the old "machine_ids" field's value of the MachineGroup entity.
+// If the MachineGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineGroupMutation) OldMachineIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineIds: %w", err)
+	}
+	return oldValue.MachineIds, nil
+}
+
+// AppendMachineIds adds s to the "machine_ids" field.
+func (m *MachineGroupMutation) AppendMachineIds(s []string) {
+	m.appendmachine_ids = append(m.appendmachine_ids, s...)
+}
+
+// AppendedMachineIds returns the list of values that were appended to the "machine_ids" field in this mutation.
+func (m *MachineGroupMutation) AppendedMachineIds() ([]string, bool) {
+	if len(m.appendmachine_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmachine_ids, true
+}
+
+// ClearMachineIds clears the value of the "machine_ids" field.
+func (m *MachineGroupMutation) ClearMachineIds() {
+	m.machine_ids = nil
+	m.appendmachine_ids = nil
+	m.clearedFields[machinegroup.FieldMachineIds] = struct{}{}
+}
+
+// MachineIdsCleared returns if the "machine_ids" field was cleared in this mutation.
+func (m *MachineGroupMutation) MachineIdsCleared() bool {
+	_, ok := m.clearedFields[machinegroup.FieldMachineIds]
+	return ok
+}
+
+// ResetMachineIds resets all changes to the "machine_ids" field.
+func (m *MachineGroupMutation) ResetMachineIds() {
+	m.machine_ids = nil
+	m.appendmachine_ids = nil
+	delete(m.clearedFields, machinegroup.FieldMachineIds)
+}
+
+// SetSelector sets the "selector" field.
+func (m *MachineGroupMutation) SetSelector(s string) {
+	m.selector = &s
+}
+
+// Selector returns the value of the "selector" field in the mutation.
+func (m *MachineGroupMutation) Selector() (r string, exists bool) {
+	v := m.selector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelector returns the old "selector" field's value of the MachineGroup entity.
+// If the MachineGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineGroupMutation) OldSelector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelector: %w", err)
+	}
+	return oldValue.Selector, nil
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (m *MachineGroupMutation) ClearSelector() {
+	m.selector = nil
+	m.clearedFields[machinegroup.FieldSelector] = struct{}{}
+}
+
+// SelectorCleared returns if the "selector" field was cleared in this mutation.
+func (m *MachineGroupMutation) SelectorCleared() bool {
+	_, ok := m.clearedFields[machinegroup.FieldSelector]
+	return ok
+}
+
+// ResetSelector resets all changes to the "selector" field.
+func (m *MachineGroupMutation) ResetSelector() {
+	m.selector = nil
+	delete(m.clearedFields, machinegroup.FieldSelector)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MachineGroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MachineGroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MachineGroup entity.
+// If the MachineGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineGroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MachineGroupMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the MachineGroupMutation builder.
+func (m *MachineGroupMutation) Where(ps ...predicate.MachineGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MachineGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MachineGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MachineGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MachineGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MachineGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MachineGroup).
+func (m *MachineGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MachineGroupMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, machinegroup.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, machinegroup.FieldDescription)
+	}
+	if m.machine_ids != nil {
+		fields = append(fields, machinegroup.FieldMachineIds)
+	}
+	if m.selector != nil {
+		fields = append(fields, machinegroup.FieldSelector)
+	}
+	if m.created_at != nil {
+		fields = append(fields, machinegroup.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MachineGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case machinegroup.FieldName:
+		return m.Name()
+	case machinegroup.FieldDescription:
+		return m.Description()
+	case machinegroup.FieldMachineIds:
+		return m.MachineIds()
+	case machinegroup.FieldSelector:
+		return m.Selector()
+	case machinegroup.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MachineGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case machinegroup.FieldName:
+		return m.OldName(ctx)
+	case machinegroup.FieldDescription:
+		return m.OldDescription(ctx)
+	case machinegroup.FieldMachineIds:
+		return m.OldMachineIds(ctx)
+	case machinegroup.FieldSelector:
+		return m.OldSelector(ctx)
+	case machinegroup.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MachineGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case machinegroup.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case machinegroup.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case machinegroup.FieldMachineIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineIds(v)
+		return nil
+	case machinegroup.FieldSelector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelector(v)
+		return nil
+	case machinegroup.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MachineGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MachineGroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MachineGroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MachineGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MachineGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(machinegroup.FieldDescription) {
+		fields = append(fields, machinegroup.FieldDescription)
+	}
+	if m.FieldCleared(machinegroup.FieldMachineIds) {
+		fields = append(fields, machinegroup.FieldMachineIds)
+	}
+	if m.FieldCleared(machinegroup.FieldSelector) {
+		fields = append(fields, machinegroup.FieldSelector)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MachineGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MachineGroupMutation) ClearField(name string) error {
+	switch name {
+	case machinegroup.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case machinegroup.FieldMachineIds:
+		m.ClearMachineIds()
+		return nil
+	case machinegroup.FieldSelector:
+		m.ClearSelector()
+		return nil
+	}
+	return fmt.Errorf("unknown MachineGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MachineGroupMutation) ResetField(name string) error {
+	switch name {
+	case machinegroup.FieldName:
+		m.ResetName()
+		return nil
+	case machinegroup.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case machinegroup.FieldMachineIds:
+		m.ResetMachineIds()
+		return nil
+	case machinegroup.FieldSelector:
+		m.ResetSelector()
+		return nil
+	case machinegroup.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MachineGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MachineGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MachineGroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MachineGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MachineGroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MachineGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MachineGroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MachineGroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MachineGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MachineGroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MachineGroup edge %s", name)
+}
+
+// MaintenanceWindowMutation represents an operation that mutates the MaintenanceWindow nodes in the graph.
+type MaintenanceWindowMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	schedule          *string
+	duration          *int64
+	addduration       *int64
+	machine_ids       *[]string
+	appendmachine_ids []string
+	selector          *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*MaintenanceWindow, error)
+	predicates        []predicate.MaintenanceWindow
+}
+
+var _ ent.Mutation = (*MaintenanceWindowMutation)(nil)
+
+// maintenancewindowOption allows management of the mutation configuration using functional options.
+type maintenancewindowOption func(*MaintenanceWindowMutation)
+
+// newMaintenanceWindowMutation creates new mutation for the MaintenanceWindow entity.
+func newMaintenanceWindowMutation(c config, op Op, opts ...maintenancewindowOption) *MaintenanceWindowMutation {
+	m := &MaintenanceWindowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceWindow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceWindowID sets the ID field of the mutation.
+func withMaintenanceWindowID(id int) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceWindow
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceWindow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceWindow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceWindow sets the old MaintenanceWindow of the mutation.
+func withMaintenanceWindow(node *MaintenanceWindow) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceWindow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceWindowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceWindowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceWindowMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceWindowMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceWindow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MaintenanceWindowMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MaintenanceWindowMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MaintenanceWindowMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSchedule sets the "schedule" field.
+func (m *MaintenanceWindowMutation) SetSchedule(s string) {
+	m.schedule = &s
+}
+
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *MaintenanceWindowMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchedule returns the old "schedule" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldSchedule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	}
+	return oldValue.Schedule, nil
+}
+
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *MaintenanceWindowMutation) ResetSchedule() {
+	m.schedule = nil
+}
+
+// SetDuration sets the "duration" field.
+func (m *MaintenanceWindowMutation) SetDuration(i int64) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *MaintenanceWindowMutation) Duration() (r int64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldDuration(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *MaintenanceWindowMutation) AddDuration(i int64) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *MaintenanceWindowMutation) AddedDuration() (r int64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *MaintenanceWindowMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (m *MaintenanceWindowMutation) SetMachineIds(s []string) {
+	m.machine_ids = &s
+	m.appendmachine_ids = nil
+}
+
+// MachineIds returns the value of the "machine_ids" field in the mutation.
+func (m *MaintenanceWindowMutation) MachineIds() (r []string, exists bool) {
+	v := m.machine_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineIds returns the old "machine_ids" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldMachineIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineIds: %w", err)
+	}
+	return oldValue.MachineIds, nil
+}
+
+// AppendMachineIds adds s to the "machine_ids" field.
+func (m *MaintenanceWindowMutation) AppendMachineIds(s []string) {
+	m.appendmachine_ids = append(m.appendmachine_ids, s...)
+}
+
+// AppendedMachineIds returns the list of values that were appended to the "machine_ids" field in this mutation.
+func (m *MaintenanceWindowMutation) AppendedMachineIds() ([]string, bool) {
+	if len(m.appendmachine_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmachine_ids, true
+}
+
+// ClearMachineIds clears the value of the "machine_ids" field.
+func (m *MaintenanceWindowMutation) ClearMachineIds() {
+	m.machine_ids = nil
+	m.appendmachine_ids = nil
+	m.clearedFields[maintenancewindow.FieldMachineIds] = struct{}{}
+}
+
+// MachineIdsCleared returns if the "machine_ids" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) MachineIdsCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldMachineIds]
+	return ok
+}
+
+// ResetMachineIds resets all changes to the "machine_ids" field.
+func (m *MaintenanceWindowMutation) ResetMachineIds() {
+	m.machine_ids = nil
+	m.appendmachine_ids = nil
+	delete(m.clearedFields, maintenancewindow.FieldMachineIds)
+}
+
+// SetSelector sets the "selector" field.
+func (m *MaintenanceWindowMutation) SetSelector(s string) {
+	m.selector = &s
+}
+
+// Selector returns the value of the "selector" field in the mutation.
+func (m *MaintenanceWindowMutation) Selector() (r string, exists bool) {
+	v := m.selector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelector returns the old "selector" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldSelector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelector: %w", err)
+	}
+	return oldValue.Selector, nil
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (m *MaintenanceWindowMutation) ClearSelector() {
+	m.selector = nil
+	m.clearedFields[maintenancewindow.FieldSelector] = struct{}{}
+}
+
+// SelectorCleared returns if the "selector" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) SelectorCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldSelector]
+	return ok
+}
+
+// ResetSelector resets all changes to the "selector" field.
+func (m *MaintenanceWindowMutation) ResetSelector() {
+	m.selector = nil
+	delete(m.clearedFields, maintenancewindow.FieldSelector)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceWindowMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceWindowMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceWindowMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the MaintenanceWindowMutation builder.
+func (m *MaintenanceWindowMutation) Where(ps ...predicate.MaintenanceWindow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceWindowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceWindowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceWindow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceWindowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceWindowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceWindow).
+func (m *MaintenanceWindowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceWindowMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, maintenancewindow.FieldName)
+	}
+	if m.schedule != nil {
+		fields = append(fields, maintenancewindow.FieldSchedule)
+	}
+	if m.duration != nil {
+		fields = append(fields, maintenancewindow.FieldDuration)
+	}
+	if m.machine_ids != nil {
+		fields = append(fields, maintenancewindow.FieldMachineIds)
+	}
+	if m.selector != nil {
+		fields = append(fields, maintenancewindow.FieldSelector)
+	}
+	if m.created_at != nil {
+		fields = append(fields, maintenancewindow.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceWindowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldName:
+		return m.Name()
+	case maintenancewindow.FieldSchedule:
+		return m.Schedule()
+	case maintenancewindow.FieldDuration:
+		return m.Duration()
+	case maintenancewindow.FieldMachineIds:
+		return m.MachineIds()
+	case maintenancewindow.FieldSelector:
+		return m.Selector()
+	case maintenancewindow.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceWindowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenancewindow.FieldName:
+		return m.OldName(ctx)
+	case maintenancewindow.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case maintenancewindow.FieldDuration:
+		return m.OldDuration(ctx)
+	case maintenancewindow.FieldMachineIds:
+		return m.OldMachineIds(ctx)
+	case maintenancewindow.FieldSelector:
+		return m.OldSelector(ctx)
+	case maintenancewindow.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case maintenancewindow.FieldSchedule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedule(v)
+		return nil
+	case maintenancewindow.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case maintenancewindow.FieldMachineIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineIds(v)
+		return nil
+	case maintenancewindow.FieldSelector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelector(v)
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceWindowMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration != nil {
+		fields = append(fields, maintenancewindow.FieldDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceWindowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldDuration:
+		return m.AddedDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceWindowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenancewindow.FieldMachineIds) {
+		fields = append(fields, maintenancewindow.FieldMachineIds)
+	}
+	if m.FieldCleared(maintenancewindow.FieldSelector) {
+		fields = append(fields, maintenancewindow.FieldSelector)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceWindowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearField(name string) error {
+	switch name {
+	case maintenancewindow.FieldMachineIds:
+		m.ClearMachineIds()
+		return nil
+	case maintenancewindow.FieldSelector:
+		m.ClearSelector()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetField(name string) error {
+	switch name {
+	case maintenancewindow.FieldName:
+		m.ResetName()
+		return nil
+	case maintenancewindow.FieldSchedule:
+		m.ResetSchedule()
+		return nil
+	case maintenancewindow.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case maintenancewindow.FieldMachineIds:
+		m.ResetMachineIds()
+		return nil
+	case maintenancewindow.FieldSelector:
+		m.ResetSelector()
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceWindowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceWindowMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceWindowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceWindowMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceWindowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceWindowMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MaintenanceWindow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MaintenanceWindow edge %s", name)
+}
+
+// OperatorMutation represents an operation that mutates the Operator nodes in the graph.
+type OperatorMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	public_key    *[]byte
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Operator, error)
+	predicates    []predicate.Operator
+}
+
+var _ ent.Mutation = (*OperatorMutation)(nil)
+
+// operatorOption allows management of the mutation configuration using functional options.
+type operatorOption func(*OperatorMutation)
+
+// newOperatorMutation creates new mutation for the Operator entity.
+func newOperatorMutation(c config, op Op, opts ...operatorOption) *OperatorMutation {
+	m := &OperatorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOperator,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOperatorID sets the ID field of the mutation.
+func withOperatorID(id string) operatorOption {
+	return func(m *OperatorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Operator
+		)
+		m.oldValue = func(ctx context.Context) (*Operator, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Operator.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOperator sets the old Operator of the mutation.
+func withOperator(node *Operator) operatorOption {
+	return func(m *OperatorMutation) {
+		m.oldValue = func(context.Context) (*Operator, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OperatorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OperatorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Operator entities.
+func (m *OperatorMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OperatorMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OperatorMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Operator.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *OperatorMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OperatorMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Operator entity.
+// If the Operator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OperatorMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *OperatorMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *OperatorMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the Operator entity.
+// If the Operator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *OperatorMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OperatorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OperatorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Operator entity.
+// If the Operator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OperatorMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OperatorMutation builder.
+func (m *OperatorMutation) Where(ps ...predicate.Operator) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OperatorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OperatorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Operator, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OperatorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OperatorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Operator).
+func (m *OperatorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OperatorMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, operator.FieldName)
+	}
+	if m.public_key != nil {
+		fields = append(fields, operator.FieldPublicKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, operator.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OperatorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case operator.FieldName:
+		return m.Name()
+	case operator.FieldPublicKey:
+		return m.PublicKey()
+	case operator.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OperatorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case operator.FieldName:
+		return m.OldName(ctx)
+	case operator.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case operator.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Operator field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperatorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case operator.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case operator.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case operator.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Operator field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OperatorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OperatorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperatorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Operator numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OperatorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OperatorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OperatorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Operator nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OperatorMutation) ResetField(name string) error {
+	switch name {
+	case operator.FieldName:
+		m.ResetName()
+		return nil
+	case operator.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case operator.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Operator field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OperatorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OperatorMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OperatorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OperatorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OperatorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OperatorMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OperatorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Operator unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OperatorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Operator edge %s", name)
+}
+
+// StoredKeyMutation represents an operation that mutates the StoredKey nodes in the graph.
+type StoredKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	machine_id    *string
+	secret        *string
+	ciphertext    *[]byte
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*StoredKey, error)
+	predicates    []predicate.StoredKey
+}
+
+var _ ent.Mutation = (*StoredKeyMutation)(nil)
+
+// storedkeyOption allows management of the mutation configuration using functional options.
+type storedkeyOption func(*StoredKeyMutation)
+
+// newStoredKeyMutation creates new mutation for the StoredKey entity.
+func newStoredKeyMutation(c config, op Op, opts ...storedkeyOption) *StoredKeyMutation {
+	m := &StoredKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStoredKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStoredKeyID sets the ID field of the mutation.
+func withStoredKeyID(id int) storedkeyOption {
+	return func(m *StoredKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StoredKey
+		)
+		m.oldValue = func(ctx context.Context) (*StoredKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StoredKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStoredKey sets the old StoredKey of the mutation.
+func withStoredKey(node *StoredKey) storedkeyOption {
+	return func(m *StoredKeyMutation) {
+		m.oldValue = func(context.Context) (*StoredKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StoredKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StoredKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StoredKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StoredKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StoredKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *StoredKeyMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *StoredKeyMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the StoredKey entity.
+// If the StoredKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoredKeyMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *StoredKeyMutation) ResetMachineID() {
+	m.machine_id = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *StoredKeyMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *StoredKeyMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the StoredKey entity.
+// If the StoredKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoredKeyMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *StoredKeyMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (m *StoredKeyMutation) SetCiphertext(b []byte) {
+	m.ciphertext = &b
+}
+
+// Ciphertext returns the value of the "ciphertext" field in the mutation.
+func (m *StoredKeyMutation) Ciphertext() (r []byte, exists bool) {
+	v := m.ciphertext
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiphertext returns the old "ciphertext" field's value of the StoredKey entity.
+// If the StoredKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoredKeyMutation) OldCiphertext(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiphertext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiphertext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiphertext: %w", err)
+	}
+	return oldValue.Ciphertext, nil
+}
+
+// ResetCiphertext resets all changes to the "ciphertext" field.
+func (m *StoredKeyMutation) ResetCiphertext() {
+	m.ciphertext = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StoredKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StoredKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StoredKey entity.
+// If the StoredKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoredKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StoredKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StoredKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StoredKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the StoredKey entity.
+// If the StoredKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoredKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StoredKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the StoredKeyMutation builder.
+func (m *StoredKeyMutation) Where(ps ...predicate.StoredKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StoredKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StoredKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StoredKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StoredKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StoredKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StoredKey).
+func (m *StoredKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StoredKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.machine_id != nil {
+		fields = append(fields, storedkey.FieldMachineID)
+	}
+	if m.secret != nil {
+		fields = append(fields, storedkey.FieldSecret)
+	}
+	if m.ciphertext != nil {
+		fields = append(fields, storedkey.FieldCiphertext)
+	}
+	if m.created_at != nil {
+		fields = append(fields, storedkey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, storedkey.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StoredKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case storedkey.FieldMachineID:
+		return m.MachineID()
+	case storedkey.FieldSecret:
+		return m.Secret()
+	case storedkey.FieldCiphertext:
+		return m.Ciphertext()
+	case storedkey.FieldCreatedAt:
+		return m.CreatedAt()
+	case storedkey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StoredKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case storedkey.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case storedkey.FieldSecret:
+		return m.OldSecret(ctx)
+	case storedkey.FieldCiphertext:
+		return m.OldCiphertext(ctx)
+	case storedkey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case storedkey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StoredKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StoredKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case storedkey.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case storedkey.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case storedkey.FieldCiphertext:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiphertext(v)
+		return nil
+	case storedkey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case storedkey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StoredKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StoredKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StoredKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StoredKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown StoredKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StoredKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StoredKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StoredKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StoredKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StoredKeyMutation) ResetField(name string) error {
+	switch name {
+	case storedkey.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case storedkey.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case storedkey.FieldCiphertext:
+		m.ResetCiphertext()
+		return nil
+	case storedkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case storedkey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StoredKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StoredKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StoredKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StoredKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StoredKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StoredKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StoredKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StoredKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StoredKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StoredKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StoredKey edge %s", name)
+}
+
+// TangKeyMutation represents an operation that mutates the TangKey nodes in the graph.
+type TangKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	use           *tangkey.Use
+	machine_id    *string
+	private_key   *[]byte
+	advertised    *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TangKey, error)
+	predicates    []predicate.TangKey
+}
+
+var _ ent.Mutation = (*TangKeyMutation)(nil)
+
+// tangkeyOption allows management of the mutation configuration using functional options.
+type tangkeyOption func(*TangKeyMutation)
+
+// newTangKeyMutation creates new mutation for the TangKey entity.
+func newTangKeyMutation(c config, op Op, opts ...tangkeyOption) *TangKeyMutation {
+	m := &TangKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTangKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTangKeyID sets the ID field of the mutation.
+func withTangKeyID(id int) tangkeyOption {
+	return func(m *TangKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TangKey
+		)
+		m.oldValue = func(ctx context.Context) (*TangKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TangKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTangKey sets the old TangKey of the mutation.
+func withTangKey(node *TangKey) tangkeyOption {
+	return func(m *TangKeyMutation) {
+		m.oldValue = func(context.Context) (*TangKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TangKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TangKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TangKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TangKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TangKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUse sets the "use" field.
+func (m *TangKeyMutation) SetUse(t tangkey.Use) {
+	m.use = &t
+}
+
+// Use returns the value of the "use" field in the mutation.
+func (m *TangKeyMutation) Use() (r tangkey.Use, exists bool) {
+	v := m.use
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUse returns the old "use" field's value of the TangKey entity.
+// If the TangKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TangKeyMutation) OldUse(ctx context.Context) (v tangkey.Use, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUse: %w", err)
+	}
+	return oldValue.Use, nil
+}
+
+// ResetUse resets all changes to the "use" field.
+func (m *TangKeyMutation) ResetUse() {
+	m.use = nil
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *TangKeyMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *TangKeyMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the TangKey entity.
+// If the TangKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TangKeyMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (m *TangKeyMutation) ClearMachineID() {
+	m.machine_id = nil
+	m.clearedFields[tangkey.FieldMachineID] = struct{}{}
+}
+
+// MachineIDCleared returns if the "machine_id" field was cleared in this mutation.
+func (m *TangKeyMutation) MachineIDCleared() bool {
+	_, ok := m.clearedFields[tangkey.FieldMachineID]
+	return ok
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *TangKeyMutation) ResetMachineID() {
+	m.machine_id = nil
+	delete(m.clearedFields, tangkey.FieldMachineID)
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *TangKeyMutation) SetPrivateKey(b []byte) {
+	m.private_key = &b
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *TangKeyMutation) PrivateKey() (r []byte, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the TangKey entity.
+// If the TangKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TangKeyMutation) OldPrivateKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *TangKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// SetAdvertised sets the "advertised" field.
+func (m *TangKeyMutation) SetAdvertised(b bool) {
+	m.advertised = &b
+}
+
+// Advertised returns the value of the "advertised" field in the mutation.
+func (m *TangKeyMutation) Advertised() (r bool, exists bool) {
+	v := m.advertised
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertised returns the old "advertised" field's value of the TangKey entity.
+// If the TangKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TangKeyMutation) OldAdvertised(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertised is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertised requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertised: %w", err)
+	}
+	return oldValue.Advertised, nil
+}
+
+// ResetAdvertised resets all changes to the "advertised" field.
+func (m *TangKeyMutation) ResetAdvertised() {
+	m.advertised = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TangKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TangKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TangKey entity.
+// If the TangKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TangKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TangKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TangKeyMutation builder.
+func (m *TangKeyMutation) Where(ps ...predicate.TangKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TangKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TangKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TangKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TangKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TangKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TangKey).
+func (m *TangKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TangKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.use != nil {
+		fields = append(fields, tangkey.FieldUse)
+	}
+	if m.machine_id != nil {
+		fields = append(fields, tangkey.FieldMachineID)
+	}
+	if m.private_key != nil {
+		fields = append(fields, tangkey.FieldPrivateKey)
+	}
+	if m.advertised != nil {
+		fields = append(fields, tangkey.FieldAdvertised)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tangkey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TangKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tangkey.FieldUse:
+		return m.Use()
+	case tangkey.FieldMachineID:
+		return m.MachineID()
+	case tangkey.FieldPrivateKey:
+		return m.PrivateKey()
+	case tangkey.FieldAdvertised:
+		return m.Advertised()
+	case tangkey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TangKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tangkey.FieldUse:
+		return m.OldUse(ctx)
+	case tangkey.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case tangkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case tangkey.FieldAdvertised:
+		return m.OldAdvertised(ctx)
+	case tangkey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TangKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TangKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tangkey.FieldUse:
+		v, ok := value.(tangkey.Use)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUse(v)
+		return nil
+	case tangkey.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case tangkey.FieldPrivateKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case tangkey.FieldAdvertised:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertised(v)
+		return nil
+	case tangkey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TangKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TangKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TangKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TangKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TangKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TangKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tangkey.FieldMachineID) {
+		fields = append(fields, tangkey.FieldMachineID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TangKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TangKeyMutation) ClearField(name string) error {
+	switch name {
+	case tangkey.FieldMachineID:
+		m.ClearMachineID()
+		return nil
+	}
+	return fmt.Errorf("unknown TangKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TangKeyMutation) ResetField(name string) error {
+	switch name {
+	case tangkey.FieldUse:
+		m.ResetUse()
+		return nil
+	case tangkey.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case tangkey.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case tangkey.FieldAdvertised:
+		m.ResetAdvertised()
+		return nil
+	case tangkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TangKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TangKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TangKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TangKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TangKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TangKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TangKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TangKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TangKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TangKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TangKey edge %s", name)
+}
+
+// UnlockPolicyMutation represents an operation that mutates the UnlockPolicy nodes in the graph.
+type UnlockPolicyMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	name                  *string
+	expression            *string
+	action                *unlockpolicy.Action
+	required_approvals    *int
+	addrequired_approvals *int
+	priority              *int
+	addpriority           *int
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*UnlockPolicy, error)
+	predicates            []predicate.UnlockPolicy
+}
+
+var _ ent.Mutation = (*UnlockPolicyMutation)(nil)
+
+// unlockpolicyOption allows management of the mutation configuration using functional options.
+type unlockpolicyOption func(*UnlockPolicyMutation)
+
+// newUnlockPolicyMutation creates new mutation for the UnlockPolicy entity.
+func newUnlockPolicyMutation(c config, op Op, opts ...unlockpolicyOption) *UnlockPolicyMutation {
+	m := &UnlockPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUnlockPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUnlockPolicyID sets the ID field of the mutation.
+func withUnlockPolicyID(id int) unlockpolicyOption {
+	return func(m *UnlockPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UnlockPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*UnlockPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UnlockPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUnlockPolicy sets the old UnlockPolicy of the mutation.
+func withUnlockPolicy(node *UnlockPolicy) unlockpolicyOption {
+	return func(m *UnlockPolicyMutation) {
+		m.oldValue = func(context.Context) (*UnlockPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UnlockPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UnlockPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UnlockPolicyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UnlockPolicyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UnlockPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *UnlockPolicyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UnlockPolicyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the UnlockPolicy entity.
+// If the UnlockPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnlockPolicyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UnlockPolicyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetExpression sets the "expression" field.
+func (m *UnlockPolicyMutation) SetExpression(s string) {
+	m.expression = &s
+}
+
+// Expression returns the value of the "expression" field in the mutation.
+func (m *UnlockPolicyMutation) Expression() (r string, exists bool) {
+	v := m.expression
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpression returns the old "expression" field's value of the UnlockPolicy entity.
+// If the UnlockPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnlockPolicyMutation) OldExpression(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpression is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpression requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpression: %w", err)
+	}
+	return oldValue.Expression, nil
+}
+
+// ResetExpression resets all changes to the "expression" field.
+func (m *UnlockPolicyMutation) ResetExpression() {
+	m.expression = nil
+}
+
+// SetAction sets the "action" field.
+func (m *UnlockPolicyMutation) SetAction(u unlockpolicy.Action) {
+	m.action = &u
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *UnlockPolicyMutation) Action() (r unlockpolicy.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the UnlockPolicy entity.
+// If the UnlockPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnlockPolicyMutation) OldAction(ctx context.Context) (v unlockpolicy.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *UnlockPolicyMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (m *UnlockPolicyMutation) SetRequiredApprovals(i int) {
+	m.required_approvals = &i
+	m.addrequired_approvals = nil
+}
+
+// RequiredApprovals returns the value of the "required_approvals" field in the mutation.
+func (m *UnlockPolicyMutation) RequiredApprovals() (r int, exists bool) {
+	v := m.required_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiredApprovals returns the old "required_approvals" field's value of the UnlockPolicy entity.
+// If the UnlockPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnlockPolicyMutation) OldRequiredApprovals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiredApprovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiredApprovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiredApprovals: %w", err)
+	}
+	return oldValue.RequiredApprovals, nil
+}
+
+// AddRequiredApprovals adds i to the "required_approvals" field.
+func (m *UnlockPolicyMutation) AddRequiredApprovals(i int) {
+	if m.addrequired_approvals != nil {
+		*m.addrequired_approvals += i
+	} else {
+		m.addrequired_approvals = &i
+	}
+}
+
+// AddedRequiredApprovals returns the value that was added to the "required_approvals" field in this mutation.
+func (m *UnlockPolicyMutation) AddedRequiredApprovals() (r int, exists bool) {
+	v := m.addrequired_approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequiredApprovals resets all changes to the "required_approvals" field.
+func (m *UnlockPolicyMutation) ResetRequiredApprovals() {
+	m.required_approvals = nil
+	m.addrequired_approvals = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *UnlockPolicyMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *UnlockPolicyMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the UnlockPolicy entity.
+// If the UnlockPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnlockPolicyMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *UnlockPolicyMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *UnlockPolicyMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *UnlockPolicyMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UnlockPolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UnlockPolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UnlockPolicy entity.
+// If the UnlockPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnlockPolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UnlockPolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the UnlockPolicyMutation builder.
+func (m *UnlockPolicyMutation) Where(ps ...predicate.UnlockPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UnlockPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UnlockPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UnlockPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UnlockPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UnlockPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UnlockPolicy).
+func (m *UnlockPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UnlockPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, unlockpolicy.FieldName)
+	}
+	if m.expression != nil {
+		fields = append(fields, unlockpolicy.FieldExpression)
+	}
+	if m.action != nil {
+		fields = append(fields, unlockpolicy.FieldAction)
+	}
+	if m.required_approvals != nil {
+		fields = append(fields, unlockpolicy.FieldRequiredApprovals)
+	}
+	if m.priority != nil {
+		fields = append(fields, unlockpolicy.FieldPriority)
+	}
+	if m.created_at != nil {
+		fields = append(fields, unlockpolicy.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UnlockPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case unlockpolicy.FieldName:
+		return m.Name()
+	case unlockpolicy.FieldExpression:
+		return m.Expression()
+	case unlockpolicy.FieldAction:
+		return m.Action()
+	case unlockpolicy.FieldRequiredApprovals:
+		return m.RequiredApprovals()
+	case unlockpolicy.FieldPriority:
+		return m.Priority()
+	case unlockpolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UnlockPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case unlockpolicy.FieldName:
+		return m.OldName(ctx)
+	case unlockpolicy.FieldExpression:
+		return m.OldExpression(ctx)
+	case unlockpolicy.FieldAction:
+		return m.OldAction(ctx)
+	case unlockpolicy.FieldRequiredApprovals:
+		return m.OldRequiredApprovals(ctx)
+	case unlockpolicy.FieldPriority:
+		return m.OldPriority(ctx)
+	case unlockpolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UnlockPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UnlockPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case unlockpolicy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case unlockpolicy.FieldExpression:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpression(v)
+		return nil
+	case unlockpolicy.FieldAction:
+		v, ok := value.(unlockpolicy.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case unlockpolicy.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiredApprovals(v)
+		return nil
+	case unlockpolicy.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case unlockpolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UnlockPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UnlockPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addrequired_approvals != nil {
+		fields = append(fields, unlockpolicy.FieldRequiredApprovals)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, unlockpolicy.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UnlockPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case unlockpolicy.FieldRequiredApprovals:
+		return m.AddedRequiredApprovals()
+	case unlockpolicy.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UnlockPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case unlockpolicy.FieldRequiredApprovals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequiredApprovals(v)
+		return nil
+	case unlockpolicy.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UnlockPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UnlockPolicyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UnlockPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UnlockPolicyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UnlockPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UnlockPolicyMutation) ResetField(name string) error {
+	switch name {
+	case unlockpolicy.FieldName:
+		m.ResetName()
+		return nil
+	case unlockpolicy.FieldExpression:
+		m.ResetExpression()
+		return nil
+	case unlockpolicy.FieldAction:
+		m.ResetAction()
+		return nil
+	case unlockpolicy.FieldRequiredApprovals:
+		m.ResetRequiredApprovals()
+		return nil
+	case unlockpolicy.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case unlockpolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UnlockPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UnlockPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UnlockPolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UnlockPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UnlockPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UnlockPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UnlockPolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UnlockPolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UnlockPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UnlockPolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UnlockPolicy edge %s", name)
+}
+
+// VaultMutation represents an operation that mutates the Vault nodes in the graph.
+type VaultMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	name          *string
-	public_key    *[]byte
-	created_at    *string
+	id            *int
+	key_hash      *[]byte
+	created_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Machine, error)
-	predicates    []predicate.Machine
+	oldValue      func(context.Context) (*Vault, error)
+	predicates    []predicate.Vault
 }
 
-var _ ent.Mutation = (*MachineMutation)(nil)
+var _ ent.Mutation = (*VaultMutation)(nil)
 
-// machineOption allows management of the mutation configuration using functional options.
-type machineOption func(*MachineMutation)
+// vaultOption allows management of the mutation configuration using functional options.
+type vaultOption func(*VaultMutation)
 
-// newMachineMutation creates new mutation for the Machine entity.
-func newMachineMutation(c config, op Op, opts ...machineOption) *MachineMutation {
-	m := &MachineMutation{
+// newVaultMutation creates new mutation for the Vault entity.
+func newVaultMutation(c config, op Op, opts ...vaultOption) *VaultMutation {
+	m := &VaultMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMachine,
+		typ:           TypeVault,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -60,20 +7897,20 @@ func newMachineMutation(c config, op Op, opts ...machineOption) *MachineMutation
 	return m
 }
 
-// withMachineID sets the ID field of the mutation.
-func withMachineID(id string) machineOption {
-	return func(m *MachineMutation) {
+// withVaultID sets the ID field of the mutation.
+func withVaultID(id int) vaultOption {
+	return func(m *VaultMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Machine
+			value *Vault
 		)
-		m.oldValue = func(ctx context.Context) (*Machine, error) {
+		m.oldValue = func(ctx context.Context) (*Vault, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Machine.Get(ctx, id)
+					value, err = m.Client().Vault.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -82,10 +7919,10 @@ func withMachineID(id string) machineOption {
 	}
 }
 
-// withMachine sets the old Machine of the mutation.
-func withMachine(node *Machine) machineOption {
-	return func(m *MachineMutation) {
-		m.oldValue = func(context.Context) (*Machine, error) {
+// withVault sets the old Vault of the mutation.
+func withVault(node *Vault) vaultOption {
+	return func(m *VaultMutation) {
+		m.oldValue = func(context.Context) (*Vault, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -94,7 +7931,7 @@ func withMachine(node *Machine) machineOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MachineMutation) Client() *Client {
+func (m VaultMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -102,7 +7939,7 @@ func (m MachineMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MachineMutation) Tx() (*Tx, error) {
+func (m VaultMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -111,15 +7948,9 @@ func (m MachineMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Machine entities.
-func (m *MachineMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MachineMutation) ID() (id string, exists bool) {
+func (m *VaultMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -130,100 +7961,64 @@ func (m *MachineMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MachineMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *VaultMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Machine.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Vault.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *MachineMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *MachineMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *MachineMutation) ResetName() {
-	m.name = nil
-}
-
-// SetPublicKey sets the "public_key" field.
-func (m *MachineMutation) SetPublicKey(b []byte) {
-	m.public_key = &b
+// SetKeyHash sets the "key_hash" field.
+func (m *VaultMutation) SetKeyHash(b []byte) {
+	m.key_hash = &b
 }
 
-// PublicKey returns the value of the "public_key" field in the mutation.
-func (m *MachineMutation) PublicKey() (r []byte, exists bool) {
-	v := m.public_key
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *VaultMutation) KeyHash() (r []byte, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublicKey returns the old "public_key" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the Vault entity.
+// If the Vault object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *VaultMutation) OldKeyHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.PublicKey, nil
+	return oldValue.KeyHash, nil
 }
 
-// ResetPublicKey resets all changes to the "public_key" field.
-func (m *MachineMutation) ResetPublicKey() {
-	m.public_key = nil
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *VaultMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MachineMutation) SetCreatedAt(s string) {
-	m.created_at = &s
+func (m *VaultMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MachineMutation) CreatedAt() (r string, exists bool) {
+func (m *VaultMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -231,10 +8026,10 @@ func (m *MachineMutation) CreatedAt() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Vault entity.
+// If the Vault object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldCreatedAt(ctx context.Context) (v string, err error) {
+func (m *VaultMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -249,19 +8044,19 @@ func (m *MachineMutation) OldCreatedAt(ctx context.Context) (v string, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MachineMutation) ResetCreatedAt() {
+func (m *VaultMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the MachineMutation builder.
-func (m *MachineMutation) Where(ps ...predicate.Machine) {
+// Where appends a list predicates to the VaultMutation builder.
+func (m *VaultMutation) Where(ps ...predicate.Vault) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MachineMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VaultMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MachineMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Machine, len(ps))
+func (m *VaultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vault, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -269,33 +8064,30 @@ func (m *MachineMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MachineMutation) Op() Op {
+func (m *VaultMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MachineMutation) SetOp(op Op) {
+func (m *VaultMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Machine).
-func (m *MachineMutation) Type() string {
+// Type returns the node type of this mutation (Vault).
+func (m *VaultMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MachineMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, machine.FieldName)
-	}
-	if m.public_key != nil {
-		fields = append(fields, machine.FieldPublicKey)
+func (m *VaultMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key_hash != nil {
+		fields = append(fields, vault.FieldKeyHash)
 	}
 	if m.created_at != nil {
-		fields = append(fields, machine.FieldCreatedAt)
+		fields = append(fields, vault.FieldCreatedAt)
 	}
 	return fields
 }
@@ -303,13 +8095,11 @@ func (m *MachineMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MachineMutation) Field(name string) (ent.Value, bool) {
+func (m *VaultMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case machine.FieldName:
-		return m.Name()
-	case machine.FieldPublicKey:
-		return m.PublicKey()
-	case machine.FieldCreatedAt:
+	case vault.FieldKeyHash:
+		return m.KeyHash()
+	case vault.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -318,150 +8108,138 @@ func (m *MachineMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MachineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VaultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case machine.FieldName:
-		return m.OldName(ctx)
-	case machine.FieldPublicKey:
-		return m.OldPublicKey(ctx)
-	case machine.FieldCreatedAt:
+	case vault.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case vault.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Machine field %s", name)
+	return nil, fmt.Errorf("unknown Vault field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MachineMutation) SetField(name string, value ent.Value) error {
+func (m *VaultMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case machine.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case machine.FieldPublicKey:
+	case vault.FieldKeyHash:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublicKey(v)
+		m.SetKeyHash(v)
 		return nil
-	case machine.FieldCreatedAt:
-		v, ok := value.(string)
+	case vault.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Machine field %s", name)
+	return fmt.Errorf("unknown Vault field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MachineMutation) AddedFields() []string {
+func (m *VaultMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MachineMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VaultMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MachineMutation) AddField(name string, value ent.Value) error {
+func (m *VaultMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Machine numeric field %s", name)
+	return fmt.Errorf("unknown Vault numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MachineMutation) ClearedFields() []string {
+func (m *VaultMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MachineMutation) FieldCleared(name string) bool {
+func (m *VaultMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MachineMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Machine nullable field %s", name)
+func (m *VaultMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Vault nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MachineMutation) ResetField(name string) error {
+func (m *VaultMutation) ResetField(name string) error {
 	switch name {
-	case machine.FieldName:
-		m.ResetName()
-		return nil
-	case machine.FieldPublicKey:
-		m.ResetPublicKey()
+	case vault.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case machine.FieldCreatedAt:
+	case vault.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Machine field %s", name)
+	return fmt.Errorf("unknown Vault field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MachineMutation) AddedEdges() []string {
+func (m *VaultMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MachineMutation) AddedIDs(name string) []ent.Value {
+func (m *VaultMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MachineMutation) RemovedEdges() []string {
+func (m *VaultMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MachineMutation) RemovedIDs(name string) []ent.Value {
+func (m *VaultMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MachineMutation) ClearedEdges() []string {
+func (m *VaultMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MachineMutation) EdgeCleared(name string) bool {
+func (m *VaultMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MachineMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Machine unique edge %s", name)
+func (m *VaultMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Vault unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MachineMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Machine edge %s", name)
+func (m *VaultMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Vault edge %s", name)
 }
@@ -6,5 +6,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// BootMeasurement is the predicate function for bootmeasurement builders.
+type BootMeasurement func(*sql.Selector)
+
+// EnrollmentToken is the predicate function for enrollmenttoken builders.
+type EnrollmentToken func(*sql.Selector)
+
 // Machine is the predicate function for machine builders.
 type Machine func(*sql.Selector)
+
+// MachineAlias is the predicate function for machinealias builders.
+type MachineAlias func(*sql.Selector)
+
+// MachineGroup is the predicate function for machinegroup builders.
+type MachineGroup func(*sql.Selector)
+
+// MaintenanceWindow is the predicate function for maintenancewindow builders.
+type MaintenanceWindow func(*sql.Selector)
+
+// Operator is the predicate function for operator builders.
+type Operator func(*sql.Selector)
+
+// StoredKey is the predicate function for storedkey builders.
+type StoredKey func(*sql.Selector)
+
+// TangKey is the predicate function for tangkey builders.
+type TangKey func(*sql.Selector)
+
+// UnlockPolicy is the predicate function for unlockpolicy builders.
+type UnlockPolicy func(*sql.Selector)
+
+// Vault is the predicate function for vault builders.
+type Vault func(*sql.Selector)
@@ -3,18 +3,215 @@
 package ent
 
 import (
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
 	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/db/ent/maintenancewindow"
+	"git.rgst.io/homelab/klefki/internal/db/ent/operator"
 	"git.rgst.io/homelab/klefki/internal/db/ent/schema"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"git.rgst.io/homelab/klefki/internal/db/ent/vault"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[0].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescType is the schema descriptor for type field.
+	auditeventDescType := auditeventFields[1].Descriptor()
+	// auditevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	auditevent.TypeValidator = auditeventDescType.Validators[0].(func(string) error)
+	bootmeasurementFields := schema.BootMeasurement{}.Fields()
+	_ = bootmeasurementFields
+	// bootmeasurementDescMachineID is the schema descriptor for machine_id field.
+	bootmeasurementDescMachineID := bootmeasurementFields[0].Descriptor()
+	// bootmeasurement.MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
+	bootmeasurement.MachineIDValidator = bootmeasurementDescMachineID.Validators[0].(func(string) error)
+	// bootmeasurementDescCreatedAt is the schema descriptor for created_at field.
+	bootmeasurementDescCreatedAt := bootmeasurementFields[5].Descriptor()
+	// bootmeasurement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bootmeasurement.DefaultCreatedAt = bootmeasurementDescCreatedAt.Default.(func() time.Time)
+	enrollmenttokenFields := schema.EnrollmentToken{}.Fields()
+	_ = enrollmenttokenFields
+	// enrollmenttokenDescMachineName is the schema descriptor for machine_name field.
+	enrollmenttokenDescMachineName := enrollmenttokenFields[1].Descriptor()
+	// enrollmenttoken.MachineNameValidator is a validator for the "machine_name" field. It is called by the builders before save.
+	enrollmenttoken.MachineNameValidator = enrollmenttokenDescMachineName.Validators[0].(func(string) error)
+	// enrollmenttokenDescRequiredApprovals is the schema descriptor for required_approvals field.
+	enrollmenttokenDescRequiredApprovals := enrollmenttokenFields[3].Descriptor()
+	// enrollmenttoken.DefaultRequiredApprovals holds the default value on creation for the required_approvals field.
+	enrollmenttoken.DefaultRequiredApprovals = enrollmenttokenDescRequiredApprovals.Default.(int)
+	// enrollmenttoken.RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	enrollmenttoken.RequiredApprovalsValidator = enrollmenttokenDescRequiredApprovals.Validators[0].(func(int) error)
+	// enrollmenttokenDescRequireConfirmation is the schema descriptor for require_confirmation field.
+	enrollmenttokenDescRequireConfirmation := enrollmenttokenFields[4].Descriptor()
+	// enrollmenttoken.DefaultRequireConfirmation holds the default value on creation for the require_confirmation field.
+	enrollmenttoken.DefaultRequireConfirmation = enrollmenttokenDescRequireConfirmation.Default.(bool)
+	// enrollmenttokenDescCreatedAt is the schema descriptor for created_at field.
+	enrollmenttokenDescCreatedAt := enrollmenttokenFields[8].Descriptor()
+	// enrollmenttoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	enrollmenttoken.DefaultCreatedAt = enrollmenttokenDescCreatedAt.Default.(func() time.Time)
 	machineFields := schema.Machine{}.Fields()
 	_ = machineFields
 	// machineDescCreatedAt is the schema descriptor for created_at field.
-	machineDescCreatedAt := machineFields[3].Descriptor()
+	machineDescCreatedAt := machineFields[5].Descriptor()
 	// machine.DefaultCreatedAt holds the default value on creation for the created_at field.
-	machine.DefaultCreatedAt = machineDescCreatedAt.Default.(string)
+	machine.DefaultCreatedAt = machineDescCreatedAt.Default.(func() time.Time)
+	// machineDescRequiredApprovals is the schema descriptor for required_approvals field.
+	machineDescRequiredApprovals := machineFields[6].Descriptor()
+	// machine.DefaultRequiredApprovals holds the default value on creation for the required_approvals field.
+	machine.DefaultRequiredApprovals = machineDescRequiredApprovals.Default.(int)
+	// machine.RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	machine.RequiredApprovalsValidator = machineDescRequiredApprovals.Validators[0].(func(int) error)
+	// machineDescShareThreshold is the schema descriptor for share_threshold field.
+	machineDescShareThreshold := machineFields[7].Descriptor()
+	// machine.DefaultShareThreshold holds the default value on creation for the share_threshold field.
+	machine.DefaultShareThreshold = machineDescShareThreshold.Default.(int)
+	// machine.ShareThresholdValidator is a validator for the "share_threshold" field. It is called by the builders before save.
+	machine.ShareThresholdValidator = func() func(int) error {
+		validators := machineDescShareThreshold.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(share_threshold int) error {
+			for _, fn := range fns {
+				if err := fn(share_threshold); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// machineDescShareIndex is the schema descriptor for share_index field.
+	machineDescShareIndex := machineFields[8].Descriptor()
+	// machine.DefaultShareIndex holds the default value on creation for the share_index field.
+	machine.DefaultShareIndex = machineDescShareIndex.Default.(int)
+	// machine.ShareIndexValidator is a validator for the "share_index" field. It is called by the builders before save.
+	machine.ShareIndexValidator = machineDescShareIndex.Validators[0].(func(int) error)
+	// machineDescAutoUnlock is the schema descriptor for auto_unlock field.
+	machineDescAutoUnlock := machineFields[9].Descriptor()
+	// machine.DefaultAutoUnlock holds the default value on creation for the auto_unlock field.
+	machine.DefaultAutoUnlock = machineDescAutoUnlock.Default.(bool)
+	// machineDescSecrets is the schema descriptor for secrets field.
+	machineDescSecrets := machineFields[18].Descriptor()
+	// machine.SecretsValidator is a validator for the "secrets" field. It is called by the builders before save.
+	machine.SecretsValidator = machineDescSecrets.Validators[0].(func([]string) error)
+	// machineDescPendingConfirmation is the schema descriptor for pending_confirmation field.
+	machineDescPendingConfirmation := machineFields[19].Descriptor()
+	// machine.DefaultPendingConfirmation holds the default value on creation for the pending_confirmation field.
+	machine.DefaultPendingConfirmation = machineDescPendingConfirmation.Default.(bool)
+	machinealiasFields := schema.MachineAlias{}.Fields()
+	_ = machinealiasFields
+	// machinealiasDescCreatedAt is the schema descriptor for created_at field.
+	machinealiasDescCreatedAt := machinealiasFields[4].Descriptor()
+	// machinealias.DefaultCreatedAt holds the default value on creation for the created_at field.
+	machinealias.DefaultCreatedAt = machinealiasDescCreatedAt.Default.(func() time.Time)
+	machinegroupFields := schema.MachineGroup{}.Fields()
+	_ = machinegroupFields
+	// machinegroupDescName is the schema descriptor for name field.
+	machinegroupDescName := machinegroupFields[0].Descriptor()
+	// machinegroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	machinegroup.NameValidator = machinegroupDescName.Validators[0].(func(string) error)
+	// machinegroupDescSelector is the schema descriptor for selector field.
+	machinegroupDescSelector := machinegroupFields[3].Descriptor()
+	// machinegroup.SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	machinegroup.SelectorValidator = machinegroupDescSelector.Validators[0].(func(string) error)
+	// machinegroupDescCreatedAt is the schema descriptor for created_at field.
+	machinegroupDescCreatedAt := machinegroupFields[4].Descriptor()
+	// machinegroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	machinegroup.DefaultCreatedAt = machinegroupDescCreatedAt.Default.(func() time.Time)
+	maintenancewindowFields := schema.MaintenanceWindow{}.Fields()
+	_ = maintenancewindowFields
+	// maintenancewindowDescName is the schema descriptor for name field.
+	maintenancewindowDescName := maintenancewindowFields[0].Descriptor()
+	// maintenancewindow.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	maintenancewindow.NameValidator = maintenancewindowDescName.Validators[0].(func(string) error)
+	// maintenancewindowDescSchedule is the schema descriptor for schedule field.
+	maintenancewindowDescSchedule := maintenancewindowFields[1].Descriptor()
+	// maintenancewindow.ScheduleValidator is a validator for the "schedule" field. It is called by the builders before save.
+	maintenancewindow.ScheduleValidator = maintenancewindowDescSchedule.Validators[0].(func(string) error)
+	// maintenancewindowDescDuration is the schema descriptor for duration field.
+	maintenancewindowDescDuration := maintenancewindowFields[2].Descriptor()
+	// maintenancewindow.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	maintenancewindow.DurationValidator = maintenancewindowDescDuration.Validators[0].(func(int64) error)
+	// maintenancewindowDescCreatedAt is the schema descriptor for created_at field.
+	maintenancewindowDescCreatedAt := maintenancewindowFields[5].Descriptor()
+	// maintenancewindow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	maintenancewindow.DefaultCreatedAt = maintenancewindowDescCreatedAt.Default.(func() time.Time)
+	operatorFields := schema.Operator{}.Fields()
+	_ = operatorFields
+	// operatorDescCreatedAt is the schema descriptor for created_at field.
+	operatorDescCreatedAt := operatorFields[3].Descriptor()
+	// operator.DefaultCreatedAt holds the default value on creation for the created_at field.
+	operator.DefaultCreatedAt = operatorDescCreatedAt.Default.(func() time.Time)
+	storedkeyFields := schema.StoredKey{}.Fields()
+	_ = storedkeyFields
+	// storedkeyDescSecret is the schema descriptor for secret field.
+	storedkeyDescSecret := storedkeyFields[1].Descriptor()
+	// storedkey.DefaultSecret holds the default value on creation for the secret field.
+	storedkey.DefaultSecret = storedkeyDescSecret.Default.(string)
+	// storedkeyDescCreatedAt is the schema descriptor for created_at field.
+	storedkeyDescCreatedAt := storedkeyFields[3].Descriptor()
+	// storedkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	storedkey.DefaultCreatedAt = storedkeyDescCreatedAt.Default.(func() time.Time)
+	// storedkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	storedkeyDescUpdatedAt := storedkeyFields[4].Descriptor()
+	// storedkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	storedkey.DefaultUpdatedAt = storedkeyDescUpdatedAt.Default.(func() time.Time)
+	// storedkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	storedkey.UpdateDefaultUpdatedAt = storedkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tangkeyFields := schema.TangKey{}.Fields()
+	_ = tangkeyFields
+	// tangkeyDescAdvertised is the schema descriptor for advertised field.
+	tangkeyDescAdvertised := tangkeyFields[3].Descriptor()
+	// tangkey.DefaultAdvertised holds the default value on creation for the advertised field.
+	tangkey.DefaultAdvertised = tangkeyDescAdvertised.Default.(bool)
+	// tangkeyDescCreatedAt is the schema descriptor for created_at field.
+	tangkeyDescCreatedAt := tangkeyFields[4].Descriptor()
+	// tangkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tangkey.DefaultCreatedAt = tangkeyDescCreatedAt.Default.(func() time.Time)
+	unlockpolicyFields := schema.UnlockPolicy{}.Fields()
+	_ = unlockpolicyFields
+	// unlockpolicyDescName is the schema descriptor for name field.
+	unlockpolicyDescName := unlockpolicyFields[0].Descriptor()
+	// unlockpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	unlockpolicy.NameValidator = unlockpolicyDescName.Validators[0].(func(string) error)
+	// unlockpolicyDescExpression is the schema descriptor for expression field.
+	unlockpolicyDescExpression := unlockpolicyFields[1].Descriptor()
+	// unlockpolicy.ExpressionValidator is a validator for the "expression" field. It is called by the builders before save.
+	unlockpolicy.ExpressionValidator = unlockpolicyDescExpression.Validators[0].(func(string) error)
+	// unlockpolicyDescRequiredApprovals is the schema descriptor for required_approvals field.
+	unlockpolicyDescRequiredApprovals := unlockpolicyFields[3].Descriptor()
+	// unlockpolicy.DefaultRequiredApprovals holds the default value on creation for the required_approvals field.
+	unlockpolicy.DefaultRequiredApprovals = unlockpolicyDescRequiredApprovals.Default.(int)
+	// unlockpolicy.RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	unlockpolicy.RequiredApprovalsValidator = unlockpolicyDescRequiredApprovals.Validators[0].(func(int) error)
+	// unlockpolicyDescPriority is the schema descriptor for priority field.
+	unlockpolicyDescPriority := unlockpolicyFields[4].Descriptor()
+	// unlockpolicy.DefaultPriority holds the default value on creation for the priority field.
+	unlockpolicy.DefaultPriority = unlockpolicyDescPriority.Default.(int)
+	// unlockpolicyDescCreatedAt is the schema descriptor for created_at field.
+	unlockpolicyDescCreatedAt := unlockpolicyFields[5].Descriptor()
+	// unlockpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	unlockpolicy.DefaultCreatedAt = unlockpolicyDescCreatedAt.Default.(func() time.Time)
+	vaultFields := schema.Vault{}.Fields()
+	_ = vaultFields
+	// vaultDescCreatedAt is the schema descriptor for created_at field.
+	vaultDescCreatedAt := vaultFields[1].Descriptor()
+	// vault.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vault.DefaultCreatedAt = vaultDescCreatedAt.Default.(func() time.Time)
 }
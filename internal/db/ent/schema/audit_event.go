@@ -0,0 +1,42 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Comment("When this event happened").Default(time.Now).Immutable(),
+		field.String("type").Comment("What happened (e.g., key_released)").NotEmpty().Immutable(),
+		field.String("machine_id").Comment("Fingerprint of the machine this event is about, if any").Optional().Immutable(),
+		field.String("operator_id").Comment("Fingerprint of the operator that caused this event, if any").Optional().Immutable(),
+		field.String("maintenance_window").Comment("Name of the maintenance window that allowed this event, if any").Optional().Immutable(),
+		field.String("details").Comment("Human readable details of this event").Optional().Immutable(),
+	}
+}
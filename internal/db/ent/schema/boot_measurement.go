@@ -0,0 +1,43 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// BootMeasurement holds the schema definition for the BootMeasurement
+// entity, a known-good set of measurements of what a machine booted.
+type BootMeasurement struct {
+	ent.Schema
+}
+
+// Fields of the BootMeasurement.
+func (BootMeasurement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("machine_id").Comment("Fingerprint of the machine these measurements are allowed for").NotEmpty(),
+		field.String("kernel").Comment("Hex encoded SHA256 of the kernel, empty to allow any").Optional(),
+		field.String("initramfs").Comment("Hex encoded SHA256 of the initramfs, empty to allow any").Optional(),
+		field.String("cmdline").Comment("Hex encoded SHA256 of the kernel command line, empty to allow any").Optional(),
+		field.String("description").Comment("User friendly description of these measurements (e.g., kernel version)").Optional(),
+		field.Time("created_at").Comment("When these measurements were added").Default(time.Now).Immutable(),
+	}
+}
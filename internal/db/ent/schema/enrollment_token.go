@@ -0,0 +1,48 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// EnrollmentToken holds the schema definition for the EnrollmentToken
+// entity.
+type EnrollmentToken struct {
+	ent.Schema
+}
+
+// Fields of the EnrollmentToken.
+func (EnrollmentToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("token_hash").Comment("SHA256 hash of the token, the token itself is never stored").Unique().Sensitive(),
+		field.String("machine_name").Comment("Name of the machine enrolled with this token").NotEmpty(),
+		field.JSON("labels", map[string]string{}).Comment("Labels of the machine enrolled with this token").Optional(),
+		field.Int("required_approvals").Comment("Number of distinct operators that must approve the sessions of the machine enrolled with this token").
+			Default(0).NonNegative(),
+		field.Bool("require_confirmation").Comment("Require an operator to confirm the machine enrolled with this token before it can request keys").
+			Default(false),
+		field.Time("expires_at").Comment("When this token can no longer be used"),
+		field.Time("used_at").Comment("When this token was used, tokens can only be used once").Optional().Nillable(),
+		field.String("machine_id").Comment("Fingerprint of the machine enrolled with this token, if used").Optional(),
+		field.Time("created_at").Comment("When this token was created").Default(time.Now).Immutable(),
+	}
+}
@@ -18,10 +18,12 @@
 package schema
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/secrets"
 )
 
 // Machine holds the schema definition for the Machine entity.
@@ -32,9 +34,39 @@ type Machine struct {
 // Fields of the Machine.
 func (Machine) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("id").Comment("Fingerprint of the public key"),
+		field.String("id").Comment("Stable ID of the machine, which doesn't change when its key is rotated").Immutable(),
 		field.String("name").Comment("User friendly name of this machine (e.g., hostname)").Unique(),
+		field.String("description").Comment("Free-form description of this machine").Optional(),
 		field.Bytes("public_key").Comment("Public key of the machine"),
-		field.String("created_at").Comment("When this machine was added in UTC").Default(time.Now().UTC().Format(time.RFC3339)),
+		field.String("fingerprint").Comment("Fingerprint of the public key, which machines are looked up by").
+			Optional().Unique(),
+		field.Time("created_at").Comment("When this machine was added").Default(time.Now).Immutable(),
+		field.Int("required_approvals").Comment("Number of distinct operators that must approve a session before its key is released").
+			Default(0).NonNegative(),
+		field.Int("share_threshold").Comment("Number of Shamir shares that must be submitted to recover the key, zero if the key isn't split").
+			Default(0).NonNegative().Validate(func(i int) error {
+			if i == 1 {
+				return fmt.Errorf("a split key requires at least 2 shares")
+			}
+			return nil
+		}),
+		field.Int("share_index").Comment("Index of the Shamir share of the key held by this server, zero if it holds the whole key").
+			Default(0).Range(0, 255),
+		field.Bool("auto_unlock").Comment("Release the key stored in the vault without an operator submitting it").
+			Default(false),
+		field.JSON("labels", map[string]string{}).Comment("Labels of the machine, used by policies").Optional(),
+		field.JSON("hardware", map[string]string{}).Comment("Hardware attributes the machine is bound to, set on first use").Optional(),
+		field.JSON("pending_hardware", map[string]string{}).Comment("Mismatching hardware attributes last reported, to be accepted by an operator").Optional(),
+		field.Enum("hardware_binding").Comment("What happens when the reported hardware attributes don't match").
+			Values("warn", "enforce").Default("warn"),
+		field.Time("last_asked_at").Comment("When this machine last requested its key").Optional().Nillable(),
+		field.Time("last_unlocked_at").Comment("When a key was last released to this machine").Optional().Nillable(),
+		field.Enum("state").Comment("Whether the machine can request keys. Quarantined machines have their requests recorded, but never receive a key").
+			Values("active", "disabled", "quarantined").Default("active"),
+		field.Time("expires_at").Comment("When the machine stops being able to request keys, if ever").Optional().Nillable(),
+		field.Strings("secrets").Comment("Names of the secrets of the machine (e.g., one per volume), the first being its default one. If empty, it has a single secret").
+			Optional().Validate(secrets.ValidateNames),
+		field.Bool("pending_confirmation").Comment("Whether this machine enrolled itself and awaits confirmation by an operator before it can request keys").
+			Default(false),
 	}
 }
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/labels"
+)
+
+// MachineGroup holds the schema definition for the MachineGroup entity.
+type MachineGroup struct {
+	ent.Schema
+}
+
+// Fields of the MachineGroup.
+func (MachineGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Comment("User friendly name of this group").Unique().NotEmpty(),
+		field.String("description").Comment("Free-form description of this group").Optional(),
+		field.Strings("machine_ids").Comment("IDs of the machines in this group").Optional(),
+		field.String("selector").Comment("Label selector of the machines in this group, in addition to machine_ids").Optional().
+			Validate(func(s string) error {
+				_, err := labels.ParseSelector(s)
+				return err
+			}),
+		field.Time("created_at").Comment("When this group was added").Default(time.Now).Immutable(),
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindow holds the schema definition for the
+// MaintenanceWindow entity.
+type MaintenanceWindow struct {
+	ent.Schema
+}
+
+// Fields of the MaintenanceWindow.
+func (MaintenanceWindow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Comment("User friendly name of this window").Unique().NotEmpty(),
+		field.String("schedule").Comment("Cron expression of when this window opens").NotEmpty(),
+		field.Int64("duration").Comment("How long this window stays open for, in seconds").Positive(),
+		field.Strings("machine_ids").Comment("IDs of the machines this window applies to").Optional(),
+		field.String("selector").Comment("Label selector of the machines this window applies to (e.g., role=storage)").Optional(),
+		field.Time("created_at").Comment("When this window was added").Default(time.Now).Immutable(),
+	}
+}
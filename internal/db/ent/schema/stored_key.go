@@ -0,0 +1,49 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StoredKey holds the schema definition for the StoredKey entity.
+type StoredKey struct {
+	ent.Schema
+}
+
+// Fields of the StoredKey.
+func (StoredKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("machine_id").Comment("ID of the machine this key belongs to"),
+		field.String("secret").Comment("Name of the secret of the machine this key is, empty for its default one").Default(""),
+		field.Bytes("ciphertext").Comment("Passphrase of the machine, encrypted with the vault's master key").Sensitive(),
+		field.Time("created_at").Comment("When this key was stored").Default(time.Now).Immutable(),
+		field.Time("updated_at").Comment("When this key was last replaced").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the StoredKey.
+func (StoredKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("machine_id", "secret").Unique(),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TangKey holds the schema definition for the TangKey entity, a key
+// used by the Tang compatible endpoint.
+type TangKey struct {
+	ent.Schema
+}
+
+// Fields of the TangKey.
+func (TangKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("use").Comment("What the key is used for, signing advertisements or key exchange").
+			Values("sign", "exchange").Immutable(),
+		field.String("machine_id").Comment("Fingerprint of the machine an exchange key is for, empty for signing keys").
+			Optional().Immutable(),
+		field.Bytes("private_key").Comment("PKCS #8 encoded P-521 private key").Sensitive().Immutable(),
+		field.Bool("advertised").Comment("Whether the key is advertised, rotated keys are only used for recovery").
+			Default(true),
+		field.Time("created_at").Comment("When this key was generated").Default(time.Now).Immutable(),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// UnlockPolicy holds the schema definition for the UnlockPolicy entity.
+type UnlockPolicy struct {
+	ent.Schema
+}
+
+// Fields of the UnlockPolicy.
+func (UnlockPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Comment("User friendly name of this policy").Unique().NotEmpty(),
+		field.String("expression").Comment("CEL expression that must evaluate to true for this policy to apply").NotEmpty(),
+		field.Enum("action").Comment("What happens to a key request this policy applies to").
+			Values("release", "require_approvals", "deny"),
+		field.Int("required_approvals").Comment("Number of approvals required by the require_approvals action").
+			Default(0).NonNegative(),
+		field.Int("priority").Comment("Policies are evaluated in ascending priority, the first that applies wins").
+			Default(0),
+		field.Time("created_at").Comment("When this policy was added").Default(time.Now).Immutable(),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Vault holds the schema definition for the Vault entity. There is at
+// most one of these, created when the vault is initialized.
+type Vault struct {
+	ent.Schema
+}
+
+// Fields of the Vault.
+func (Vault) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("key_hash").Comment("SHA256 hash of the master key, used to verify it when unsealing"),
+		field.Time("created_at").Comment("When the vault was initialized").Default(time.Now).Immutable(),
+	}
+}
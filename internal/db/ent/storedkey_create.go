@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+)
+
+// StoredKeyCreate is the builder for creating a StoredKey entity.
+type StoredKeyCreate struct {
+	config
+	mutation *StoredKeyMutation
+	hooks    []Hook
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *StoredKeyCreate) SetMachineID(v string) *StoredKeyCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *StoredKeyCreate) SetSecret(v string) *StoredKeyCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_c *StoredKeyCreate) SetNillableSecret(v *string) *StoredKeyCreate {
+	if v != nil {
+		_c.SetSecret(*v)
+	}
+	return _c
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_c *StoredKeyCreate) SetCiphertext(v []byte) *StoredKeyCreate {
+	_c.mutation.SetCiphertext(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StoredKeyCreate) SetCreatedAt(v time.Time) *StoredKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StoredKeyCreate) SetNillableCreatedAt(v *time.Time) *StoredKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StoredKeyCreate) SetUpdatedAt(v time.Time) *StoredKeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StoredKeyCreate) SetNillableUpdatedAt(v *time.Time) *StoredKeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the StoredKeyMutation object of the builder.
+func (_c *StoredKeyCreate) Mutation() *StoredKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the StoredKey in the database.
+func (_c *StoredKeyCreate) Save(ctx context.Context) (*StoredKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StoredKeyCreate) SaveX(ctx context.Context) *StoredKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StoredKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StoredKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StoredKeyCreate) defaults() {
+	if _, ok := _c.mutation.Secret(); !ok {
+		v := storedkey.DefaultSecret
+		_c.mutation.SetSecret(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := storedkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := storedkey.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StoredKeyCreate) check() error {
+	if _, ok := _c.mutation.MachineID(); !ok {
+		return &ValidationError{Name: "machine_id", err: errors.New(`ent: missing required field "StoredKey.machine_id"`)}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "StoredKey.secret"`)}
+	}
+	if _, ok := _c.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`ent: missing required field "StoredKey.ciphertext"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StoredKey.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StoredKey.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *StoredKeyCreate) sqlSave(ctx context.Context) (*StoredKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StoredKeyCreate) createSpec() (*StoredKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StoredKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(storedkey.Table, sqlgraph.NewFieldSpec(storedkey.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.MachineID(); ok {
+		_spec.SetField(storedkey.FieldMachineID, field.TypeString, value)
+		_node.MachineID = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(storedkey.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.Ciphertext(); ok {
+		_spec.SetField(storedkey.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(storedkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(storedkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// StoredKeyCreateBulk is the builder for creating many StoredKey entities in bulk.
+type StoredKeyCreateBulk struct {
+	config
+	err      error
+	builders []*StoredKeyCreate
+}
+
+// Save creates the StoredKey entities in the database.
+func (_c *StoredKeyCreateBulk) Save(ctx context.Context) ([]*StoredKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StoredKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StoredKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StoredKeyCreateBulk) SaveX(ctx context.Context) []*StoredKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StoredKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StoredKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
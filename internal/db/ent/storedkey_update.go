@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+)
+
+// StoredKeyUpdate is the builder for updating StoredKey entities.
+type StoredKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StoredKeyMutation
+}
+
+// Where appends a list predicates to the StoredKeyUpdate builder.
+func (_u *StoredKeyUpdate) Where(ps ...predicate.StoredKey) *StoredKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *StoredKeyUpdate) SetMachineID(v string) *StoredKeyUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *StoredKeyUpdate) SetNillableMachineID(v *string) *StoredKeyUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *StoredKeyUpdate) SetSecret(v string) *StoredKeyUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *StoredKeyUpdate) SetNillableSecret(v *string) *StoredKeyUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_u *StoredKeyUpdate) SetCiphertext(v []byte) *StoredKeyUpdate {
+	_u.mutation.SetCiphertext(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StoredKeyUpdate) SetUpdatedAt(v time.Time) *StoredKeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the StoredKeyMutation object of the builder.
+func (_u *StoredKeyUpdate) Mutation() *StoredKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StoredKeyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StoredKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StoredKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StoredKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StoredKeyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := storedkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *StoredKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storedkey.Table, storedkey.Columns, sqlgraph.NewFieldSpec(storedkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(storedkey.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(storedkey.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ciphertext(); ok {
+		_spec.SetField(storedkey.FieldCiphertext, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(storedkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storedkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StoredKeyUpdateOne is the builder for updating a single StoredKey entity.
+type StoredKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StoredKeyMutation
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *StoredKeyUpdateOne) SetMachineID(v string) *StoredKeyUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *StoredKeyUpdateOne) SetNillableMachineID(v *string) *StoredKeyUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *StoredKeyUpdateOne) SetSecret(v string) *StoredKeyUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *StoredKeyUpdateOne) SetNillableSecret(v *string) *StoredKeyUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_u *StoredKeyUpdateOne) SetCiphertext(v []byte) *StoredKeyUpdateOne {
+	_u.mutation.SetCiphertext(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StoredKeyUpdateOne) SetUpdatedAt(v time.Time) *StoredKeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the StoredKeyMutation object of the builder.
+func (_u *StoredKeyUpdateOne) Mutation() *StoredKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StoredKeyUpdate builder.
+func (_u *StoredKeyUpdateOne) Where(ps ...predicate.StoredKey) *StoredKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StoredKeyUpdateOne) Select(field string, fields ...string) *StoredKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StoredKey entity.
+func (_u *StoredKeyUpdateOne) Save(ctx context.Context) (*StoredKey, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StoredKeyUpdateOne) SaveX(ctx context.Context) *StoredKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StoredKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StoredKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StoredKeyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := storedkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *StoredKeyUpdateOne) sqlSave(ctx context.Context) (_node *StoredKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storedkey.Table, storedkey.Columns, sqlgraph.NewFieldSpec(storedkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StoredKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storedkey.FieldID)
+		for _, f := range fields {
+			if !storedkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != storedkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(storedkey.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(storedkey.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ciphertext(); ok {
+		_spec.SetField(storedkey.FieldCiphertext, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(storedkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &StoredKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storedkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+)
+
+// TangKey is the model entity for the TangKey schema.
+type TangKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// What the key is used for, signing advertisements or key exchange
+	Use tangkey.Use `json:"use,omitempty"`
+	// Fingerprint of the machine an exchange key is for, empty for signing keys
+	MachineID string `json:"machine_id,omitempty"`
+	// PKCS #8 encoded P-521 private key
+	PrivateKey []byte `json:"-"`
+	// Whether the key is advertised, rotated keys are only used for recovery
+	Advertised bool `json:"advertised,omitempty"`
+	// When this key was generated
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TangKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tangkey.FieldPrivateKey:
+			values[i] = new([]byte)
+		case tangkey.FieldAdvertised:
+			values[i] = new(sql.NullBool)
+		case tangkey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case tangkey.FieldUse, tangkey.FieldMachineID:
+			values[i] = new(sql.NullString)
+		case tangkey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TangKey fields.
+func (_m *TangKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tangkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tangkey.FieldUse:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field use", values[i])
+			} else if value.Valid {
+				_m.Use = tangkey.Use(value.String)
+			}
+		case tangkey.FieldMachineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value.Valid {
+				_m.MachineID = value.String
+			}
+		case tangkey.FieldPrivateKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value != nil {
+				_m.PrivateKey = *value
+			}
+		case tangkey.FieldAdvertised:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field advertised", values[i])
+			} else if value.Valid {
+				_m.Advertised = value.Bool
+			}
+		case tangkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TangKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *TangKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TangKey.
+// Note that you need to call TangKey.Unwrap() before calling this method if this TangKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TangKey) Update() *TangKeyUpdateOne {
+	return NewTangKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TangKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TangKey) Unwrap() *TangKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TangKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TangKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("TangKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("use=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Use))
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(_m.MachineID)
+	builder.WriteString(", ")
+	builder.WriteString("private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("advertised=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Advertised))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TangKeys is a parsable slice of TangKey.
+type TangKeys []*TangKey
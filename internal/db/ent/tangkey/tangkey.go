@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tangkey
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tangkey type in the database.
+	Label = "tang_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUse holds the string denoting the use field in the database.
+	FieldUse = "use"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldPrivateKey holds the string denoting the private_key field in the database.
+	FieldPrivateKey = "private_key"
+	// FieldAdvertised holds the string denoting the advertised field in the database.
+	FieldAdvertised = "advertised"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the tangkey in the database.
+	Table = "tang_keys"
+)
+
+// Columns holds all SQL columns for tangkey fields.
+var Columns = []string{
+	FieldID,
+	FieldUse,
+	FieldMachineID,
+	FieldPrivateKey,
+	FieldAdvertised,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAdvertised holds the default value on creation for the "advertised" field.
+	DefaultAdvertised bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Use defines the type for the "use" enum field.
+type Use string
+
+// Use values.
+const (
+	UseSign     Use = "sign"
+	UseExchange Use = "exchange"
+)
+
+func (u Use) String() string {
+	return string(u)
+}
+
+// UseValidator is a validator for the "use" field enum values. It is called by the builders before save.
+func UseValidator(u Use) error {
+	switch u {
+	case UseSign, UseExchange:
+		return nil
+	default:
+		return fmt.Errorf("tangkey: invalid enum value for use field: %q", u)
+	}
+}
+
+// OrderOption defines the ordering options for the TangKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUse orders the results by the use field.
+func ByUse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUse, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByAdvertised orders the results by the advertised field.
+func ByAdvertised(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdvertised, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
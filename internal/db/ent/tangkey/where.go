@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tangkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLTE(FieldID, id))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldMachineID, v))
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// Advertised applies equality check predicate on the "advertised" field. It's identical to AdvertisedEQ.
+func Advertised(v bool) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldAdvertised, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UseEQ applies the EQ predicate on the "use" field.
+func UseEQ(v Use) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldUse, v))
+}
+
+// UseNEQ applies the NEQ predicate on the "use" field.
+func UseNEQ(v Use) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNEQ(FieldUse, v))
+}
+
+// UseIn applies the In predicate on the "use" field.
+func UseIn(vs ...Use) predicate.TangKey {
+	return predicate.TangKey(sql.FieldIn(FieldUse, vs...))
+}
+
+// UseNotIn applies the NotIn predicate on the "use" field.
+func UseNotIn(vs ...Use) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNotIn(FieldUse, vs...))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// MachineIDGT applies the GT predicate on the "machine_id" field.
+func MachineIDGT(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGT(FieldMachineID, v))
+}
+
+// MachineIDGTE applies the GTE predicate on the "machine_id" field.
+func MachineIDGTE(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGTE(FieldMachineID, v))
+}
+
+// MachineIDLT applies the LT predicate on the "machine_id" field.
+func MachineIDLT(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLT(FieldMachineID, v))
+}
+
+// MachineIDLTE applies the LTE predicate on the "machine_id" field.
+func MachineIDLTE(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLTE(FieldMachineID, v))
+}
+
+// MachineIDContains applies the Contains predicate on the "machine_id" field.
+func MachineIDContains(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldContains(FieldMachineID, v))
+}
+
+// MachineIDHasPrefix applies the HasPrefix predicate on the "machine_id" field.
+func MachineIDHasPrefix(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldHasPrefix(FieldMachineID, v))
+}
+
+// MachineIDHasSuffix applies the HasSuffix predicate on the "machine_id" field.
+func MachineIDHasSuffix(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldHasSuffix(FieldMachineID, v))
+}
+
+// MachineIDIsNil applies the IsNil predicate on the "machine_id" field.
+func MachineIDIsNil() predicate.TangKey {
+	return predicate.TangKey(sql.FieldIsNull(FieldMachineID))
+}
+
+// MachineIDNotNil applies the NotNil predicate on the "machine_id" field.
+func MachineIDNotNil() predicate.TangKey {
+	return predicate.TangKey(sql.FieldNotNull(FieldMachineID))
+}
+
+// MachineIDEqualFold applies the EqualFold predicate on the "machine_id" field.
+func MachineIDEqualFold(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEqualFold(FieldMachineID, v))
+}
+
+// MachineIDContainsFold applies the ContainsFold predicate on the "machine_id" field.
+func MachineIDContainsFold(v string) predicate.TangKey {
+	return predicate.TangKey(sql.FieldContainsFold(FieldMachineID, v))
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...[]byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...[]byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNotIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGT(FieldPrivateKey, v))
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLT(FieldPrivateKey, v))
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v []byte) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLTE(FieldPrivateKey, v))
+}
+
+// AdvertisedEQ applies the EQ predicate on the "advertised" field.
+func AdvertisedEQ(v bool) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldAdvertised, v))
+}
+
+// AdvertisedNEQ applies the NEQ predicate on the "advertised" field.
+func AdvertisedNEQ(v bool) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNEQ(FieldAdvertised, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TangKey {
+	return predicate.TangKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TangKey) predicate.TangKey {
+	return predicate.TangKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TangKey) predicate.TangKey {
+	return predicate.TangKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TangKey) predicate.TangKey {
+	return predicate.TangKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+)
+
+// TangKeyCreate is the builder for creating a TangKey entity.
+type TangKeyCreate struct {
+	config
+	mutation *TangKeyMutation
+	hooks    []Hook
+}
+
+// SetUse sets the "use" field.
+func (_c *TangKeyCreate) SetUse(v tangkey.Use) *TangKeyCreate {
+	_c.mutation.SetUse(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *TangKeyCreate) SetMachineID(v string) *TangKeyCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_c *TangKeyCreate) SetNillableMachineID(v *string) *TangKeyCreate {
+	if v != nil {
+		_c.SetMachineID(*v)
+	}
+	return _c
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_c *TangKeyCreate) SetPrivateKey(v []byte) *TangKeyCreate {
+	_c.mutation.SetPrivateKey(v)
+	return _c
+}
+
+// SetAdvertised sets the "advertised" field.
+func (_c *TangKeyCreate) SetAdvertised(v bool) *TangKeyCreate {
+	_c.mutation.SetAdvertised(v)
+	return _c
+}
+
+// SetNillableAdvertised sets the "advertised" field if the given value is not nil.
+func (_c *TangKeyCreate) SetNillableAdvertised(v *bool) *TangKeyCreate {
+	if v != nil {
+		_c.SetAdvertised(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TangKeyCreate) SetCreatedAt(v time.Time) *TangKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TangKeyCreate) SetNillableCreatedAt(v *time.Time) *TangKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TangKeyMutation object of the builder.
+func (_c *TangKeyCreate) Mutation() *TangKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the TangKey in the database.
+func (_c *TangKeyCreate) Save(ctx context.Context) (*TangKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TangKeyCreate) SaveX(ctx context.Context) *TangKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TangKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TangKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TangKeyCreate) defaults() {
+	if _, ok := _c.mutation.Advertised(); !ok {
+		v := tangkey.DefaultAdvertised
+		_c.mutation.SetAdvertised(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tangkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TangKeyCreate) check() error {
+	if _, ok := _c.mutation.Use(); !ok {
+		return &ValidationError{Name: "use", err: errors.New(`ent: missing required field "TangKey.use"`)}
+	}
+	if v, ok := _c.mutation.Use(); ok {
+		if err := tangkey.UseValidator(v); err != nil {
+			return &ValidationError{Name: "use", err: fmt.Errorf(`ent: validator failed for field "TangKey.use": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "TangKey.private_key"`)}
+	}
+	if _, ok := _c.mutation.Advertised(); !ok {
+		return &ValidationError{Name: "advertised", err: errors.New(`ent: missing required field "TangKey.advertised"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TangKey.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TangKeyCreate) sqlSave(ctx context.Context) (*TangKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TangKeyCreate) createSpec() (*TangKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TangKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tangkey.Table, sqlgraph.NewFieldSpec(tangkey.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Use(); ok {
+		_spec.SetField(tangkey.FieldUse, field.TypeEnum, value)
+		_node.Use = value
+	}
+	if value, ok := _c.mutation.MachineID(); ok {
+		_spec.SetField(tangkey.FieldMachineID, field.TypeString, value)
+		_node.MachineID = value
+	}
+	if value, ok := _c.mutation.PrivateKey(); ok {
+		_spec.SetField(tangkey.FieldPrivateKey, field.TypeBytes, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := _c.mutation.Advertised(); ok {
+		_spec.SetField(tangkey.FieldAdvertised, field.TypeBool, value)
+		_node.Advertised = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tangkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TangKeyCreateBulk is the builder for creating many TangKey entities in bulk.
+type TangKeyCreateBulk struct {
+	config
+	err      error
+	builders []*TangKeyCreate
+}
+
+// Save creates the TangKey entities in the database.
+func (_c *TangKeyCreateBulk) Save(ctx context.Context) ([]*TangKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TangKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TangKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TangKeyCreateBulk) SaveX(ctx context.Context) []*TangKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TangKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TangKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+)
+
+// TangKeyUpdate is the builder for updating TangKey entities.
+type TangKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TangKeyMutation
+}
+
+// Where appends a list predicates to the TangKeyUpdate builder.
+func (_u *TangKeyUpdate) Where(ps ...predicate.TangKey) *TangKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAdvertised sets the "advertised" field.
+func (_u *TangKeyUpdate) SetAdvertised(v bool) *TangKeyUpdate {
+	_u.mutation.SetAdvertised(v)
+	return _u
+}
+
+// SetNillableAdvertised sets the "advertised" field if the given value is not nil.
+func (_u *TangKeyUpdate) SetNillableAdvertised(v *bool) *TangKeyUpdate {
+	if v != nil {
+		_u.SetAdvertised(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TangKeyMutation object of the builder.
+func (_u *TangKeyUpdate) Mutation() *TangKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TangKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TangKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TangKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TangKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TangKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tangkey.Table, tangkey.Columns, sqlgraph.NewFieldSpec(tangkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.MachineIDCleared() {
+		_spec.ClearField(tangkey.FieldMachineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Advertised(); ok {
+		_spec.SetField(tangkey.FieldAdvertised, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tangkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TangKeyUpdateOne is the builder for updating a single TangKey entity.
+type TangKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TangKeyMutation
+}
+
+// SetAdvertised sets the "advertised" field.
+func (_u *TangKeyUpdateOne) SetAdvertised(v bool) *TangKeyUpdateOne {
+	_u.mutation.SetAdvertised(v)
+	return _u
+}
+
+// SetNillableAdvertised sets the "advertised" field if the given value is not nil.
+func (_u *TangKeyUpdateOne) SetNillableAdvertised(v *bool) *TangKeyUpdateOne {
+	if v != nil {
+		_u.SetAdvertised(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TangKeyMutation object of the builder.
+func (_u *TangKeyUpdateOne) Mutation() *TangKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TangKeyUpdate builder.
+func (_u *TangKeyUpdateOne) Where(ps ...predicate.TangKey) *TangKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TangKeyUpdateOne) Select(field string, fields ...string) *TangKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TangKey entity.
+func (_u *TangKeyUpdateOne) Save(ctx context.Context) (*TangKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TangKeyUpdateOne) SaveX(ctx context.Context) *TangKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TangKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TangKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TangKeyUpdateOne) sqlSave(ctx context.Context) (_node *TangKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tangkey.Table, tangkey.Columns, sqlgraph.NewFieldSpec(tangkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TangKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tangkey.FieldID)
+		for _, f := range fields {
+			if !tangkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tangkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.MachineIDCleared() {
+		_spec.ClearField(tangkey.FieldMachineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Advertised(); ok {
+		_spec.SetField(tangkey.FieldAdvertised, field.TypeBool, value)
+	}
+	_node = &TangKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tangkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// BootMeasurement is the client for interacting with the BootMeasurement builders.
+	BootMeasurement *BootMeasurementClient
+	// EnrollmentToken is the client for interacting with the EnrollmentToken builders.
+	EnrollmentToken *EnrollmentTokenClient
 	// Machine is the client for interacting with the Machine builders.
 	Machine *MachineClient
+	// MachineAlias is the client for interacting with the MachineAlias builders.
+	MachineAlias *MachineAliasClient
+	// MachineGroup is the client for interacting with the MachineGroup builders.
+	MachineGroup *MachineGroupClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
+	// Operator is the client for interacting with the Operator builders.
+	Operator *OperatorClient
+	// StoredKey is the client for interacting with the StoredKey builders.
+	StoredKey *StoredKeyClient
+	// TangKey is the client for interacting with the TangKey builders.
+	TangKey *TangKeyClient
+	// UnlockPolicy is the client for interacting with the UnlockPolicy builders.
+	UnlockPolicy *UnlockPolicyClient
+	// Vault is the client for interacting with the Vault builders.
+	Vault *VaultClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +167,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.BootMeasurement = NewBootMeasurementClient(tx.config)
+	tx.EnrollmentToken = NewEnrollmentTokenClient(tx.config)
 	tx.Machine = NewMachineClient(tx.config)
+	tx.MachineAlias = NewMachineAliasClient(tx.config)
+	tx.MachineGroup = NewMachineGroupClient(tx.config)
+	tx.MaintenanceWindow = NewMaintenanceWindowClient(tx.config)
+	tx.Operator = NewOperatorClient(tx.config)
+	tx.StoredKey = NewStoredKeyClient(tx.config)
+	tx.TangKey = NewTangKeyClient(tx.config)
+	tx.UnlockPolicy = NewUnlockPolicyClient(tx.config)
+	tx.Vault = NewVaultClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Machine.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+)
+
+// UnlockPolicy is the model entity for the UnlockPolicy schema.
+type UnlockPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User friendly name of this policy
+	Name string `json:"name,omitempty"`
+	// CEL expression that must evaluate to true for this policy to apply
+	Expression string `json:"expression,omitempty"`
+	// What happens to a key request this policy applies to
+	Action unlockpolicy.Action `json:"action,omitempty"`
+	// Number of approvals required by the require_approvals action
+	RequiredApprovals int `json:"required_approvals,omitempty"`
+	// Policies are evaluated in ascending priority, the first that applies wins
+	Priority int `json:"priority,omitempty"`
+	// When this policy was added
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UnlockPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case unlockpolicy.FieldID, unlockpolicy.FieldRequiredApprovals, unlockpolicy.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case unlockpolicy.FieldName, unlockpolicy.FieldExpression, unlockpolicy.FieldAction:
+			values[i] = new(sql.NullString)
+		case unlockpolicy.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UnlockPolicy fields.
+func (_m *UnlockPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case unlockpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case unlockpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case unlockpolicy.FieldExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expression", values[i])
+			} else if value.Valid {
+				_m.Expression = value.String
+			}
+		case unlockpolicy.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = unlockpolicy.Action(value.String)
+			}
+		case unlockpolicy.FieldRequiredApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_approvals", values[i])
+			} else if value.Valid {
+				_m.RequiredApprovals = int(value.Int64)
+			}
+		case unlockpolicy.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case unlockpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UnlockPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *UnlockPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UnlockPolicy.
+// Note that you need to call UnlockPolicy.Unwrap() before calling this method if this UnlockPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UnlockPolicy) Update() *UnlockPolicyUpdateOne {
+	return NewUnlockPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UnlockPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UnlockPolicy) Unwrap() *UnlockPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UnlockPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UnlockPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("UnlockPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("expression=")
+	builder.WriteString(_m.Expression)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("required_approvals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredApprovals))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UnlockPolicies is a parsable slice of UnlockPolicy.
+type UnlockPolicies []*UnlockPolicy
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package unlockpolicy
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the unlockpolicy type in the database.
+	Label = "unlock_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldExpression holds the string denoting the expression field in the database.
+	FieldExpression = "expression"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldRequiredApprovals holds the string denoting the required_approvals field in the database.
+	FieldRequiredApprovals = "required_approvals"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the unlockpolicy in the database.
+	Table = "unlock_policies"
+)
+
+// Columns holds all SQL columns for unlockpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldExpression,
+	FieldAction,
+	FieldRequiredApprovals,
+	FieldPriority,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ExpressionValidator is a validator for the "expression" field. It is called by the builders before save.
+	ExpressionValidator func(string) error
+	// DefaultRequiredApprovals holds the default value on creation for the "required_approvals" field.
+	DefaultRequiredApprovals int
+	// RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	RequiredApprovalsValidator func(int) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionRelease          Action = "release"
+	ActionRequireApprovals Action = "require_approvals"
+	ActionDeny             Action = "deny"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionRelease, ActionRequireApprovals, ActionDeny:
+		return nil
+	default:
+		return fmt.Errorf("unlockpolicy: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the UnlockPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByExpression orders the results by the expression field.
+func ByExpression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpression, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByRequiredApprovals orders the results by the required_approvals field.
+func ByRequiredApprovals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredApprovals, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
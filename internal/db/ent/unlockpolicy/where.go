@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package unlockpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Expression applies equality check predicate on the "expression" field. It's identical to ExpressionEQ.
+func Expression(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldExpression, v))
+}
+
+// RequiredApprovals applies equality check predicate on the "required_approvals" field. It's identical to RequiredApprovalsEQ.
+func RequiredApprovals(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldPriority, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// ExpressionEQ applies the EQ predicate on the "expression" field.
+func ExpressionEQ(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldExpression, v))
+}
+
+// ExpressionNEQ applies the NEQ predicate on the "expression" field.
+func ExpressionNEQ(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldExpression, v))
+}
+
+// ExpressionIn applies the In predicate on the "expression" field.
+func ExpressionIn(vs ...string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldExpression, vs...))
+}
+
+// ExpressionNotIn applies the NotIn predicate on the "expression" field.
+func ExpressionNotIn(vs ...string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldExpression, vs...))
+}
+
+// ExpressionGT applies the GT predicate on the "expression" field.
+func ExpressionGT(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGT(FieldExpression, v))
+}
+
+// ExpressionGTE applies the GTE predicate on the "expression" field.
+func ExpressionGTE(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGTE(FieldExpression, v))
+}
+
+// ExpressionLT applies the LT predicate on the "expression" field.
+func ExpressionLT(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLT(FieldExpression, v))
+}
+
+// ExpressionLTE applies the LTE predicate on the "expression" field.
+func ExpressionLTE(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLTE(FieldExpression, v))
+}
+
+// ExpressionContains applies the Contains predicate on the "expression" field.
+func ExpressionContains(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldContains(FieldExpression, v))
+}
+
+// ExpressionHasPrefix applies the HasPrefix predicate on the "expression" field.
+func ExpressionHasPrefix(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldHasPrefix(FieldExpression, v))
+}
+
+// ExpressionHasSuffix applies the HasSuffix predicate on the "expression" field.
+func ExpressionHasSuffix(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldHasSuffix(FieldExpression, v))
+}
+
+// ExpressionEqualFold applies the EqualFold predicate on the "expression" field.
+func ExpressionEqualFold(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEqualFold(FieldExpression, v))
+}
+
+// ExpressionContainsFold applies the ContainsFold predicate on the "expression" field.
+func ExpressionContainsFold(v string) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldContainsFold(FieldExpression, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// RequiredApprovalsEQ applies the EQ predicate on the "required_approvals" field.
+func RequiredApprovalsEQ(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsNEQ applies the NEQ predicate on the "required_approvals" field.
+func RequiredApprovalsNEQ(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsIn applies the In predicate on the "required_approvals" field.
+func RequiredApprovalsIn(vs ...int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsNotIn applies the NotIn predicate on the "required_approvals" field.
+func RequiredApprovalsNotIn(vs ...int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsGT applies the GT predicate on the "required_approvals" field.
+func RequiredApprovalsGT(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsGTE applies the GTE predicate on the "required_approvals" field.
+func RequiredApprovalsGTE(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGTE(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLT applies the LT predicate on the "required_approvals" field.
+func RequiredApprovalsLT(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLTE applies the LTE predicate on the "required_approvals" field.
+func RequiredApprovalsLTE(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLTE(FieldRequiredApprovals, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLTE(FieldPriority, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UnlockPolicy) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UnlockPolicy) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UnlockPolicy) predicate.UnlockPolicy {
+	return predicate.UnlockPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+)
+
+// UnlockPolicyCreate is the builder for creating a UnlockPolicy entity.
+type UnlockPolicyCreate struct {
+	config
+	mutation *UnlockPolicyMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *UnlockPolicyCreate) SetName(v string) *UnlockPolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetExpression sets the "expression" field.
+func (_c *UnlockPolicyCreate) SetExpression(v string) *UnlockPolicyCreate {
+	_c.mutation.SetExpression(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *UnlockPolicyCreate) SetAction(v unlockpolicy.Action) *UnlockPolicyCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_c *UnlockPolicyCreate) SetRequiredApprovals(v int) *UnlockPolicyCreate {
+	_c.mutation.SetRequiredApprovals(v)
+	return _c
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_c *UnlockPolicyCreate) SetNillableRequiredApprovals(v *int) *UnlockPolicyCreate {
+	if v != nil {
+		_c.SetRequiredApprovals(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *UnlockPolicyCreate) SetPriority(v int) *UnlockPolicyCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *UnlockPolicyCreate) SetNillablePriority(v *int) *UnlockPolicyCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UnlockPolicyCreate) SetCreatedAt(v time.Time) *UnlockPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UnlockPolicyCreate) SetNillableCreatedAt(v *time.Time) *UnlockPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UnlockPolicyMutation object of the builder.
+func (_c *UnlockPolicyCreate) Mutation() *UnlockPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the UnlockPolicy in the database.
+func (_c *UnlockPolicyCreate) Save(ctx context.Context) (*UnlockPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UnlockPolicyCreate) SaveX(ctx context.Context) *UnlockPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UnlockPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UnlockPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UnlockPolicyCreate) defaults() {
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		v := unlockpolicy.DefaultRequiredApprovals
+		_c.mutation.SetRequiredApprovals(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := unlockpolicy.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := unlockpolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UnlockPolicyCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "UnlockPolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := unlockpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Expression(); !ok {
+		return &ValidationError{Name: "expression", err: errors.New(`ent: missing required field "UnlockPolicy.expression"`)}
+	}
+	if v, ok := _c.mutation.Expression(); ok {
+		if err := unlockpolicy.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.expression": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "UnlockPolicy.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := unlockpolicy.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		return &ValidationError{Name: "required_approvals", err: errors.New(`ent: missing required field "UnlockPolicy.required_approvals"`)}
+	}
+	if v, ok := _c.mutation.RequiredApprovals(); ok {
+		if err := unlockpolicy.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.required_approvals": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "UnlockPolicy.priority"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UnlockPolicy.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *UnlockPolicyCreate) sqlSave(ctx context.Context) (*UnlockPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UnlockPolicyCreate) createSpec() (*UnlockPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UnlockPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(unlockpolicy.Table, sqlgraph.NewFieldSpec(unlockpolicy.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(unlockpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Expression(); ok {
+		_spec.SetField(unlockpolicy.FieldExpression, field.TypeString, value)
+		_node.Expression = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(unlockpolicy.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.RequiredApprovals(); ok {
+		_spec.SetField(unlockpolicy.FieldRequiredApprovals, field.TypeInt, value)
+		_node.RequiredApprovals = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(unlockpolicy.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(unlockpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UnlockPolicyCreateBulk is the builder for creating many UnlockPolicy entities in bulk.
+type UnlockPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*UnlockPolicyCreate
+}
+
+// Save creates the UnlockPolicy entities in the database.
+func (_c *UnlockPolicyCreateBulk) Save(ctx context.Context) ([]*UnlockPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UnlockPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UnlockPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UnlockPolicyCreateBulk) SaveX(ctx context.Context) []*UnlockPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UnlockPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UnlockPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"git.rgst.io/homelab/klefki/internal/db/ent/predicate"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+)
+
+// UnlockPolicyUpdate is the builder for updating UnlockPolicy entities.
+type UnlockPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UnlockPolicyMutation
+}
+
+// Where appends a list predicates to the UnlockPolicyUpdate builder.
+func (_u *UnlockPolicyUpdate) Where(ps ...predicate.UnlockPolicy) *UnlockPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *UnlockPolicyUpdate) SetName(v string) *UnlockPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UnlockPolicyUpdate) SetNillableName(v *string) *UnlockPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetExpression sets the "expression" field.
+func (_u *UnlockPolicyUpdate) SetExpression(v string) *UnlockPolicyUpdate {
+	_u.mutation.SetExpression(v)
+	return _u
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (_u *UnlockPolicyUpdate) SetNillableExpression(v *string) *UnlockPolicyUpdate {
+	if v != nil {
+		_u.SetExpression(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *UnlockPolicyUpdate) SetAction(v unlockpolicy.Action) *UnlockPolicyUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *UnlockPolicyUpdate) SetNillableAction(v *unlockpolicy.Action) *UnlockPolicyUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *UnlockPolicyUpdate) SetRequiredApprovals(v int) *UnlockPolicyUpdate {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *UnlockPolicyUpdate) SetNillableRequiredApprovals(v *int) *UnlockPolicyUpdate {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *UnlockPolicyUpdate) AddRequiredApprovals(v int) *UnlockPolicyUpdate {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *UnlockPolicyUpdate) SetPriority(v int) *UnlockPolicyUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *UnlockPolicyUpdate) SetNillablePriority(v *int) *UnlockPolicyUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *UnlockPolicyUpdate) AddPriority(v int) *UnlockPolicyUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// Mutation returns the UnlockPolicyMutation object of the builder.
+func (_u *UnlockPolicyUpdate) Mutation() *UnlockPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UnlockPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UnlockPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UnlockPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UnlockPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UnlockPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := unlockpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Expression(); ok {
+		if err := unlockpolicy.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := unlockpolicy.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := unlockpolicy.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.required_approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UnlockPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(unlockpolicy.Table, unlockpolicy.Columns, sqlgraph.NewFieldSpec(unlockpolicy.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(unlockpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Expression(); ok {
+		_spec.SetField(unlockpolicy.FieldExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(unlockpolicy.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(unlockpolicy.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(unlockpolicy.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(unlockpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(unlockpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unlockpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UnlockPolicyUpdateOne is the builder for updating a single UnlockPolicy entity.
+type UnlockPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UnlockPolicyMutation
+}
+
+// SetName sets the "name" field.
+func (_u *UnlockPolicyUpdateOne) SetName(v string) *UnlockPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UnlockPolicyUpdateOne) SetNillableName(v *string) *UnlockPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetExpression sets the "expression" field.
+func (_u *UnlockPolicyUpdateOne) SetExpression(v string) *UnlockPolicyUpdateOne {
+	_u.mutation.SetExpression(v)
+	return _u
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (_u *UnlockPolicyUpdateOne) SetNillableExpression(v *string) *UnlockPolicyUpdateOne {
+	if v != nil {
+		_u.SetExpression(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *UnlockPolicyUpdateOne) SetAction(v unlockpolicy.Action) *UnlockPolicyUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *UnlockPolicyUpdateOne) SetNillableAction(v *unlockpolicy.Action) *UnlockPolicyUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *UnlockPolicyUpdateOne) SetRequiredApprovals(v int) *UnlockPolicyUpdateOne {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *UnlockPolicyUpdateOne) SetNillableRequiredApprovals(v *int) *UnlockPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *UnlockPolicyUpdateOne) AddRequiredApprovals(v int) *UnlockPolicyUpdateOne {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *UnlockPolicyUpdateOne) SetPriority(v int) *UnlockPolicyUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *UnlockPolicyUpdateOne) SetNillablePriority(v *int) *UnlockPolicyUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *UnlockPolicyUpdateOne) AddPriority(v int) *UnlockPolicyUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// Mutation returns the UnlockPolicyMutation object of the builder.
+func (_u *UnlockPolicyUpdateOne) Mutation() *UnlockPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UnlockPolicyUpdate builder.
+func (_u *UnlockPolicyUpdateOne) Where(ps ...predicate.UnlockPolicy) *UnlockPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UnlockPolicyUpdateOne) Select(field string, fields ...string) *UnlockPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UnlockPolicy entity.
+func (_u *UnlockPolicyUpdateOne) Save(ctx context.Context) (*UnlockPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UnlockPolicyUpdateOne) SaveX(ctx context.Context) *UnlockPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UnlockPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UnlockPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UnlockPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := unlockpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Expression(); ok {
+		if err := unlockpolicy.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := unlockpolicy.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := unlockpolicy.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "UnlockPolicy.required_approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UnlockPolicyUpdateOne) sqlSave(ctx context.Context) (_node *UnlockPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(unlockpolicy.Table, unlockpolicy.Columns, sqlgraph.NewFieldSpec(unlockpolicy.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UnlockPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unlockpolicy.FieldID)
+		for _, f := range fields {
+			if !unlockpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != unlockpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(unlockpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Expression(); ok {
+		_spec.SetField(unlockpolicy.FieldExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(unlockpolicy.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(unlockpolicy.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(unlockpolicy.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(unlockpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(unlockpolicy.FieldPriority, field.TypeInt, value)
+	}
+	_node = &UnlockPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unlockpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
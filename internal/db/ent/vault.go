@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"git.rgst.io/homelab/klefki/internal/db/ent/vault"
+)
+
+// Vault is the model entity for the Vault schema.
+type Vault struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SHA256 hash of the master key, used to verify it when unsealing
+	KeyHash []byte `json:"key_hash,omitempty"`
+	// When the vault was initialized
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Vault) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vault.FieldKeyHash:
+			values[i] = new([]byte)
+		case vault.FieldID:
+			values[i] = new(sql.NullInt64)
+		case vault.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Vault fields.
+func (_m *Vault) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vault.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case vault.FieldKeyHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field key_hash", values[i])
+			} else if value != nil {
+				_m.KeyHash = *value
+			}
+		case vault.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Vault.
+// This includes values selected through modifiers, order, etc.
+func (_m *Vault) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Vault.
+// Note that you need to call Vault.Unwrap() before calling this method if this Vault
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Vault) Update() *VaultUpdateOne {
+	return NewVaultClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Vault entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Vault) Unwrap() *Vault {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Vault is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Vault) String() string {
+	var builder strings.Builder
+	builder.WriteString("Vault(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key_hash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyHash))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Vaults is a parsable slice of Vault.
+type Vaults []*Vault
@@ -0,0 +1,149 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package enrollment implements machines enrolling themselves with
+// one-time tokens created by operators, so that their private keys are
+// generated on, and never leave, the machine.
+package enrollment
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enrollmenttoken"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/registry"
+)
+
+// ErrInvalidToken is returned when an enrollment token doesn't exist,
+// has expired or has already been used. The reason isn't given to the
+// machine, so that tokens can't be probed.
+var ErrInvalidToken = errors.New("invalid enrollment token")
+
+// Options are the options of the machine enrolled with a token.
+type Options struct {
+	// Labels are the labels of the machine.
+	Labels map[string]string
+
+	// RequiredApprovals is the number of distinct operators that must
+	// approve the sessions of the machine.
+	RequiredApprovals int
+
+	// RequireConfirmation requires an operator to confirm the machine
+	// before it can request keys.
+	RequireConfirmation bool
+}
+
+// CreateToken creates a token the machine with the provided name can
+// enroll itself with once, until ttl has passed. Returned is the token,
+// which isn't stored.
+func CreateToken(ctx context.Context, db *ent.Client, name string, ttl time.Duration, opts *Options) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	if err := db.EnrollmentToken.Create().
+		SetTokenHash(HashToken(token)).
+		SetMachineName(name).
+		SetLabels(opts.Labels).
+		SetRequiredApprovals(opts.RequiredApprovals).
+		SetRequireConfirmation(opts.RequireConfirmation).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Exec(ctx); err != nil {
+		return "", fmt.Errorf("failed to create enrollment token: %w", err)
+	}
+	return token, nil
+}
+
+// HashToken returns the hash of the provided token, as stored.
+func HashToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
+
+// Enroll uses the provided token to create a machine with the provided
+// public key, bound to the provided hardware attributes, if any. The
+// token is marked as used in the same transaction, so that it can only
+// be used once.
+func Enroll(ctx context.Context, db *ent.Client, token string, pubKey ed25519.PublicKey,
+	hardware map[string]string) (*ent.Machine, error) {
+	fprint, err := machines.Fingerprint(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	id := registry.NewID()
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // Why: No-op after commit.
+
+	t, err := tx.EnrollmentToken.Query().Where(enrollmenttoken.TokenHash(HashToken(token))).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to get enrollment token: %w", err)
+	}
+	if t.UsedAt != nil || time.Now().After(t.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	// Only mark the token as used if it wasn't by a concurrent request.
+	used, err := tx.EnrollmentToken.Update().
+		Where(enrollmenttoken.ID(t.ID), enrollmenttoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		SetMachineID(id).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use enrollment token: %w", err)
+	}
+	if used == 0 {
+		return nil, ErrInvalidToken
+	}
+
+	mc := tx.Machine.Create().
+		SetID(id).
+		SetName(t.MachineName).
+		SetPublicKey(pubKey).
+		SetFingerprint(fprint).
+		SetLabels(t.Labels).
+		SetRequiredApprovals(t.RequiredApprovals).
+		SetPendingConfirmation(t.RequireConfirmation)
+	if len(hardware) != 0 {
+		mc.SetHardware(hardware)
+	}
+	m, err := mc.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create machine: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit enrollment: %w", err)
+	}
+	return m.Unwrap(), nil
+}
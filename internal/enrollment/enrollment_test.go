@@ -0,0 +1,191 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package enrollment
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"maps"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	"git.rgst.io/homelab/klefki/internal/machines"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// newTestDB returns a new, empty, DB.
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newPublicKey generates a new public key to enroll.
+func newPublicKey(t *testing.T) ed25519.PublicKey {
+	t.Helper()
+
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return pub
+}
+
+func TestEnroll(t *testing.T) {
+	hardware := map[string]string{"product_uuid": "4c4c4544", "mac_address": "02:00:00:00:00:01"}
+	tests := []struct {
+		name     string
+		opts     *Options
+		hardware map[string]string
+	}{
+		{name: "defaults", opts: &Options{}},
+		{
+			name: "options",
+			opts: &Options{Labels: map[string]string{"role": "storage"}, RequiredApprovals: 2, RequireConfirmation: true},
+		},
+		{name: "hardware", opts: &Options{}, hardware: hardware},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := newTestDB(t)
+
+			token, err := CreateToken(ctx, db, "nas1", time.Hour, tt.opts)
+			if err != nil {
+				t.Fatalf("CreateToken() error = %v", err)
+			}
+
+			pub := newPublicKey(t)
+			m, err := Enroll(ctx, db, token, pub, tt.hardware)
+			if err != nil {
+				t.Fatalf("Enroll() error = %v", err)
+			}
+
+			fprint, err := machines.Fingerprint(pub)
+			if err != nil {
+				t.Fatalf("Fingerprint() error = %v", err)
+			}
+			if m.Name != "nas1" || m.Fingerprint != fprint || !pub.Equal(ed25519.PublicKey(m.PublicKey)) {
+				t.Errorf("Enroll() = %s (%s), want nas1 (%s)", m.Name, m.Fingerprint, fprint)
+			}
+			if m.ID == m.Fingerprint {
+				t.Error("Enroll() used the fingerprint as the ID, want a stable ID")
+			}
+			if !maps.Equal(m.Labels, tt.opts.Labels) || m.RequiredApprovals != tt.opts.RequiredApprovals ||
+				m.PendingConfirmation != tt.opts.RequireConfirmation {
+				t.Errorf("Enroll() = labels %v, %d approvals, pending %v, want %v, %d, %v",
+					m.Labels, m.RequiredApprovals, m.PendingConfirmation,
+					tt.opts.Labels, tt.opts.RequiredApprovals, tt.opts.RequireConfirmation)
+			}
+			if !maps.Equal(m.Hardware, tt.hardware) {
+				t.Errorf("Enroll() bound to hardware %v, want %v", m.Hardware, tt.hardware)
+			}
+
+			stored, err := db.Machine.Get(ctx, m.ID)
+			if err != nil {
+				t.Fatalf("failed to get enrolled machine: %v", err)
+			}
+			if !maps.Equal(stored.Hardware, tt.hardware) {
+				t.Errorf("stored machine bound to hardware %v, want %v", stored.Hardware, tt.hardware)
+			}
+		})
+	}
+}
+
+func TestEnrollInvalidToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token func(t *testing.T, db *ent.Client) string
+	}{
+		{
+			name:  "unknown",
+			token: func(*testing.T, *ent.Client) string { return "not-a-token" },
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T, db *ent.Client) string {
+				token, err := CreateToken(context.Background(), db, "nas1", -time.Second, &Options{})
+				if err != nil {
+					t.Fatalf("CreateToken() error = %v", err)
+				}
+				return token
+			},
+		},
+		{
+			name: "used",
+			token: func(t *testing.T, db *ent.Client) string {
+				token, err := CreateToken(context.Background(), db, "nas1", time.Hour, &Options{})
+				if err != nil {
+					t.Fatalf("CreateToken() error = %v", err)
+				}
+				if _, err := Enroll(context.Background(), db, token, newPublicKey(t), nil); err != nil {
+					t.Fatalf("Enroll() error = %v", err)
+				}
+				return token
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			token := tt.token(t, db)
+
+			_, err := Enroll(context.Background(), db, token, newPublicKey(t), nil)
+			if !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Enroll() error = %v, want %v", err, ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestCreateToken(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	token, err := CreateToken(ctx, db, "nas1", time.Hour, &Options{})
+	if err != nil {
+		t.Fatalf("CreateToken() error = %v", err)
+	}
+	other, err := CreateToken(ctx, db, "nas1", time.Hour, &Options{})
+	if err != nil {
+		t.Fatalf("CreateToken() error = %v", err)
+	}
+	if token == other {
+		t.Error("CreateToken() returned the same token twice")
+	}
+
+	// Only the hash of the token is stored.
+	tokens, err := db.EnrollmentToken.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to list tokens: %v", err)
+	}
+	for _, et := range tokens {
+		if string(et.TokenHash) == token || string(et.TokenHash) == other {
+			t.Error("token is stored in the clear")
+		}
+	}
+}
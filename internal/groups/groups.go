@@ -0,0 +1,80 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package groups implements named groups of machines, so that operators
+// can act on many machines at once, e.g., after a power cut.
+package groups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinegroup"
+	"git.rgst.io/homelab/klefki/internal/labels"
+)
+
+// Get returns the group with the provided name.
+func Get(ctx context.Context, db *ent.Client, name string) (*ent.MachineGroup, error) {
+	g, err := db.MachineGroup.Query().Where(machinegroup.Name(name)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("group %q not found", name)
+		}
+		return nil, fmt.Errorf("failed to get group %q: %w", name, err)
+	}
+	return g, nil
+}
+
+// Contains returns if the provided machine is in the provided group,
+// either by its ID or because its labels match the selector of the
+// group.
+func Contains(g *ent.MachineGroup, m *ent.Machine) (bool, error) {
+	if slices.Contains(g.MachineIds, m.ID) {
+		return true, nil
+	}
+	if g.Selector == "" {
+		return false, nil
+	}
+
+	selector, err := labels.ParseSelector(g.Selector)
+	if err != nil {
+		return false, fmt.Errorf("group %q has an invalid selector: %w", g.Name, err)
+	}
+	return selector.Matches(m.Labels), nil
+}
+
+// Members returns the machines in the provided group.
+func Members(ctx context.Context, db *ent.Client, g *ent.MachineGroup) ([]*ent.Machine, error) {
+	ms, err := db.Machine.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machines: %w", err)
+	}
+
+	members := make([]*ent.Machine, 0, len(ms))
+	for _, m := range ms {
+		ok, err := Contains(g, m)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			members = append(members, m)
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package groups
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+func TestContains(t *testing.T) {
+	m := &ent.Machine{ID: "id1", Labels: map[string]string{"role": "storage"}}
+
+	tests := []struct {
+		name    string
+		group   *ent.MachineGroup
+		want    bool
+		wantErr bool
+	}{
+		{name: "by ID", group: &ent.MachineGroup{MachineIds: []string{"id2", "id1"}}, want: true},
+		{name: "by selector", group: &ent.MachineGroup{Selector: "role=storage"}, want: true},
+		{name: "selector doesn't match", group: &ent.MachineGroup{Selector: "role=web"}},
+		{name: "neither", group: &ent.MachineGroup{MachineIds: []string{"id2"}}},
+		{name: "empty", group: &ent.MachineGroup{}},
+		{name: "invalid selector", group: &ent.MachineGroup{Selector: "=storage"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Contains(tt.group, m)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Contains() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMembers(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	defer db.Close()
+
+	for _, m := range []struct {
+		id     string
+		labels map[string]string
+	}{
+		{id: "nas1", labels: map[string]string{"role": "storage"}},
+		{id: "nas2", labels: map[string]string{"role": "storage"}},
+		{id: "backup1", labels: map[string]string{"role": "backup"}},
+		{id: "web1", labels: map[string]string{"role": "web"}},
+	} {
+		err := db.Machine.Create().SetID(m.id).SetName(m.id).SetFingerprint(m.id).SetPublicKey([]byte(m.id)).
+			SetLabels(m.labels).Exec(ctx)
+		if err != nil {
+			t.Fatalf("failed to create machine: %v", err)
+		}
+	}
+	if err := db.MachineGroup.Create().SetName("storage").SetSelector("role=storage").
+		SetMachineIds([]string{"backup1"}).Exec(ctx); err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+
+	g, err := Get(ctx, db, "storage")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	members, err := Members(ctx, db, g)
+	if err != nil {
+		t.Fatalf("Members() error = %v", err)
+	}
+
+	var got []string
+	for _, m := range members {
+		got = append(got, m.ID)
+	}
+	slices.Sort(got)
+	if want := []string{"backup1", "nas1", "nas2"}; !slices.Equal(got, want) {
+		t.Errorf("Members() = %v, want %v", got, want)
+	}
+
+	if _, err := Get(ctx, db, "web"); err == nil {
+		t.Error("Get() of an unknown group error = nil, want error")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package hardware implements binding machines to the hardware
+// attributes they report, so that a copied machine key can't be used
+// from another box.
+package hardware
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// Keys of the hardware attributes as stored on machines.
+const (
+	// ProductUUID is the DMI product UUID of the machine.
+	ProductUUID = "product_uuid"
+
+	// BoardSerial is the serial number of the mainboard of the
+	// machine.
+	BoardSerial = "board_serial"
+
+	// MACAddress is the MAC address of the primary NIC of the machine.
+	MACAddress = "mac_address"
+)
+
+// ToMap converts the provided hardware attributes to how they are
+// stored on machines, omitting attributes that weren't reported.
+func ToMap(hw *pbgrpcv1.HardwareAttributes) map[string]string {
+	m := make(map[string]string)
+	if v := hw.GetProductUuid(); v != "" {
+		m[ProductUUID] = strings.ToLower(v)
+	}
+	if v := hw.GetBoardSerial(); v != "" {
+		m[BoardSerial] = v
+	}
+	if v := hw.GetMacAddress(); v != "" {
+		m[MACAddress] = strings.ToLower(v)
+	}
+	return m
+}
+
+// Mismatches returns the keys of the bound attributes that differ from,
+// or are missing in, the reported attributes, sorted.
+func Mismatches(bound, reported map[string]string) []string {
+	var mismatches []string
+	for k, v := range bound {
+		if reported[k] != v {
+			mismatches = append(mismatches, k)
+		}
+	}
+	slices.Sort(mismatches)
+	return mismatches
+}
+
+// Format returns a human readable representation of the provided
+// hardware attributes.
+func Format(attrs map[string]string) string {
+	if len(attrs) == 0 {
+		return "none"
+	}
+
+	pairs := make([]string, 0, len(attrs))
+	for _, k := range []string{ProductUUID, BoardSerial, MACAddress} {
+		if v, ok := attrs[k]; ok {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
+	return strings.Join(pairs, " ")
+}
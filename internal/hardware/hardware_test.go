@@ -0,0 +1,93 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package hardware
+
+import (
+	"slices"
+	"testing"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+func TestToMap(t *testing.T) {
+	hw := &pbgrpcv1.HardwareAttributes{}
+	hw.SetProductUuid("4C4C4544-0000-1000-8000-000000000000")
+	hw.SetMacAddress("52:54:00:AB:CD:EF")
+
+	got := ToMap(hw)
+	want := map[string]string{
+		ProductUUID: "4c4c4544-0000-1000-8000-000000000000",
+		MACAddress:  "52:54:00:ab:cd:ef",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ToMap() = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("ToMap()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+
+	if got := ToMap(nil); len(got) != 0 {
+		t.Errorf("ToMap(nil) = %v, want empty", got)
+	}
+}
+
+func TestMismatches(t *testing.T) {
+	bound := map[string]string{ProductUUID: "uuid", BoardSerial: "serial"}
+
+	tests := []struct {
+		name     string
+		reported map[string]string
+		want     []string
+	}{
+		{name: "match", reported: map[string]string{ProductUUID: "uuid", BoardSerial: "serial"}},
+		{name: "extra", reported: map[string]string{ProductUUID: "uuid", BoardSerial: "serial", MACAddress: "mac"}},
+		{name: "changed", reported: map[string]string{ProductUUID: "uuid", BoardSerial: "other"}, want: []string{BoardSerial}},
+		{name: "missing", reported: map[string]string{}, want: []string{BoardSerial, ProductUUID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mismatches(bound, tt.reported); !slices.Equal(got, tt.want) {
+				t.Errorf("Mismatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs map[string]string
+		want  string
+	}{
+		{name: "empty", want: "none"},
+		{
+			name:  "ordered",
+			attrs: map[string]string{MACAddress: "mac", ProductUUID: "uuid"},
+			want:  "product_uuid=uuid mac_address=mac",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.attrs); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
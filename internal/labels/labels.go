@@ -0,0 +1,179 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package labels implements selecting machines by their labels, with
+// selectors like those of Kubernetes.
+package labels
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// operator is the operator of a [requirement].
+type operator int
+
+// Operators of a [requirement].
+const (
+	opEquals operator = iota
+	opNotEquals
+	opIn
+	opNotIn
+	opExists
+	opNotExists
+)
+
+// requirement is a single requirement of a [Selector], e.g., "k=v".
+type requirement struct {
+	key    string
+	op     operator
+	values []string
+}
+
+// matches returns if the provided labels meet the requirement.
+func (r *requirement) matches(labels map[string]string) bool {
+	v, ok := labels[r.key]
+	switch r.op {
+	case opEquals, opIn:
+		return ok && slices.Contains(r.values, v)
+	case opNotEquals, opNotIn:
+		return !ok || !slices.Contains(r.values, v)
+	case opExists:
+		return ok
+	case opNotExists:
+		return !ok
+	}
+	return false
+}
+
+// Selector selects labels meeting all of its requirements.
+type Selector []requirement
+
+// Matches returns if the provided labels meet all requirements of the
+// selector. An empty selector matches all labels.
+func (s Selector) Matches(labels map[string]string) bool {
+	for i := range s {
+		if !s[i].matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseSelector parses a comma separated list of requirements into a
+// [Selector]. Requirements are one of:
+//
+//   - key=value or key==value, the label is set to value.
+//   - key!=value, the label isn't set to value, or isn't set.
+//   - key in (a,b), the label is set to one of the values.
+//   - key notin (a,b), the label isn't set to any of the values.
+//   - key, the label is set.
+//   - !key, the label isn't set.
+func ParseSelector(selector string) (Selector, error) {
+	var s Selector
+	for _, raw := range split(selector) {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		r, err := parseRequirement(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid requirement %q: %w", raw, err)
+		}
+		s = append(s, *r)
+	}
+	return s, nil
+}
+
+// parseRequirement parses a single requirement of a selector.
+func parseRequirement(raw string) (*requirement, error) {
+	if key, ok := strings.CutPrefix(raw, "!"); ok {
+		return newRequirement(key, opNotExists, nil)
+	}
+	if key, value, ok := strings.Cut(raw, "!="); ok {
+		return newRequirement(key, opNotEquals, []string{value})
+	}
+	if key, value, ok := strings.Cut(raw, "=="); ok {
+		return newRequirement(key, opEquals, []string{value})
+	}
+	if key, value, ok := strings.Cut(raw, "="); ok {
+		return newRequirement(key, opEquals, []string{value})
+	}
+
+	fields := strings.Fields(raw)
+	if len(fields) == 1 {
+		return newRequirement(fields[0], opExists, nil)
+	}
+
+	key, rest, _ := strings.Cut(raw, " ")
+	rest = strings.TrimSpace(rest)
+	op := opIn
+	switch {
+	case strings.HasPrefix(rest, "in"):
+		rest = strings.TrimPrefix(rest, "in")
+	case strings.HasPrefix(rest, "notin"):
+		rest, op = strings.TrimPrefix(rest, "notin"), opNotIn
+	default:
+		return nil, fmt.Errorf("expected in or notin")
+	}
+
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return nil, fmt.Errorf("expected a parenthesized list of values")
+	}
+
+	var values []string
+	for v := range strings.SplitSeq(rest[1:len(rest)-1], ",") {
+		values = append(values, strings.TrimSpace(v))
+	}
+	return newRequirement(key, op, values)
+}
+
+// newRequirement creates a requirement, trimming the provided key and
+// values.
+func newRequirement(key string, op operator, values []string) (*requirement, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, fmt.Errorf("missing key")
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return &requirement{key: key, op: op, values: values}, nil
+}
+
+// split splits the provided selector on commas outside of parentheses.
+func split(selector string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
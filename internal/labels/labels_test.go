@@ -0,0 +1,88 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package labels
+
+import "testing"
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		wantLen  int
+		wantErr  bool
+	}{
+		{name: "empty", selector: ""},
+		{name: "equals", selector: "role=storage", wantLen: 1},
+		{name: "double equals", selector: "role==storage", wantLen: 1},
+		{name: "not equals", selector: "role!=storage", wantLen: 1},
+		{name: "in", selector: "zone in (a, b)", wantLen: 1},
+		{name: "notin", selector: "zone notin (a,b)", wantLen: 1},
+		{name: "exists", selector: "role", wantLen: 1},
+		{name: "not exists", selector: "!role", wantLen: 1},
+		{name: "multiple", selector: "role=storage, zone in (a,b), !rack", wantLen: 3},
+		{name: "missing key", selector: "=storage", wantErr: true},
+		{name: "unknown operator", selector: "zone within (a)", wantErr: true},
+		{name: "unparenthesized values", selector: "zone in a,b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSelector(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSelector(%q) error = %v, wantErr %v", tt.selector, err, tt.wantErr)
+			}
+			if len(got) != tt.wantLen {
+				t.Errorf("ParseSelector(%q) has %d requirements, want %d", tt.selector, len(got), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{"role": "storage", "zone": "a"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "", want: true},
+		{selector: "role=storage", want: true},
+		{selector: "role=web"},
+		{selector: "role!=web", want: true},
+		{selector: "rack!=1", want: true},
+		{selector: "zone in (a,b)", want: true},
+		{selector: "zone in (b,c)"},
+		{selector: "zone notin (b,c)", want: true},
+		{selector: "rack notin (1)", want: true},
+		{selector: "role", want: true},
+		{selector: "rack"},
+		{selector: "!rack", want: true},
+		{selector: "!role"},
+		{selector: "role=storage,zone=a", want: true},
+		{selector: "role=storage,zone=b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			s, err := ParseSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) error = %v", tt.selector, err)
+			}
+			if got := s.Matches(labels); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,16 +20,20 @@
 package machines
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"sync"
 
-	"git.rgst.io/homelab/klefki/internal/db/ent"
 	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/sigtool/v3/sign"
 )
 
 // Fingerprint returns a fingerprint of the provided key.
@@ -47,7 +51,7 @@ type Machine struct {
 	fingerprint string
 
 	// PublicKey is the public key for this machine. This is always set
-	// when initialized through [MachineFromDB] or [Machine].
+	// when initialized through [NewMachine].
 	PublicKey ed25519.PublicKey
 
 	// PrivateKey is the private key for this machine. This is normally
@@ -109,12 +113,6 @@ func (m *Machine) EncodePublicKey() (string, error) {
 	return string(encoded), nil
 }
 
-// MachineFromDB creates a [Machine] from a [ent.Machine]. Note that the
-// private key will never be set in this case as it is no longer known.
-func MachineFromDB(m *ent.Machine) *Machine {
-	return &Machine{fingerprint: m.ID, PublicKey: ed25519.PublicKey(m.PublicKey)}
-}
-
 // NewMachine creates a new [Machine] with the private key included.
 func NewMachine() (*Machine, error) {
 	pub, priv, err := ed25519.GenerateKey(nil)
@@ -148,20 +146,225 @@ func DecodePrivateKey(data []byte) (ed25519.PrivateKey, error) {
 	return pk, nil
 }
 
+// DecodePublicKey decodes a public key that was encoded by
+// [Machine.EncodePublicKey].
+func DecodePublicKey(data []byte) (ed25519.PublicKey, error) {
+	b, _ := pem.Decode(data)
+	if b == nil {
+		return nil, fmt.Errorf("failed to parse public key as PEM encoded data")
+	}
+	if b.Type != "ED25519 PUBLIC KEY" {
+		return nil, fmt.Errorf("expected type \"ED25519 PUBLIC KEY\", got %s", b.Type)
+	}
+
+	k, err := x509.ParsePKIXPublicKey(b.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := k.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected ed25519.PublicKey, got %T", k)
+	}
+	return pk, nil
+}
+
 // Verify takes the provided pubKey and determines if the provided
 // signature was made by it, for the nonce. A nil error is success.
 func Verify(pubKey ed25519.PublicKey, sig []byte, nonce string) error {
-	if ed25519.Verify(pubKey, []byte(nonce), sig) {
+	return verify(pubKey, sig, []byte(nonce))
+}
+
+// KeyRequestMessage returns the message signed by a machine when
+// requesting its key, covering all fields of the request.
+func KeyRequestMessage(req *pbgrpcv1.GetKeyRequest) []byte {
+	bm := req.GetBootMeasurements()
+	hw := req.GetHardware()
+	parts := []string{
+		"getkey", req.GetMachineId(), req.GetNonce(), req.GetSignedAt(),
+		bm.GetKernel(), bm.GetInitramfs(), bm.GetCmdline(),
+		hw.GetProductUuid(), hw.GetBoardSerial(), hw.GetMacAddress(),
+	}
+	if secret := req.GetSecret(); secret != "" {
+		parts = append(parts, secret)
+	}
+	return []byte(strings.Join(parts, "\n"))
+}
+
+// SignKeyRequest signs the provided key request with the provided
+// private key.
+func SignKeyRequest(pk ed25519.PrivateKey, req *pbgrpcv1.GetKeyRequest) {
+	req.SetSignature(ed25519.Sign(pk, KeyRequestMessage(req)))
+}
+
+// VerifyKeyRequest verifies the signature of the provided key request
+// was made by the provided public key.
+func VerifyKeyRequest(pubKey ed25519.PublicKey, req *pbgrpcv1.GetKeyRequest) error {
+	return verify(pubKey, req.GetSignature(), KeyRequestMessage(req))
+}
+
+// VerifyLegacyKeyRequest verifies the signature of the provided key
+// request was made by the provided public key over only its nonce, as
+// older clients sign it. Nothing else in such a request is authenticated.
+func VerifyLegacyKeyRequest(pubKey ed25519.PublicKey, req *pbgrpcv1.GetKeyRequest) error {
+	return verify(pubKey, req.GetSignature(), []byte(req.GetNonce()))
+}
+
+// KeyResponseMessage returns the message signed by the server when
+// responding to the key request with the provided nonce.
+func KeyResponseMessage(nonce string, resp *pbgrpcv1.GetKeyResponse) []byte {
+	parts := []string{
+		"getkey-response", nonce, strconv.Itoa(int(resp.GetShareIndex())),
+		base64.StdEncoding.EncodeToString(resp.GetEncKey()),
+	}
+	for _, encShare := range resp.GetEncShares() {
+		parts = append(parts, base64.StdEncoding.EncodeToString(encShare))
+	}
+	return []byte(strings.Join(parts, "\n"))
+}
+
+// SignKeyResponse signs the provided response to the key request with
+// the provided nonce with the provided private key of the server.
+func SignKeyResponse(identity ed25519.PrivateKey, nonce string, resp *pbgrpcv1.GetKeyResponse) {
+	resp.SetSignature(ed25519.Sign(identity, KeyResponseMessage(nonce, resp)))
+}
+
+// VerifyKeyResponse verifies the signature of the provided response to
+// the key request with the provided nonce was made by the server with
+// the provided identity.
+func VerifyKeyResponse(identity ed25519.PublicKey, nonce string, resp *pbgrpcv1.GetKeyResponse) error {
+	return verify(identity, resp.GetSignature(), KeyResponseMessage(nonce, resp))
+}
+
+// EnrollMessage returns the message signed by a machine when enrolling
+// itself with the provided token, proving it holds the private key of
+// the public key being enrolled and covering its hardware attributes.
+func EnrollMessage(req *pbgrpcv1.EnrollRequest) []byte {
+	hw := req.GetHardware()
+	return []byte(strings.Join([]string{
+		"enroll", req.GetToken(), base64.StdEncoding.EncodeToString(req.GetPublicKey()),
+		hw.GetProductUuid(), hw.GetBoardSerial(), hw.GetMacAddress(),
+	}, "\n"))
+}
+
+// SignEnrollRequest sets the public key of the provided private key on
+// the provided enroll request and signs it.
+func SignEnrollRequest(pk ed25519.PrivateKey, req *pbgrpcv1.EnrollRequest) {
+	req.SetPublicKey(pk.Public().(ed25519.PublicKey))
+	req.SetSignature(ed25519.Sign(pk, EnrollMessage(req)))
+}
+
+// VerifyEnrollRequest verifies the signature of the provided enroll
+// request was made by the public key being enrolled.
+func VerifyEnrollRequest(req *pbgrpcv1.EnrollRequest) error {
+	if len(req.GetPublicKey()) != ed25519.PublicKeySize {
+		return fmt.Errorf("public key must be %d bytes, got %d", ed25519.PublicKeySize, len(req.GetPublicKey()))
+	}
+	return verify(req.GetPublicKey(), req.GetSignature(), EnrollMessage(req))
+}
+
+// RotateKeyMessage returns the message signed by a machine with both
+// its current and its new private key when rotating its key.
+func RotateKeyMessage(req *pbgrpcv1.RotateMachineKeyRequest) []byte {
+	return []byte(strings.Join([]string{
+		"rotatekey", req.GetMachineId(), req.GetNonce(), req.GetSignedAt(),
+		base64.StdEncoding.EncodeToString(req.GetNewPublicKey()),
+	}, "\n"))
+}
+
+// SignRotateKeyRequest sets the public key of newPK on the provided
+// rotate key request and signs it with both pk, the current private
+// key, and newPK.
+func SignRotateKeyRequest(pk, newPK ed25519.PrivateKey, req *pbgrpcv1.RotateMachineKeyRequest) {
+	req.SetNewPublicKey(newPK.Public().(ed25519.PublicKey))
+	msg := RotateKeyMessage(req)
+	req.SetSignature(ed25519.Sign(pk, msg))
+	req.SetNewSignature(ed25519.Sign(newPK, msg))
+}
+
+// VerifyRotateKeyRequest verifies the provided rotate key request was
+// signed by the provided current public key, and by the new public key
+// being rotated to.
+func VerifyRotateKeyRequest(pubKey ed25519.PublicKey, req *pbgrpcv1.RotateMachineKeyRequest) error {
+	if len(req.GetNewPublicKey()) != ed25519.PublicKeySize {
+		return fmt.Errorf("new public key must be %d bytes, got %d", ed25519.PublicKeySize, len(req.GetNewPublicKey()))
+	}
+
+	msg := RotateKeyMessage(req)
+	if err := verify(pubKey, req.GetSignature(), msg); err != nil {
+		return err
+	}
+	if err := verify(req.GetNewPublicKey(), req.GetNewSignature(), msg); err != nil {
+		return fmt.Errorf("new key: %w", err)
+	}
+	return nil
+}
+
+// verify verifies that sig is a signature of msg by pubKey.
+func verify(pubKey ed25519.PublicKey, sig, msg []byte) error {
+	if ed25519.Verify(pubKey, msg, sig) {
 		return nil
 	}
 
 	return fmt.Errorf("invalid signature")
 }
 
-// GRPCMachine converts a [ent.Machine] into a [pbgrpcv1.Machine].
-func GRPCMachine(m *ent.Machine) *pbgrpcv1.Machine {
-	return (&pbgrpcv1.Machine_builder{
-		Id:        &m.ID,
-		PublicKey: m.PublicKey,
-	}).Build()
+// Encrypt encrypts the provided data to the provided public key, such
+// that it can only be decrypted by the holder of the private key
+// through [Decrypt].
+func Encrypt(pubKey ed25519.PublicKey, data []byte) ([]byte, error) {
+	spubk, err := sign.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pub key for encryption: %w", err)
+	}
+
+	enc, err := sign.NewEncryptor(nil, 1024)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryptor instance: %w", err)
+	}
+
+	if err := enc.AddRecipient(spubk); err != nil {
+		return nil, fmt.Errorf("failed to add public key to encryptor: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := enc.Encrypt(bytes.NewReader(data), nopWriteCloser{&buf}); err != nil {
+		return nil, fmt.Errorf("failed to encrypt: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decrypt decrypts data that was encrypted by [Encrypt] to the public
+// key of the provided private key.
+func Decrypt(privKey ed25519.PrivateKey, data []byte) ([]byte, error) {
+	spk, err := sign.PrivateKeyFromBytes(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create private key for decryption: %w", err)
+	}
+
+	dec, err := sign.NewDecryptor(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decryptor: %w", err)
+	}
+	if err := dec.SetPrivateKey(spk, nil); err != nil {
+		return nil, fmt.Errorf("failed to set private key on decryptor: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := dec.Decrypt(&buf); err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// nopWriteCloser is a no-op [io.WriteCloser]
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close implements [io.Closer]
+func (nwc nopWriteCloser) Close() error {
+	return nil
 }
@@ -0,0 +1,196 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package machines
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// newKeyRequest returns a key request reporting boot measurements.
+func newKeyRequest() *pbgrpcv1.GetKeyRequest {
+	bm := &pbgrpcv1.BootMeasurements{}
+	bm.SetKernel("kernel-hash")
+
+	hw := &pbgrpcv1.HardwareAttributes{}
+	hw.SetProductUuid("4c4c4544-0000-1000-8000-000000000000")
+
+	req := &pbgrpcv1.GetKeyRequest{}
+	req.SetMachineId("nas1")
+	req.SetNonce("nonce")
+	req.SetSignedAt("2026-10-18T00:00:00Z")
+	req.SetBootMeasurements(bm)
+	req.SetHardware(hw)
+	return req
+}
+
+func TestVerifyKeyRequest(t *testing.T) {
+	pub, pk, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(req *pbgrpcv1.GetKeyRequest)
+		pubKey     ed25519.PublicKey
+		wantErr    bool
+		wantLegacy bool
+	}{
+		{name: "valid", modify: func(*pbgrpcv1.GetKeyRequest) {}},
+		{name: "other key", modify: func(*pbgrpcv1.GetKeyRequest) {}, pubKey: otherPub, wantErr: true},
+		{name: "machine ID changed", modify: func(req *pbgrpcv1.GetKeyRequest) { req.SetMachineId("web1") }, wantErr: true},
+		{name: "nonce changed", modify: func(req *pbgrpcv1.GetKeyRequest) { req.SetNonce("other") }, wantErr: true},
+		{
+			name:    "signed at changed",
+			modify:  func(req *pbgrpcv1.GetKeyRequest) { req.SetSignedAt("2026-10-19T00:00:00Z") },
+			wantErr: true,
+		},
+		{
+			name:    "measurements removed",
+			modify:  func(req *pbgrpcv1.GetKeyRequest) { req.ClearBootMeasurements() },
+			wantErr: true,
+		},
+		{
+			name:    "measurements changed",
+			modify:  func(req *pbgrpcv1.GetKeyRequest) { req.GetBootMeasurements().SetCmdline("init=/bin/sh") },
+			wantErr: true,
+		},
+		{
+			name:    "hardware changed",
+			modify:  func(req *pbgrpcv1.GetKeyRequest) { req.GetHardware().SetMacAddress("52:54:00:00:00:01") },
+			wantErr: true,
+		},
+		{
+			name: "legacy signature",
+			modify: func(req *pbgrpcv1.GetKeyRequest) {
+				req.SetSignature(ed25519.Sign(pk, []byte(req.GetNonce())))
+			},
+			wantErr:    true,
+			wantLegacy: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newKeyRequest()
+			SignKeyRequest(pk, req)
+			tt.modify(req)
+
+			pubKey := pub
+			if tt.pubKey != nil {
+				pubKey = tt.pubKey
+			}
+			if err := VerifyKeyRequest(pubKey, req); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyKeyRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := VerifyLegacyKeyRequest(pubKey, req); (err == nil) != tt.wantLegacy {
+				t.Errorf("VerifyLegacyKeyRequest() error = %v, want legacy %v", err, tt.wantLegacy)
+			}
+		})
+	}
+}
+
+func TestVerifyKeyResponse(t *testing.T) {
+	pub, identity, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		nonce   string
+		modify  func(resp *pbgrpcv1.GetKeyResponse)
+		wantErr bool
+	}{
+		{name: "valid", nonce: "nonce", modify: func(*pbgrpcv1.GetKeyResponse) {}},
+		{name: "other nonce", nonce: "other", modify: func(*pbgrpcv1.GetKeyResponse) {}, wantErr: true},
+		{
+			name:    "key changed",
+			nonce:   "nonce",
+			modify:  func(resp *pbgrpcv1.GetKeyResponse) { resp.SetEncKey([]byte("other")) },
+			wantErr: true,
+		},
+		{
+			name:    "share index changed",
+			nonce:   "nonce",
+			modify:  func(resp *pbgrpcv1.GetKeyResponse) { resp.SetShareIndex(2) },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &pbgrpcv1.GetKeyResponse{}
+			resp.SetEncKey([]byte("key"))
+			SignKeyResponse(identity, "nonce", resp)
+			tt.modify(resp)
+
+			if err := VerifyKeyResponse(pub, tt.nonce, resp); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyKeyResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyEnrollRequest(t *testing.T) {
+	_, pk, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *pbgrpcv1.EnrollRequest)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*pbgrpcv1.EnrollRequest) {}},
+		{name: "token changed", modify: func(req *pbgrpcv1.EnrollRequest) { req.SetToken("other") }, wantErr: true},
+		{name: "public key replaced", modify: func(req *pbgrpcv1.EnrollRequest) { req.SetPublicKey(otherPub) }, wantErr: true},
+		{name: "public key truncated", modify: func(req *pbgrpcv1.EnrollRequest) { req.SetPublicKey(otherPub[:16]) }, wantErr: true},
+		{
+			name:    "hardware changed",
+			modify:  func(req *pbgrpcv1.EnrollRequest) { req.GetHardware().SetBoardSerial("other") },
+			wantErr: true,
+		},
+		{name: "hardware removed", modify: func(req *pbgrpcv1.EnrollRequest) { req.ClearHardware() }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hw := &pbgrpcv1.HardwareAttributes{}
+			hw.SetBoardSerial("serial")
+			req := &pbgrpcv1.EnrollRequest{}
+			req.SetToken("token")
+			req.SetHardware(hw)
+			SignEnrollRequest(pk, req)
+			tt.modify(req)
+
+			if err := VerifyEnrollRequest(req); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyEnrollRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package measurements implements checking the boot measurements
+// reported by machines against their allowlist of known-good
+// measurements.
+package measurements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/bootmeasurement"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// ErrMismatch is returned by [Check] when the reported measurements
+// aren't allowed.
+var ErrMismatch = errors.New("boot measurements are not allowed")
+
+// Check returns nil if the provided measurements match one of the
+// allowed measurements of the provided machine, or if the machine has
+// none. Otherwise, [ErrMismatch] is returned.
+func Check(ctx context.Context, db *ent.Client, machineID string, bm *pbgrpcv1.BootMeasurements) error {
+	allowed, err := db.BootMeasurement.Query().
+		Where(bootmeasurement.MachineID(machineID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get allowed boot measurements: %w", err)
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	// Machines with an allowlist must report what they booted.
+	if bm == nil {
+		return fmt.Errorf("%w: none were reported", ErrMismatch)
+	}
+
+	for _, a := range allowed {
+		if Matches(a, bm) {
+			return nil
+		}
+	}
+	return ErrMismatch
+}
+
+// HasAllowlist returns if the machine with the provided ID has an
+// allowlist of boot measurements.
+func HasAllowlist(ctx context.Context, db *ent.Client, machineID string) (bool, error) {
+	ok, err := db.BootMeasurement.Query().
+		Where(bootmeasurement.MachineID(machineID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get allowed boot measurements: %w", err)
+	}
+	return ok, nil
+}
+
+// Matches returns if the provided measurements are allowed by the
+// provided allowlist entry. Empty fields of the entry match anything.
+func Matches(allowed *ent.BootMeasurement, bm *pbgrpcv1.BootMeasurements) bool {
+	return matches(allowed.Kernel, bm.GetKernel()) &&
+		matches(allowed.Initramfs, bm.GetInitramfs()) &&
+		matches(allowed.Cmdline, bm.GetCmdline())
+}
+
+// matches returns if the reported hash is allowed by the allowed hash.
+func matches(allowed, reported string) bool {
+	return allowed == "" || strings.EqualFold(allowed, reported)
+}
+
+// Format returns a human readable representation of the provided
+// measurements.
+func Format(bm *pbgrpcv1.BootMeasurements) string {
+	if bm == nil {
+		return "none"
+	}
+	return fmt.Sprintf("kernel=%s initramfs=%s cmdline=%s", bm.GetKernel(), bm.GetInitramfs(), bm.GetCmdline())
+}
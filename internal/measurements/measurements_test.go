@@ -0,0 +1,89 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package measurements
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// newMeasurements returns boot measurements with the provided hashes.
+func newMeasurements(kernel, initramfs, cmdline string) *pbgrpcv1.BootMeasurements {
+	bm := &pbgrpcv1.BootMeasurements{}
+	bm.SetKernel(kernel)
+	bm.SetInitramfs(initramfs)
+	bm.SetCmdline(cmdline)
+	return bm
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	defer db.Close()
+
+	// nas1 allows two kernels with the same initramfs, and any command
+	// line. web1 has no allowlist.
+	for _, kernel := range []string{"aaaa", "bbbb"} {
+		if err := db.BootMeasurement.Create().SetMachineID("nas1").
+			SetKernel(kernel).SetInitramfs("cccc").Exec(ctx); err != nil {
+			t.Fatalf("failed to create allowlist entry: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		machineID string
+		bm        *pbgrpcv1.BootMeasurements
+		wantErr   error
+	}{
+		{name: "allowed", machineID: "nas1", bm: newMeasurements("aaaa", "cccc", "dddd")},
+		{name: "other allowed kernel", machineID: "nas1", bm: newMeasurements("bbbb", "cccc", "")},
+		{name: "case insensitive", machineID: "nas1", bm: newMeasurements("AAAA", "CCCC", "")},
+		{name: "unknown kernel", machineID: "nas1", bm: newMeasurements("eeee", "cccc", ""), wantErr: ErrMismatch},
+		{name: "unknown initramfs", machineID: "nas1", bm: newMeasurements("aaaa", "eeee", ""), wantErr: ErrMismatch},
+		{name: "none reported", machineID: "nas1", wantErr: ErrMismatch},
+		{name: "no allowlist", machineID: "web1", bm: newMeasurements("eeee", "eeee", "")},
+		{name: "no allowlist, none reported", machineID: "web1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Check(ctx, db, tt.machineID, tt.bm); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	for machineID, want := range map[string]bool{"nas1": true, "web1": false} {
+		got, err := HasAllowlist(ctx, db, machineID)
+		if err != nil {
+			t.Fatalf("HasAllowlist() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("HasAllowlist(%q) = %v, want %v", machineID, got, want)
+		}
+	}
+}
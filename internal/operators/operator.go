@@ -0,0 +1,107 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package operators contains all of the code for creating and managing
+// operators, the humans that approve key releases.
+package operators
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// NewOperator creates a new operator keypair. Operators use the same
+// ed25519 key material (and encoding) as machines do.
+func NewOperator() (*machines.Machine, error) {
+	return machines.NewMachine()
+}
+
+// Actions that operators sign requests for.
+const (
+	// ActionApprove approves the session of a machine. The subject is
+	// the ID of the machine.
+	ActionApprove = "approve"
+
+	// ActionDeny denies the session of a machine. The subject is the ID
+	// of the machine.
+	ActionDeny = "deny"
+
+	// ActionCancel cancels the session of a machine. The subject is the
+	// ID of the machine.
+	ActionCancel = "cancel"
+
+	// ActionUnseal unseals the vault. The subject is [KeyDigest] of
+	// the encrypted master key.
+	ActionUnseal = "unseal"
+
+	// ActionSeal seals the vault. There is no subject.
+	ActionSeal = "seal"
+)
+
+// RequestMessage returns the message an operator signs to perform the
+// provided action on the provided subject. Both are included so that a
+// signature can't be reused for a different action or subject.
+func RequestMessage(action, subject, nonce, signedAt string) []byte {
+	return []byte(strings.Join([]string{action, subject, nonce, signedAt}, "\n"))
+}
+
+// SignRequest signs a request to perform the provided action on the
+// provided subject.
+func SignRequest(pk ed25519.PrivateKey, action, subject, nonce, signedAt string) []byte {
+	return ed25519.Sign(pk, RequestMessage(action, subject, nonce, signedAt))
+}
+
+// VerifyRequest takes the provided pubKey and determines if the
+// provided signature is of a request to perform the provided action on
+// the provided subject. A nil error is success.
+func VerifyRequest(pubKey ed25519.PublicKey, sig []byte, action, subject, nonce, signedAt string) error {
+	if ed25519.Verify(pubKey, RequestMessage(action, subject, nonce, signedAt), sig) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid signature")
+}
+
+// KeyDigest returns a digest of the provided key, used as the subject
+// of requests that carry key material.
+func KeyDigest(key []byte) string {
+	sum := sha256.Sum256(key)
+	return base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// GRPCApproval creates a [pbgrpcv1.Approval] for the provided operator
+// approving at the given time.
+func GRPCApproval(o *ent.Operator, approvedAt time.Time) *pbgrpcv1.Approval {
+	return (&pbgrpcv1.Approval_builder{
+		OperatorId:   &o.ID,
+		OperatorName: &o.Name,
+		ApprovedAt:   ptr(approvedAt.Format(time.RFC3339Nano)),
+	}).Build()
+}
+
+// ptr returns a pointer to the provided value.
+func ptr[T any](v T) *T {
+	return &v
+}
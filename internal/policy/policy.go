@@ -0,0 +1,201 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package policy implements evaluating unlock policies, CEL expressions
+// that decide what happens to a key request.
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"github.com/google/cel-go/cel"
+)
+
+// Input contains the information about a key request that policies are
+// evaluated against.
+type Input struct {
+	// MachineID is the ID of the machine asking for its key.
+	MachineID string `json:"machine_id"`
+
+	// MachineName is the name of the machine asking for its key.
+	MachineName string `json:"machine_name"`
+
+	// Labels are the labels of the machine asking for its key.
+	Labels map[string]string `json:"labels"`
+
+	// Peer is the IP address the request was made from.
+	Peer string `json:"peer"`
+
+	// Metadata is the gRPC metadata sent with the request.
+	Metadata map[string]string `json:"metadata"`
+
+	// Now is the time the request was made.
+	Now time.Time `json:"now"`
+
+	// LastUnlocked is when a key was last released to the machine, zero
+	// if it never was. Policies see the unix epoch instead of zero.
+	LastUnlocked time.Time `json:"last_unlocked"`
+}
+
+// vars returns the variables exposed to policy expressions for the
+// input.
+func (in *Input) vars() map[string]any {
+	labels := in.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	metadata := in.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	// The zero time is too far in the past for durations relative to it
+	// to be represented, so use the unix epoch instead.
+	lastUnlocked := in.LastUnlocked
+	if lastUnlocked.IsZero() {
+		lastUnlocked = time.Unix(0, 0)
+	}
+
+	return map[string]any{
+		"machine_id":    in.MachineID,
+		"machine_name":  in.MachineName,
+		"labels":        labels,
+		"peer":          in.Peer,
+		"metadata":      metadata,
+		"now":           in.Now.UTC(),
+		"last_unlocked": lastUnlocked.UTC(),
+	}
+}
+
+// Engine compiles and evaluates policy expressions. Compiled expressions
+// are cached, so an Engine should be reused.
+type Engine struct {
+	env *cel.Env
+
+	mu       sync.Mutex
+	programs map[string]cel.Program
+}
+
+// NewEngine creates a new [Engine].
+func NewEngine() (*Engine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("machine_id", cel.StringType),
+		cel.Variable("machine_name", cel.StringType),
+		cel.Variable("labels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("peer", cel.StringType),
+		cel.Variable("metadata", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("now", cel.TimestampType),
+		cel.Variable("last_unlocked", cel.TimestampType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	return &Engine{env: env, programs: make(map[string]cel.Program)}, nil
+}
+
+// Compile compiles the provided expression, returning an error if it's
+// invalid or doesn't evaluate to a bool.
+func (e *Engine) Compile(expr string) error {
+	_, err := e.program(expr)
+	return err
+}
+
+// program returns the compiled program for the provided expression.
+func (e *Engine) program(expr string) (cel.Program, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if prg, ok := e.programs[expr]; ok {
+		return prg, nil
+	}
+
+	ast, iss := e.env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, got %s", ast.OutputType())
+	}
+
+	prg, err := e.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create program: %w", err)
+	}
+
+	e.programs[expr] = prg
+	return prg, nil
+}
+
+// Evaluate evaluates the provided policies, in order, against the
+// provided input and returns the first one that applies. If none
+// apply, nil is returned.
+func (e *Engine) Evaluate(policies []*ent.UnlockPolicy, in *Input) (*ent.UnlockPolicy, error) {
+	vars := in.vars()
+	for _, p := range policies {
+		prg, err := e.program(p.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: %w", p.Name, err)
+		}
+
+		out, _, err := prg.Eval(vars)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate policy %q: %w", p.Name, err)
+		}
+
+		if applies, ok := out.Value().(bool); ok && applies {
+			// Policies are validated when they're created, but a policy
+			// that isn't valid must never decide a request.
+			if err := Validate(p); err != nil {
+				return nil, fmt.Errorf("policy %q: %w", p.Name, err)
+			}
+			return p, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Validate returns an error if the action of the provided policy isn't
+// valid. A policy requiring approvals must require at least one, so that
+// it can't silently drop the approvals a machine requires.
+func Validate(p *ent.UnlockPolicy) error {
+	if err := unlockpolicy.ActionValidator(p.Action); err != nil {
+		return err
+	}
+	if p.Action == unlockpolicy.ActionRequireApprovals && p.RequiredApprovals < 1 {
+		return fmt.Errorf("action %s requires at least one approval, got %d", p.Action, p.RequiredApprovals)
+	}
+	return nil
+}
+
+// List returns all policies in the provided DB in the order they
+// should be evaluated.
+func List(ctx context.Context, db *ent.Client) ([]*ent.UnlockPolicy, error) {
+	policies, err := db.UnlockPolicy.Query().
+		Order(ent.Asc(unlockpolicy.FieldPriority), ent.Asc(unlockpolicy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	return policies, nil
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package policy
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "constant", expr: "true"},
+		{name: "labels", expr: `labels["role"] == "storage"`},
+		{name: "peer", expr: `peer.startsWith("10.0.")`},
+		{name: "time", expr: `now.getHours("Europe/Berlin") < 6 && now - last_unlocked > duration("24h")`},
+		{name: "metadata", expr: `"x-ticket" in metadata`},
+		{name: "syntax error", expr: `labels["role" ==`, wantErr: true},
+		{name: "unknown variable", expr: `hostname == "nas1"`, wantErr: true},
+		{name: "not a bool", expr: `machine_name`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEngine()
+			if err != nil {
+				t.Fatalf("NewEngine() error = %v", err)
+			}
+			if err := e.Compile(tt.expr); (err != nil) != tt.wantErr {
+				t.Errorf("Compile(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	night := time.Date(2026, time.October, 18, 3, 0, 0, 0, time.UTC)
+	storage := &Input{
+		MachineID:   "nas1",
+		MachineName: "nas1",
+		Labels:      map[string]string{"role": "storage"},
+		Peer:        "10.0.0.5",
+		Now:         night,
+	}
+
+	deny := &ent.UnlockPolicy{Name: "deny-external", Expression: `!peer.startsWith("10.")`, Action: unlockpolicy.ActionDeny}
+	release := &ent.UnlockPolicy{
+		Name:       "storage-at-night",
+		Expression: `labels["role"] == "storage" && now.getHours("UTC") < 6`,
+		Action:     unlockpolicy.ActionRelease,
+	}
+	approvals := &ent.UnlockPolicy{
+		Name:              "two-approvals",
+		Expression:        "true",
+		Action:            unlockpolicy.ActionRequireApprovals,
+		RequiredApprovals: 2,
+	}
+
+	tests := []struct {
+		name     string
+		policies []*ent.UnlockPolicy
+		in       *Input
+		want     *ent.UnlockPolicy
+		wantErr  bool
+	}{
+		{name: "no policies", in: storage},
+		{name: "first applying wins", policies: []*ent.UnlockPolicy{deny, release, approvals}, in: storage, want: release},
+		{name: "order matters", policies: []*ent.UnlockPolicy{approvals, release}, in: storage, want: approvals},
+		{
+			name:     "deny",
+			policies: []*ent.UnlockPolicy{deny, release},
+			in:       &Input{Labels: map[string]string{"role": "storage"}, Peer: "192.0.2.1", Now: night},
+			want:     deny,
+		},
+		{
+			name:     "none applying",
+			policies: []*ent.UnlockPolicy{deny, release},
+			in:       &Input{Labels: map[string]string{"role": "web"}, Peer: "10.0.0.6", Now: night},
+		},
+		{
+			name:     "missing label",
+			policies: []*ent.UnlockPolicy{{Name: "x", Expression: `labels["role"] == "x"`, Action: unlockpolicy.ActionRelease}},
+			in:       &Input{},
+			wantErr:  true,
+		},
+		{
+			name: "never unlocked",
+			policies: []*ent.UnlockPolicy{{
+				Name:       "stale",
+				Expression: `now - last_unlocked > duration("720h")`,
+				Action:     unlockpolicy.ActionDeny,
+			}},
+			in:   &Input{Now: night},
+			want: &ent.UnlockPolicy{Name: "stale"},
+		},
+		{
+			name: "invalid policy applying",
+			policies: []*ent.UnlockPolicy{{
+				Name:       "zero-approvals",
+				Expression: "true",
+				Action:     unlockpolicy.ActionRequireApprovals,
+			}},
+			in:      storage,
+			wantErr: true,
+		},
+		{
+			name:     "invalid expression",
+			policies: []*ent.UnlockPolicy{{Name: "broken", Expression: "peer ==", Action: unlockpolicy.ActionDeny}},
+			in:       storage,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEngine()
+			if err != nil {
+				t.Fatalf("NewEngine() error = %v", err)
+			}
+
+			got, err := e.Evaluate(tt.policies, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && got.Name != tt.want.Name) {
+				t.Errorf("Evaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       *ent.UnlockPolicy
+		wantErr bool
+	}{
+		{name: "release", p: &ent.UnlockPolicy{Action: unlockpolicy.ActionRelease}},
+		{name: "deny", p: &ent.UnlockPolicy{Action: unlockpolicy.ActionDeny}},
+		{name: "require approvals", p: &ent.UnlockPolicy{Action: unlockpolicy.ActionRequireApprovals, RequiredApprovals: 1}},
+		{name: "require no approvals", p: &ent.UnlockPolicy{Action: unlockpolicy.ActionRequireApprovals}, wantErr: true},
+		{name: "no action", p: &ent.UnlockPolicy{}, wantErr: true},
+		{name: "unknown action", p: &ent.UnlockPolicy{Action: "allow"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.p); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	defer db.Close()
+
+	for _, p := range []struct {
+		name     string
+		priority int
+	}{{"c", 10}, {"a", 0}, {"d", 10}, {"b", 5}} {
+		if err := db.UnlockPolicy.Create().SetName(p.name).SetExpression("true").
+			SetAction(unlockpolicy.ActionDeny).SetPriority(p.priority).Exec(ctx); err != nil {
+			t.Fatalf("failed to create policy: %v", err)
+		}
+	}
+
+	policies, err := List(ctx, db)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	names := make([]string, 0, len(policies))
+	for _, p := range policies {
+		names = append(names, p.Name)
+	}
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(names, want) {
+		t.Errorf("List() = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package registry looks up machines by the IDs and keys they are known
+// by, and rotates their keys.
+package registry
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/db/ent/machinealias"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"github.com/google/uuid"
+)
+
+// DefaultAliasGrace is how long the fingerprint of a rotated key stays
+// an alias of its machine by default.
+const DefaultAliasGrace = 7 * 24 * time.Hour
+
+// ErrKeyRotated is returned by [Rotate] when the machine's key was
+// rotated concurrently.
+var ErrKeyRotated = errors.New("machine key was rotated concurrently")
+
+// NewID returns a new, random, ID for a machine. IDs are stable, unlike
+// the fingerprints of the keys of the machine.
+func NewID() string {
+	return uuid.New().String()
+}
+
+// Lookup returns the machine known by the provided reference, which is
+// either its ID, its name, the fingerprint of its key or the fingerprint
+// of a key it rotated within the grace period.
+func Lookup(ctx context.Context, db *ent.Client, ref string) (*ent.Machine, error) {
+	m, err := db.Machine.Query().
+		Where(machine.Or(machine.ID(ref), machine.Name(ref), machine.Fingerprint(ref))).
+		Only(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return m, err
+	}
+
+	m, _, err = ByFingerprint(ctx, db, ref)
+	return m, err
+}
+
+// ByFingerprint returns the machine whose key has the provided
+// fingerprint, and that key. The key is either the current key of the
+// machine, or a key it rotated within the grace period.
+func ByFingerprint(ctx context.Context, db *ent.Client, fprint string) (*ent.Machine, ed25519.PublicKey, error) {
+	m, err := db.Machine.Query().Where(machine.Fingerprint(fprint)).Only(ctx)
+	if err == nil {
+		return m, m.PublicKey, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	a, err := db.MachineAlias.Query().
+		Where(machinealias.ID(fprint), machinealias.ExpiresAtGT(time.Now())).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err = db.Machine.Get(ctx, a.MachineID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, a.PublicKey, nil
+}
+
+// ByRequest returns the machine a request identifying it by the
+// provided reference is for, and the key the request must be signed
+// with. The reference is either the fingerprint of the key, which may
+// be a key the machine rotated within the grace period, or the name of
+// the machine.
+func ByRequest(ctx context.Context, db *ent.Client, ref string) (*ent.Machine, ed25519.PublicKey, error) {
+	m, pubKey, err := ByFingerprint(ctx, db, ref)
+	if err == nil || !ent.IsNotFound(err) {
+		return m, pubKey, err
+	}
+
+	m, err = db.Machine.Query().Where(machine.Name(ref)).Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, m.PublicKey, nil
+}
+
+// Rotate replaces the key of the provided machine with the provided
+// public key. The fingerprint of the replaced key stays an alias of the
+// machine, accepted until grace has passed. Returned is the alias.
+func Rotate(ctx context.Context, db *ent.Client, m *ent.Machine, pubKey ed25519.PublicKey,
+	grace time.Duration) (*ent.MachineAlias, error) {
+	fprint, err := machines.Fingerprint(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if fprint == m.Fingerprint {
+		return nil, fmt.Errorf("new key is the current key of the machine")
+	}
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // Why: No-op after commit.
+
+	// Only rotate the key if it wasn't by a concurrent request.
+	updated, err := tx.Machine.Update().
+		Where(machine.ID(m.ID), machine.Fingerprint(m.Fingerprint)).
+		SetPublicKey(pubKey).
+		SetFingerprint(fprint).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update machine: %w", err)
+	}
+	if updated == 0 {
+		return nil, ErrKeyRotated
+	}
+
+	// A machine rotating back to a key it used before must not be able
+	// to use it through its alias after it's rotated again.
+	if _, err := tx.MachineAlias.Delete().Where(machinealias.ID(fprint)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete alias: %w", err)
+	}
+
+	a, err := tx.MachineAlias.Create().
+		SetID(m.Fingerprint).
+		SetMachineID(m.ID).
+		SetPublicKey(m.PublicKey).
+		SetExpiresAt(time.Now().Add(grace)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alias: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit key rotation: %w", err)
+	}
+	return a.Unwrap(), nil
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package registry
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	"git.rgst.io/homelab/klefki/internal/machines"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// newTestDB returns a new empty DB.
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newKey generates a new public key, returning it and its fingerprint.
+func newKey(t *testing.T) (ed25519.PublicKey, string) {
+	t.Helper()
+
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	fprint, err := machines.Fingerprint(pub)
+	if err != nil {
+		t.Fatalf("failed to get fingerprint: %v", err)
+	}
+	return pub, fprint
+}
+
+// newTestMachine creates a new machine with a new key in the provided
+// DB.
+func newTestMachine(t *testing.T, db *ent.Client) *ent.Machine {
+	t.Helper()
+
+	pub, fprint := newKey(t)
+	m, err := db.Machine.Create().SetID(NewID()).SetName("nas1").SetPublicKey(pub).SetFingerprint(fprint).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create machine: %v", err)
+	}
+	return m
+}
+
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m := newTestMachine(t, db)
+	newPub, newFprint := newKey(t)
+
+	if _, err := Rotate(ctx, db, m, newPub, time.Hour); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	// Both keys are accepted during the grace period, each by its own
+	// fingerprint.
+	for _, tt := range []struct {
+		fprint string
+		want   ed25519.PublicKey
+	}{
+		{fprint: m.Fingerprint, want: m.PublicKey},
+		{fprint: newFprint, want: newPub},
+	} {
+		got, pubKey, err := ByFingerprint(ctx, db, tt.fprint)
+		if err != nil {
+			t.Fatalf("ByFingerprint(%s) error = %v", tt.fprint, err)
+		}
+		if got.ID != m.ID || !pubKey.Equal(tt.want) {
+			t.Errorf("ByFingerprint(%s) = %s with another key, want %s", tt.fprint, got.ID, m.ID)
+		}
+	}
+
+	for _, ref := range []string{m.ID, m.Name, m.Fingerprint, newFprint} {
+		got, err := Lookup(ctx, db, ref)
+		if err != nil {
+			t.Fatalf("Lookup(%s) error = %v", ref, err)
+		}
+		if got.ID != m.ID {
+			t.Errorf("Lookup(%s) = %s, want %s", ref, got.ID, m.ID)
+		}
+	}
+
+	// The machine can't be rotated based on its old key.
+	if _, err := Rotate(ctx, db, m, newPub, time.Hour); err == nil {
+		t.Error("Rotate() with a stale machine error = nil, want error")
+	}
+	otherPub, _ := newKey(t)
+	if _, err := Rotate(ctx, db, m, otherPub, time.Hour); !errors.Is(err, ErrKeyRotated) {
+		t.Errorf("Rotate() with a stale machine error = %v, want %v", err, ErrKeyRotated)
+	}
+}
+
+func TestRotateGraceExpired(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m := newTestMachine(t, db)
+	newPub, _ := newKey(t)
+
+	if _, err := Rotate(ctx, db, m, newPub, -time.Second); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	if _, _, err := ByFingerprint(ctx, db, m.Fingerprint); !ent.IsNotFound(err) {
+		t.Errorf("ByFingerprint() of the expired key error = %v, want not found", err)
+	}
+}
+
+func TestRotateBack(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m := newTestMachine(t, db)
+	newPub, _ := newKey(t)
+
+	if _, err := Rotate(ctx, db, m, newPub, time.Hour); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	rotated := db.Machine.GetX(ctx, m.ID)
+	if _, err := Rotate(ctx, db, rotated, m.PublicKey, time.Hour); err != nil {
+		t.Fatalf("Rotate() back error = %v", err)
+	}
+
+	// The original key is the current one again, not an alias.
+	if n := db.MachineAlias.Query().CountX(ctx); n != 1 {
+		t.Errorf("aliases = %d, want 1", n)
+	}
+	if _, err := Rotate(ctx, db, db.Machine.GetX(ctx, m.ID), m.PublicKey, time.Hour); err == nil {
+		t.Error("Rotate() to the current key error = nil, want error")
+	}
+}
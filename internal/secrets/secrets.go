@@ -0,0 +1,89 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package secrets implements the named secrets of machines, e.g., one
+// for each encrypted volume of a storage server.
+package secrets
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// Default is the name of the only secret of machines without named
+// secrets.
+const Default = "default"
+
+// nameRe matches valid secret names.
+var nameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,62}$`)
+
+// Validate returns an error if the provided secret name is invalid.
+func Validate(name string) error {
+	if !nameRe.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q: must be lowercase letters, digits, '_', '.' or '-'", name)
+	}
+	return nil
+}
+
+// ValidateNames returns an error if any of the provided secret names
+// is invalid or duplicated.
+func ValidateNames(names []string) error {
+	for i, name := range names {
+		if err := Validate(name); err != nil {
+			return err
+		}
+		if slices.Contains(names[:i], name) {
+			return fmt.Errorf("duplicate secret name %q", name)
+		}
+	}
+	return nil
+}
+
+// Names returns the names of the secrets of a machine with the
+// provided configured secrets. The first one is its default secret.
+func Names(configured []string) []string {
+	if len(configured) == 0 {
+		return []string{Default}
+	}
+	return configured
+}
+
+// Resolve returns the name of the secret with the provided name of a
+// machine with the provided configured secrets, or of its default
+// secret if empty.
+func Resolve(configured []string, name string) (string, error) {
+	names := Names(configured)
+	if name == "" {
+		return names[0], nil
+	}
+	if !slices.Contains(names, name) {
+		return "", fmt.Errorf("no secret %q, expected one of %v", name, names)
+	}
+	return name, nil
+}
+
+// StoredName returns the name the secret with the provided name of a
+// machine with the provided configured secrets is stored in the vault
+// under. The default secret is stored without a name, so that it's
+// kept when the secrets of the machine are named later on.
+func StoredName(configured []string, name string) string {
+	if name == Names(configured)[0] {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/measurements"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// alertMeasurementMismatch records, and alerts operators, that the
+// provided machine reported boot measurements that aren't allowed.
+func (s *Server) alertMeasurementMismatch(ctx context.Context, machine *ent.Machine, bm *pbgrpcv1.BootMeasurements) {
+	s.alertOnce(ctx, &audit.Event{
+		Type:      audit.EventBootMeasurementMismatch,
+		MachineID: machine.ID,
+		Details:   "reported boot measurements are not allowed: " + measurements.Format(bm),
+	})
+}
+
+// alertOnce calls [Server.alert] for the provided event, unless the
+// last alert of the same type for the same machine had the same
+// details. This prevents polling machines from repeating alerts.
+// Returned is whether the alert was sent.
+func (s *Server) alertOnce(ctx context.Context, ev *audit.Event) bool {
+	key := ev.MachineID + "/" + ev.Type
+
+	s.alertedMu.Lock()
+	if s.alerted[key] == ev.Details {
+		s.alertedMu.Unlock()
+		return false
+	}
+	s.alerted[key] = ev.Details
+	s.alertedMu.Unlock()
+
+	s.alert(ctx, ev)
+	return true
+}
+
+// alert records the provided event in the audit log and sends it to
+// operators. Failures are logged, as they shouldn't fail the request
+// that caused them.
+func (s *Server) alert(ctx context.Context, ev *audit.Event) {
+	if err := audit.Record(ctx, s.db, ev); err != nil {
+		fmt.Printf("failed to record alert: %v\n", err)
+	}
+	if err := s.alerter.Send(ctx, ev); err != nil {
+		fmt.Printf("failed to send alert: %v\n", err)
+	}
+}
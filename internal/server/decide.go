@@ -0,0 +1,113 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+	"git.rgst.io/homelab/klefki/internal/windows"
+)
+
+// unlockDecision is how a key request is handled, as decided by
+// [decideUnlock].
+type unlockDecision struct {
+	// RequiredApprovals is how many operators must approve the session
+	// before the key is released.
+	RequiredApprovals int
+
+	// AutoUnlock is whether the key stored for the machine is released
+	// without an operator submitting it.
+	AutoUnlock bool
+
+	// MaintenanceWindow is the name of the maintenance window that
+	// released the key, if any.
+	MaintenanceWindow string
+
+	// Details are the audit details of the release, if decided by a
+	// policy or window. Empty if decided by the machine's settings.
+	Details string
+}
+
+// decideUnlock decides how a key request of the provided machine is
+// handled, given the policy that applies to it and the open maintenance
+// window, if any (either may be nil):
+//
+//  1. A policy denying the request rejects it.
+//  2. A policy requiring approvals requires the larger of its and the
+//     machine's number of approvals, so that it never lowers them.
+//     Windows don't apply.
+//  3. A policy releasing the key releases it without approval, and
+//     windows don't apply.
+//  4. Otherwise, an open window releases the key without approval.
+//  5. Otherwise, the machine's settings apply.
+//
+// Neither policies nor windows bypass machines requiring approval by
+// multiple operators.
+func decideUnlock(machine *ent.Machine, p *ent.UnlockPolicy, w *ent.MaintenanceWindow) (*unlockDecision, error) {
+	d := &unlockDecision{
+		RequiredApprovals: machine.RequiredApprovals,
+		AutoUnlock:        machine.AutoUnlock,
+	}
+	bypassable := machine.RequiredApprovals <= 1
+
+	if p != nil {
+		switch p.Action {
+		case unlockpolicy.ActionDeny:
+			return nil, fmt.Errorf("key request denied by policy %q", p.Name)
+		case unlockpolicy.ActionRelease:
+			if bypassable {
+				d.RequiredApprovals = 0
+				d.AutoUnlock = true
+				d.Details = fmt.Sprintf("released by policy %q", p.Name)
+			}
+			return d, nil
+		case unlockpolicy.ActionRequireApprovals:
+			d.RequiredApprovals = max(machine.RequiredApprovals, p.RequiredApprovals)
+			return d, nil
+		}
+	}
+
+	if w != nil && bypassable {
+		d.RequiredApprovals = 0
+		d.AutoUnlock = true
+		d.MaintenanceWindow = w.Name
+		d.Details = fmt.Sprintf("released during maintenance window %q", w.Name)
+	}
+	return d, nil
+}
+
+// decide decides how a key request of the provided machine is handled
+// through [decideUnlock], with the policy and maintenance window that
+// apply to it now.
+func (s *Server) decide(ctx context.Context, machine *ent.Machine) (*unlockDecision, error) {
+	p, err := s.evaluatePolicies(ctx, machine)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := windows.Find(ctx, s.db, machine, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return decideUnlock(machine, p, w)
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"testing"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/unlockpolicy"
+)
+
+func TestDecideUnlock(t *testing.T) {
+	deny := &ent.UnlockPolicy{Name: "deny", Action: unlockpolicy.ActionDeny}
+	release := &ent.UnlockPolicy{Name: "release", Action: unlockpolicy.ActionRelease}
+	approvals := &ent.UnlockPolicy{Name: "three", Action: unlockpolicy.ActionRequireApprovals, RequiredApprovals: 3}
+	window := &ent.MaintenanceWindow{Name: "patching"}
+	releasedByWindow := unlockDecision{
+		AutoUnlock: true, MaintenanceWindow: "patching",
+		Details: `released during maintenance window "patching"`,
+	}
+
+	tests := []struct {
+		name    string
+		machine *ent.Machine
+		p       *ent.UnlockPolicy
+		w       *ent.MaintenanceWindow
+		want    unlockDecision
+		wantErr bool
+	}{
+		{
+			name:    "machine settings",
+			machine: &ent.Machine{RequiredApprovals: 2},
+			want:    unlockDecision{RequiredApprovals: 2},
+		},
+		{
+			name:    "machine auto unlock",
+			machine: &ent.Machine{AutoUnlock: true},
+			want:    unlockDecision{AutoUnlock: true},
+		},
+		{
+			name:    "denied by policy",
+			machine: &ent.Machine{},
+			p:       deny,
+			wantErr: true,
+		},
+		{
+			name:    "denied by policy during window",
+			machine: &ent.Machine{},
+			p:       deny,
+			w:       window,
+			wantErr: true,
+		},
+		{
+			name:    "released by policy",
+			machine: &ent.Machine{},
+			p:       release,
+			want:    unlockDecision{AutoUnlock: true, Details: `released by policy "release"`},
+		},
+		{
+			name:    "single approval bypassed by policy",
+			machine: &ent.Machine{RequiredApprovals: 1},
+			p:       release,
+			w:       window,
+			want:    unlockDecision{AutoUnlock: true, Details: `released by policy "release"`},
+		},
+		{
+			name:    "multiple approvals not bypassed by policy",
+			machine: &ent.Machine{RequiredApprovals: 2},
+			p:       release,
+			want:    unlockDecision{RequiredApprovals: 2},
+		},
+		{
+			name:    "multiple approvals not bypassed by policy during window",
+			machine: &ent.Machine{RequiredApprovals: 2},
+			p:       release,
+			w:       window,
+			want:    unlockDecision{RequiredApprovals: 2},
+		},
+		{
+			name:    "approvals raised by policy",
+			machine: &ent.Machine{RequiredApprovals: 1, AutoUnlock: true},
+			p:       approvals,
+			want:    unlockDecision{RequiredApprovals: 3, AutoUnlock: true},
+		},
+		{
+			name:    "approvals not lowered by policy",
+			machine: &ent.Machine{RequiredApprovals: 5},
+			p:       approvals,
+			want:    unlockDecision{RequiredApprovals: 5},
+		},
+		{
+			name:    "approvals required by policy during window",
+			machine: &ent.Machine{},
+			p:       approvals,
+			w:       window,
+			want:    unlockDecision{RequiredApprovals: 3},
+		},
+		{
+			name:    "released during window",
+			machine: &ent.Machine{},
+			w:       window,
+			want:    releasedByWindow,
+		},
+		{
+			name:    "single approval bypassed during window",
+			machine: &ent.Machine{RequiredApprovals: 1},
+			w:       window,
+			want:    releasedByWindow,
+		},
+		{
+			name:    "multiple approvals required during window",
+			machine: &ent.Machine{RequiredApprovals: 2},
+			w:       window,
+			want:    unlockDecision{RequiredApprovals: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decideUnlock(tt.machine, tt.p, tt.w)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decideUnlock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("decideUnlock() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/enrollment"
+	"git.rgst.io/homelab/klefki/internal/hardware"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// Enroll implements the Enroll RPC.
+func (s *Server) Enroll(ctx context.Context, req *pbgrpcv1.EnrollRequest) (*pbgrpcv1.EnrollResponse, error) {
+	if err := machines.VerifyEnrollRequest(req); err != nil {
+		return nil, err
+	}
+
+	m, err := enrollment.Enroll(ctx, s.db, req.GetToken(), req.GetPublicKey(), hardware.ToMap(req.GetHardware()))
+	if err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("enrolled as %q", m.Name)
+	if len(m.Hardware) != 0 {
+		details += ", bound to hardware attributes: " + hardware.Format(m.Hardware)
+	}
+	if m.PendingConfirmation {
+		details += ", pending confirmation"
+	}
+	if err := audit.Record(ctx, s.db, &audit.Event{
+		Type:      audit.EventMachineEnrolled,
+		MachineID: m.ID,
+		Details:   details,
+	}); err != nil {
+		return nil, err
+	}
+
+	resp := &pbgrpcv1.EnrollResponse{}
+	resp.SetMachineId(m.ID)
+	resp.SetPendingConfirmation(m.PendingConfirmation)
+	return resp, nil
+}
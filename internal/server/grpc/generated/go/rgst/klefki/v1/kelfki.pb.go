@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: rgst/klefki/v1/kelfki.proto
 
@@ -143,32 +143,31 @@ func (b0 GetTimeResponse_builder) Build() *GetTimeResponse {
 	return m0
 }
 
-type GetKeyRequest struct {
+type BootMeasurements struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_MachineId   *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
-	xxx_hidden_Signature   []byte                 `protobuf:"bytes,2,opt,name=signature"`
-	xxx_hidden_Nonce       *string                `protobuf:"bytes,3,opt,name=nonce"`
-	xxx_hidden_SignedAt    *string                `protobuf:"bytes,4,opt,name=signed_at,json=signedAt"`
+	xxx_hidden_Kernel      *string                `protobuf:"bytes,1,opt,name=kernel"`
+	xxx_hidden_Initramfs   *string                `protobuf:"bytes,2,opt,name=initramfs"`
+	xxx_hidden_Cmdline     *string                `protobuf:"bytes,3,opt,name=cmdline"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetKeyRequest) Reset() {
-	*x = GetKeyRequest{}
+func (x *BootMeasurements) Reset() {
+	*x = BootMeasurements{}
 	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKeyRequest) String() string {
+func (x *BootMeasurements) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKeyRequest) ProtoMessage() {}
+func (*BootMeasurements) ProtoMessage() {}
 
-func (x *GetKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *BootMeasurements) ProtoReflect() protoreflect.Message {
 	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -180,169 +179,139 @@ func (x *GetKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetKeyRequest) GetMachineId() string {
+func (x *BootMeasurements) GetKernel() string {
 	if x != nil {
-		if x.xxx_hidden_MachineId != nil {
-			return *x.xxx_hidden_MachineId
+		if x.xxx_hidden_Kernel != nil {
+			return *x.xxx_hidden_Kernel
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GetKeyRequest) GetSignature() []byte {
-	if x != nil {
-		return x.xxx_hidden_Signature
-	}
-	return nil
-}
-
-func (x *GetKeyRequest) GetNonce() string {
+func (x *BootMeasurements) GetInitramfs() string {
 	if x != nil {
-		if x.xxx_hidden_Nonce != nil {
-			return *x.xxx_hidden_Nonce
+		if x.xxx_hidden_Initramfs != nil {
+			return *x.xxx_hidden_Initramfs
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GetKeyRequest) GetSignedAt() string {
+func (x *BootMeasurements) GetCmdline() string {
 	if x != nil {
-		if x.xxx_hidden_SignedAt != nil {
-			return *x.xxx_hidden_SignedAt
+		if x.xxx_hidden_Cmdline != nil {
+			return *x.xxx_hidden_Cmdline
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GetKeyRequest) SetMachineId(v string) {
-	x.xxx_hidden_MachineId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
-}
-
-func (x *GetKeyRequest) SetSignature(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_Signature = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+func (x *BootMeasurements) SetKernel(v string) {
+	x.xxx_hidden_Kernel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *GetKeyRequest) SetNonce(v string) {
-	x.xxx_hidden_Nonce = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+func (x *BootMeasurements) SetInitramfs(v string) {
+	x.xxx_hidden_Initramfs = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *GetKeyRequest) SetSignedAt(v string) {
-	x.xxx_hidden_SignedAt = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+func (x *BootMeasurements) SetCmdline(v string) {
+	x.xxx_hidden_Cmdline = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *GetKeyRequest) HasMachineId() bool {
+func (x *BootMeasurements) HasKernel() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetKeyRequest) HasSignature() bool {
+func (x *BootMeasurements) HasInitramfs() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *GetKeyRequest) HasNonce() bool {
+func (x *BootMeasurements) HasCmdline() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *GetKeyRequest) HasSignedAt() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
-}
-
-func (x *GetKeyRequest) ClearMachineId() {
+func (x *BootMeasurements) ClearKernel() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_MachineId = nil
+	x.xxx_hidden_Kernel = nil
 }
 
-func (x *GetKeyRequest) ClearSignature() {
+func (x *BootMeasurements) ClearInitramfs() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Signature = nil
+	x.xxx_hidden_Initramfs = nil
 }
 
-func (x *GetKeyRequest) ClearNonce() {
+func (x *BootMeasurements) ClearCmdline() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Nonce = nil
-}
-
-func (x *GetKeyRequest) ClearSignedAt() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_SignedAt = nil
+	x.xxx_hidden_Cmdline = nil
 }
 
-type GetKeyRequest_builder struct {
+type BootMeasurements_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	MachineId *string
-	Signature []byte
-	Nonce     *string
-	SignedAt  *string
+	Kernel    *string
+	Initramfs *string
+	Cmdline   *string
 }
 
-func (b0 GetKeyRequest_builder) Build() *GetKeyRequest {
-	m0 := &GetKeyRequest{}
+func (b0 BootMeasurements_builder) Build() *BootMeasurements {
+	m0 := &BootMeasurements{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.MachineId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
-		x.xxx_hidden_MachineId = b.MachineId
-	}
-	if b.Signature != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
-		x.xxx_hidden_Signature = b.Signature
+	if b.Kernel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Kernel = b.Kernel
 	}
-	if b.Nonce != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
-		x.xxx_hidden_Nonce = b.Nonce
+	if b.Initramfs != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Initramfs = b.Initramfs
 	}
-	if b.SignedAt != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
-		x.xxx_hidden_SignedAt = b.SignedAt
+	if b.Cmdline != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Cmdline = b.Cmdline
 	}
 	return m0
 }
 
-type GetKeyResponse struct {
+type HardwareAttributes struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_EncKey      []byte                 `protobuf:"bytes,1,opt,name=enc_key,json=encKey"`
+	xxx_hidden_ProductUuid *string                `protobuf:"bytes,1,opt,name=product_uuid,json=productUuid"`
+	xxx_hidden_BoardSerial *string                `protobuf:"bytes,2,opt,name=board_serial,json=boardSerial"`
+	xxx_hidden_MacAddress  *string                `protobuf:"bytes,3,opt,name=mac_address,json=macAddress"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetKeyResponse) Reset() {
-	*x = GetKeyResponse{}
+func (x *HardwareAttributes) Reset() {
+	*x = HardwareAttributes{}
 	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKeyResponse) String() string {
+func (x *HardwareAttributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKeyResponse) ProtoMessage() {}
+func (*HardwareAttributes) ProtoMessage() {}
 
-func (x *GetKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *HardwareAttributes) ProtoReflect() protoreflect.Message {
 	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -354,119 +323,144 @@ func (x *GetKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetKeyResponse) GetEncKey() []byte {
+func (x *HardwareAttributes) GetProductUuid() string {
 	if x != nil {
-		return x.xxx_hidden_EncKey
+		if x.xxx_hidden_ProductUuid != nil {
+			return *x.xxx_hidden_ProductUuid
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *GetKeyResponse) SetEncKey(v []byte) {
-	if v == nil {
-		v = []byte{}
+func (x *HardwareAttributes) GetBoardSerial() string {
+	if x != nil {
+		if x.xxx_hidden_BoardSerial != nil {
+			return *x.xxx_hidden_BoardSerial
+		}
+		return ""
 	}
-	x.xxx_hidden_EncKey = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	return ""
 }
 
-func (x *GetKeyResponse) HasEncKey() bool {
-	if x == nil {
-		return false
+func (x *HardwareAttributes) GetMacAddress() string {
+	if x != nil {
+		if x.xxx_hidden_MacAddress != nil {
+			return *x.xxx_hidden_MacAddress
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return ""
 }
 
-func (x *GetKeyResponse) ClearEncKey() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_EncKey = nil
+func (x *HardwareAttributes) SetProductUuid(v string) {
+	x.xxx_hidden_ProductUuid = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-type GetKeyResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *HardwareAttributes) SetBoardSerial(v string) {
+	x.xxx_hidden_BoardSerial = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
 
-	EncKey []byte
+func (x *HardwareAttributes) SetMacAddress(v string) {
+	x.xxx_hidden_MacAddress = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (b0 GetKeyResponse_builder) Build() *GetKeyResponse {
-	m0 := &GetKeyResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.EncKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_EncKey = b.EncKey
+func (x *HardwareAttributes) HasProductUuid() bool {
+	if x == nil {
+		return false
 	}
-	return m0
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-type ListSessionsRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HardwareAttributes) HasBoardSerial() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *ListSessionsRequest) Reset() {
-	*x = ListSessionsRequest{}
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *HardwareAttributes) HasMacAddress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *ListSessionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HardwareAttributes) ClearProductUuid() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ProductUuid = nil
 }
 
-func (*ListSessionsRequest) ProtoMessage() {}
+func (x *HardwareAttributes) ClearBoardSerial() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_BoardSerial = nil
+}
 
-func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *HardwareAttributes) ClearMacAddress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MacAddress = nil
 }
 
-type ListSessionsRequest_builder struct {
+type HardwareAttributes_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	ProductUuid *string
+	BoardSerial *string
+	MacAddress  *string
 }
 
-func (b0 ListSessionsRequest_builder) Build() *ListSessionsRequest {
-	m0 := &ListSessionsRequest{}
+func (b0 HardwareAttributes_builder) Build() *HardwareAttributes {
+	m0 := &HardwareAttributes{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.ProductUuid != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_ProductUuid = b.ProductUuid
+	}
+	if b.BoardSerial != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_BoardSerial = b.BoardSerial
+	}
+	if b.MacAddress != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_MacAddress = b.MacAddress
+	}
 	return m0
 }
 
-type Machine struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_PublicKey   []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
-	xxx_hidden_LastAsked   *string                `protobuf:"bytes,3,opt,name=last_asked,json=lastAsked"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type GetKeyRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId        *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_Signature        []byte                 `protobuf:"bytes,2,opt,name=signature"`
+	xxx_hidden_Nonce            *string                `protobuf:"bytes,3,opt,name=nonce"`
+	xxx_hidden_SignedAt         *string                `protobuf:"bytes,4,opt,name=signed_at,json=signedAt"`
+	xxx_hidden_BootMeasurements *BootMeasurements      `protobuf:"bytes,5,opt,name=boot_measurements,json=bootMeasurements"`
+	xxx_hidden_Hardware         *HardwareAttributes    `protobuf:"bytes,6,opt,name=hardware"`
+	xxx_hidden_Secret           *string                `protobuf:"bytes,7,opt,name=secret"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *Machine) Reset() {
-	*x = Machine{}
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[5]
+func (x *GetKeyRequest) Reset() {
+	*x = GetKeyRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Machine) String() string {
+func (x *GetKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Machine) ProtoMessage() {}
+func (*GetKeyRequest) ProtoMessage() {}
 
-func (x *Machine) ProtoReflect() protoreflect.Message {
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[5]
+func (x *GetKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,198 +471,3749 @@ func (x *Machine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Machine) GetId() string {
+func (x *GetKeyRequest) GetMachineId() string {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Machine) GetPublicKey() []byte {
+func (x *GetKeyRequest) GetSignature() []byte {
 	if x != nil {
-		return x.xxx_hidden_PublicKey
+		return x.xxx_hidden_Signature
 	}
 	return nil
 }
 
-func (x *Machine) GetLastAsked() string {
+func (x *GetKeyRequest) GetNonce() string {
 	if x != nil {
-		if x.xxx_hidden_LastAsked != nil {
-			return *x.xxx_hidden_LastAsked
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Machine) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
-}
-
-func (x *Machine) SetPublicKey(v []byte) {
-	if v == nil {
-		v = []byte{}
+func (x *GetKeyRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
 	}
-	x.xxx_hidden_PublicKey = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+	return ""
 }
 
-func (x *Machine) SetLastAsked(v string) {
-	x.xxx_hidden_LastAsked = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+func (x *GetKeyRequest) GetBootMeasurements() *BootMeasurements {
+	if x != nil {
+		return x.xxx_hidden_BootMeasurements
+	}
+	return nil
 }
 
-func (x *Machine) HasId() bool {
-	if x == nil {
-		return false
+func (x *GetKeyRequest) GetHardware() *HardwareAttributes {
+	if x != nil {
+		return x.xxx_hidden_Hardware
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return nil
 }
 
-func (x *Machine) HasPublicKey() bool {
-	if x == nil {
-		return false
+func (x *GetKeyRequest) GetSecret() string {
+	if x != nil {
+		if x.xxx_hidden_Secret != nil {
+			return *x.xxx_hidden_Secret
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return ""
 }
 
-func (x *Machine) HasLastAsked() bool {
+func (x *GetKeyRequest) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *GetKeyRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *GetKeyRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *GetKeyRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *GetKeyRequest) SetBootMeasurements(v *BootMeasurements) {
+	x.xxx_hidden_BootMeasurements = v
+}
+
+func (x *GetKeyRequest) SetHardware(v *HardwareAttributes) {
+	x.xxx_hidden_Hardware = v
+}
+
+func (x *GetKeyRequest) SetSecret(v string) {
+	x.xxx_hidden_Secret = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *GetKeyRequest) HasMachineId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetKeyRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetKeyRequest) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetKeyRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetKeyRequest) HasBootMeasurements() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BootMeasurements != nil
+}
+
+func (x *GetKeyRequest) HasHardware() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hardware != nil
+}
+
+func (x *GetKeyRequest) HasSecret() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *GetKeyRequest) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
+}
+
+func (x *GetKeyRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *GetKeyRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *GetKeyRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_SignedAt = nil
+}
+
+func (x *GetKeyRequest) ClearBootMeasurements() {
+	x.xxx_hidden_BootMeasurements = nil
+}
+
+func (x *GetKeyRequest) ClearHardware() {
+	x.xxx_hidden_Hardware = nil
+}
+
+func (x *GetKeyRequest) ClearSecret() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Secret = nil
+}
+
+type GetKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MachineId        *string
+	Signature        []byte
+	Nonce            *string
+	SignedAt         *string
+	BootMeasurements *BootMeasurements
+	Hardware         *HardwareAttributes
+	Secret           *string
+}
+
+func (b0 GetKeyRequest_builder) Build() *GetKeyRequest {
+	m0 := &GetKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	x.xxx_hidden_BootMeasurements = b.BootMeasurements
+	x.xxx_hidden_Hardware = b.Hardware
+	if b.Secret != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_Secret = b.Secret
+	}
+	return m0
+}
+
+type GetKeyResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_EncKey      []byte                 `protobuf:"bytes,1,opt,name=enc_key,json=encKey"`
+	xxx_hidden_EncShares   [][]byte               `protobuf:"bytes,2,rep,name=enc_shares,json=encShares"`
+	xxx_hidden_ShareIndex  int32                  `protobuf:"varint,3,opt,name=share_index,json=shareIndex"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,4,opt,name=signature"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetKeyResponse) Reset() {
+	*x = GetKeyResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyResponse) ProtoMessage() {}
+
+func (x *GetKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetKeyResponse) GetEncKey() []byte {
+	if x != nil {
+		return x.xxx_hidden_EncKey
+	}
+	return nil
+}
+
+func (x *GetKeyResponse) GetEncShares() [][]byte {
+	if x != nil {
+		return x.xxx_hidden_EncShares
+	}
+	return nil
+}
+
+func (x *GetKeyResponse) GetShareIndex() int32 {
+	if x != nil {
+		return x.xxx_hidden_ShareIndex
+	}
+	return 0
+}
+
+func (x *GetKeyResponse) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *GetKeyResponse) SetEncKey(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_EncKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *GetKeyResponse) SetEncShares(v [][]byte) {
+	x.xxx_hidden_EncShares = v
+}
+
+func (x *GetKeyResponse) SetShareIndex(v int32) {
+	x.xxx_hidden_ShareIndex = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *GetKeyResponse) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *GetKeyResponse) HasEncKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetKeyResponse) HasShareIndex() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetKeyResponse) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetKeyResponse) ClearEncKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_EncKey = nil
+}
+
+func (x *GetKeyResponse) ClearShareIndex() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ShareIndex = 0
+}
+
+func (x *GetKeyResponse) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Signature = nil
+}
+
+type GetKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	EncKey     []byte
+	EncShares  [][]byte
+	ShareIndex *int32
+	Signature  []byte
+}
+
+func (b0 GetKeyResponse_builder) Build() *GetKeyResponse {
+	m0 := &GetKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.EncKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_EncKey = b.EncKey
+	}
+	x.xxx_hidden_EncShares = b.EncShares
+	if b.ShareIndex != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_ShareIndex = *b.ShareIndex
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	return m0
+}
+
+type KeyChunk struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,1,opt,name=data"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *KeyChunk) Reset() {
+	*x = KeyChunk{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyChunk) ProtoMessage() {}
+
+func (x *KeyChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *KeyChunk) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *KeyChunk) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *KeyChunk) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *KeyChunk) ClearData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Data = nil
+}
+
+type KeyChunk_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Data []byte
+}
+
+func (b0 KeyChunk_builder) Build() *KeyChunk {
+	m0 := &KeyChunk{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Data = b.Data
+	}
+	return m0
+}
+
+type ListSessionsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Group       *string                `protobuf:"bytes,1,opt,name=group"`
+	xxx_hidden_Selector    *string                `protobuf:"bytes,2,opt,name=selector"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSessionsRequest) GetGroup() string {
+	if x != nil {
+		if x.xxx_hidden_Group != nil {
+			return *x.xxx_hidden_Group
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetSelector() string {
+	if x != nil {
+		if x.xxx_hidden_Selector != nil {
+			return *x.xxx_hidden_Selector
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) SetGroup(v string) {
+	x.xxx_hidden_Group = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListSessionsRequest) SetSelector(v string) {
+	x.xxx_hidden_Selector = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListSessionsRequest) HasGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListSessionsRequest) HasSelector() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListSessionsRequest) ClearGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Group = nil
+}
+
+func (x *ListSessionsRequest) ClearSelector() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Selector = nil
+}
+
+type ListSessionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Group    *string
+	Selector *string
+}
+
+func (b0 ListSessionsRequest_builder) Build() *ListSessionsRequest {
+	m0 := &ListSessionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Group != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Group = b.Group
+	}
+	if b.Selector != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Selector = b.Selector
+	}
+	return m0
+}
+
+type Approval struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OperatorId   *string                `protobuf:"bytes,1,opt,name=operator_id,json=operatorId"`
+	xxx_hidden_OperatorName *string                `protobuf:"bytes,2,opt,name=operator_name,json=operatorName"`
+	xxx_hidden_ApprovedAt   *string                `protobuf:"bytes,3,opt,name=approved_at,json=approvedAt"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Approval) Reset() {
+	*x = Approval{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approval) ProtoMessage() {}
+
+func (x *Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Approval) GetOperatorId() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorId != nil {
+			return *x.xxx_hidden_OperatorId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Approval) GetOperatorName() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorName != nil {
+			return *x.xxx_hidden_OperatorName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Approval) GetApprovedAt() string {
+	if x != nil {
+		if x.xxx_hidden_ApprovedAt != nil {
+			return *x.xxx_hidden_ApprovedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Approval) SetOperatorId(v string) {
+	x.xxx_hidden_OperatorId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *Approval) SetOperatorName(v string) {
+	x.xxx_hidden_OperatorName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *Approval) SetApprovedAt(v string) {
+	x.xxx_hidden_ApprovedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *Approval) HasOperatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Approval) HasOperatorName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Approval) HasApprovedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Approval) ClearOperatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OperatorId = nil
+}
+
+func (x *Approval) ClearOperatorName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OperatorName = nil
+}
+
+func (x *Approval) ClearApprovedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ApprovedAt = nil
+}
+
+type Approval_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OperatorId   *string
+	OperatorName *string
+	ApprovedAt   *string
+}
+
+func (b0 Approval_builder) Build() *Approval {
+	m0 := &Approval{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OperatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_OperatorId = b.OperatorId
+	}
+	if b.OperatorName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_OperatorName = b.OperatorName
+	}
+	if b.ApprovedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_ApprovedAt = b.ApprovedAt
+	}
+	return m0
+}
+
+type SecretStatus struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name            *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_KeySubmitted    bool                   `protobuf:"varint,2,opt,name=key_submitted,json=keySubmitted"`
+	xxx_hidden_SharesSubmitted int32                  `protobuf:"varint,3,opt,name=shares_submitted,json=sharesSubmitted"`
+	xxx_hidden_Released        bool                   `protobuf:"varint,4,opt,name=released"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *SecretStatus) Reset() {
+	*x = SecretStatus{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretStatus) ProtoMessage() {}
+
+func (x *SecretStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecretStatus) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SecretStatus) GetKeySubmitted() bool {
+	if x != nil {
+		return x.xxx_hidden_KeySubmitted
+	}
+	return false
+}
+
+func (x *SecretStatus) GetSharesSubmitted() int32 {
+	if x != nil {
+		return x.xxx_hidden_SharesSubmitted
+	}
+	return 0
+}
+
+func (x *SecretStatus) GetReleased() bool {
+	if x != nil {
+		return x.xxx_hidden_Released
+	}
+	return false
+}
+
+func (x *SecretStatus) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SecretStatus) SetKeySubmitted(v bool) {
+	x.xxx_hidden_KeySubmitted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *SecretStatus) SetSharesSubmitted(v int32) {
+	x.xxx_hidden_SharesSubmitted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *SecretStatus) SetReleased(v bool) {
+	x.xxx_hidden_Released = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SecretStatus) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SecretStatus) HasKeySubmitted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SecretStatus) HasSharesSubmitted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SecretStatus) HasReleased() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SecretStatus) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *SecretStatus) ClearKeySubmitted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_KeySubmitted = false
+}
+
+func (x *SecretStatus) ClearSharesSubmitted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_SharesSubmitted = 0
+}
+
+func (x *SecretStatus) ClearReleased() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Released = false
+}
+
+type SecretStatus_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name            *string
+	KeySubmitted    *bool
+	SharesSubmitted *int32
+	Released        *bool
+}
+
+func (b0 SecretStatus_builder) Build() *SecretStatus {
+	m0 := &SecretStatus{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.KeySubmitted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_KeySubmitted = *b.KeySubmitted
+	}
+	if b.SharesSubmitted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_SharesSubmitted = *b.SharesSubmitted
+	}
+	if b.Released != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Released = *b.Released
+	}
+	return m0
+}
+
+type Machine struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_PublicKey         []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_LastAsked         *string                `protobuf:"bytes,3,opt,name=last_asked,json=lastAsked"`
+	xxx_hidden_Approvals         *[]*Approval           `protobuf:"bytes,4,rep,name=approvals"`
+	xxx_hidden_RequiredApprovals int32                  `protobuf:"varint,5,opt,name=required_approvals,json=requiredApprovals"`
+	xxx_hidden_KeySubmitted      bool                   `protobuf:"varint,6,opt,name=key_submitted,json=keySubmitted"`
+	xxx_hidden_SharesSubmitted   int32                  `protobuf:"varint,7,opt,name=shares_submitted,json=sharesSubmitted"`
+	xxx_hidden_ShareThreshold    int32                  `protobuf:"varint,8,opt,name=share_threshold,json=shareThreshold"`
+	xxx_hidden_ShareIndex        int32                  `protobuf:"varint,9,opt,name=share_index,json=shareIndex"`
+	xxx_hidden_Labels            map[string]string      `protobuf:"bytes,10,rep,name=labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Name              *string                `protobuf:"bytes,11,opt,name=name"`
+	xxx_hidden_Fingerprint       *string                `protobuf:"bytes,12,opt,name=fingerprint"`
+	xxx_hidden_Description       *string                `protobuf:"bytes,13,opt,name=description"`
+	xxx_hidden_Secrets           *[]*SecretStatus       `protobuf:"bytes,14,rep,name=secrets"`
+	xxx_hidden_PeerAddress       *string                `protobuf:"bytes,15,opt,name=peer_address,json=peerAddress"`
+	xxx_hidden_StartedAt         *string                `protobuf:"bytes,16,opt,name=started_at,json=startedAt"`
+	xxx_hidden_DeniedBy          *string                `protobuf:"bytes,17,opt,name=denied_by,json=deniedBy"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *Machine) Reset() {
+	*x = Machine{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Machine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Machine) ProtoMessage() {}
+
+func (x *Machine) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Machine) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetPublicKey() []byte {
+	if x != nil {
+		return x.xxx_hidden_PublicKey
+	}
+	return nil
+}
+
+func (x *Machine) GetLastAsked() string {
+	if x != nil {
+		if x.xxx_hidden_LastAsked != nil {
+			return *x.xxx_hidden_LastAsked
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetApprovals() []*Approval {
+	if x != nil {
+		if x.xxx_hidden_Approvals != nil {
+			return *x.xxx_hidden_Approvals
+		}
+	}
+	return nil
+}
+
+func (x *Machine) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.xxx_hidden_RequiredApprovals
+	}
+	return 0
+}
+
+func (x *Machine) GetKeySubmitted() bool {
+	if x != nil {
+		return x.xxx_hidden_KeySubmitted
+	}
+	return false
+}
+
+func (x *Machine) GetSharesSubmitted() int32 {
+	if x != nil {
+		return x.xxx_hidden_SharesSubmitted
+	}
+	return 0
+}
+
+func (x *Machine) GetShareThreshold() int32 {
+	if x != nil {
+		return x.xxx_hidden_ShareThreshold
+	}
+	return 0
+}
+
+func (x *Machine) GetShareIndex() int32 {
+	if x != nil {
+		return x.xxx_hidden_ShareIndex
+	}
+	return 0
+}
+
+func (x *Machine) GetLabels() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Labels
+	}
+	return nil
+}
+
+func (x *Machine) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetFingerprint() string {
+	if x != nil {
+		if x.xxx_hidden_Fingerprint != nil {
+			return *x.xxx_hidden_Fingerprint
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetSecrets() []*SecretStatus {
+	if x != nil {
+		if x.xxx_hidden_Secrets != nil {
+			return *x.xxx_hidden_Secrets
+		}
+	}
+	return nil
+}
+
+func (x *Machine) GetPeerAddress() string {
+	if x != nil {
+		if x.xxx_hidden_PeerAddress != nil {
+			return *x.xxx_hidden_PeerAddress
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetStartedAt() string {
+	if x != nil {
+		if x.xxx_hidden_StartedAt != nil {
+			return *x.xxx_hidden_StartedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) GetDeniedBy() string {
+	if x != nil {
+		if x.xxx_hidden_DeniedBy != nil {
+			return *x.xxx_hidden_DeniedBy
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Machine) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 17)
+}
+
+func (x *Machine) SetPublicKey(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_PublicKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 17)
+}
+
+func (x *Machine) SetLastAsked(v string) {
+	x.xxx_hidden_LastAsked = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 17)
+}
+
+func (x *Machine) SetApprovals(v []*Approval) {
+	x.xxx_hidden_Approvals = &v
+}
+
+func (x *Machine) SetRequiredApprovals(v int32) {
+	x.xxx_hidden_RequiredApprovals = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 17)
+}
+
+func (x *Machine) SetKeySubmitted(v bool) {
+	x.xxx_hidden_KeySubmitted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 17)
+}
+
+func (x *Machine) SetSharesSubmitted(v int32) {
+	x.xxx_hidden_SharesSubmitted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 17)
+}
+
+func (x *Machine) SetShareThreshold(v int32) {
+	x.xxx_hidden_ShareThreshold = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 17)
+}
+
+func (x *Machine) SetShareIndex(v int32) {
+	x.xxx_hidden_ShareIndex = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 17)
+}
+
+func (x *Machine) SetLabels(v map[string]string) {
+	x.xxx_hidden_Labels = v
+}
+
+func (x *Machine) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 17)
+}
+
+func (x *Machine) SetFingerprint(v string) {
+	x.xxx_hidden_Fingerprint = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 17)
+}
+
+func (x *Machine) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 17)
+}
+
+func (x *Machine) SetSecrets(v []*SecretStatus) {
+	x.xxx_hidden_Secrets = &v
+}
+
+func (x *Machine) SetPeerAddress(v string) {
+	x.xxx_hidden_PeerAddress = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 17)
+}
+
+func (x *Machine) SetStartedAt(v string) {
+	x.xxx_hidden_StartedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 17)
+}
+
+func (x *Machine) SetDeniedBy(v string) {
+	x.xxx_hidden_DeniedBy = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 17)
+}
+
+func (x *Machine) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Machine) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Machine) HasLastAsked() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Machine) HasRequiredApprovals() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Machine) HasKeySubmitted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Machine) HasSharesSubmitted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Machine) HasShareThreshold() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Machine) HasShareIndex() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Machine) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Machine) HasFingerprint() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *Machine) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Machine) HasPeerAddress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *Machine) HasStartedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *Machine) HasDeniedBy() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *Machine) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Machine) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *Machine) ClearLastAsked() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_LastAsked = nil
+}
+
+func (x *Machine) ClearRequiredApprovals() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_RequiredApprovals = 0
+}
+
+func (x *Machine) ClearKeySubmitted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_KeySubmitted = false
+}
+
+func (x *Machine) ClearSharesSubmitted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_SharesSubmitted = 0
+}
+
+func (x *Machine) ClearShareThreshold() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_ShareThreshold = 0
+}
+
+func (x *Machine) ClearShareIndex() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_ShareIndex = 0
+}
+
+func (x *Machine) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Machine) ClearFingerprint() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_Fingerprint = nil
+}
+
+func (x *Machine) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *Machine) ClearPeerAddress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_PeerAddress = nil
+}
+
+func (x *Machine) ClearStartedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_StartedAt = nil
+}
+
+func (x *Machine) ClearDeniedBy() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_DeniedBy = nil
+}
+
+type Machine_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id                *string
+	PublicKey         []byte
+	LastAsked         *string
+	Approvals         []*Approval
+	RequiredApprovals *int32
+	KeySubmitted      *bool
+	SharesSubmitted   *int32
+	ShareThreshold    *int32
+	ShareIndex        *int32
+	Labels            map[string]string
+	Name              *string
+	Fingerprint       *string
+	Description       *string
+	Secrets           []*SecretStatus
+	PeerAddress       *string
+	StartedAt         *string
+	DeniedBy          *string
+}
+
+func (b0 Machine_builder) Build() *Machine {
+	m0 := &Machine{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 17)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 17)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.LastAsked != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 17)
+		x.xxx_hidden_LastAsked = b.LastAsked
+	}
+	x.xxx_hidden_Approvals = &b.Approvals
+	if b.RequiredApprovals != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 17)
+		x.xxx_hidden_RequiredApprovals = *b.RequiredApprovals
+	}
+	if b.KeySubmitted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 17)
+		x.xxx_hidden_KeySubmitted = *b.KeySubmitted
+	}
+	if b.SharesSubmitted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 17)
+		x.xxx_hidden_SharesSubmitted = *b.SharesSubmitted
+	}
+	if b.ShareThreshold != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 17)
+		x.xxx_hidden_ShareThreshold = *b.ShareThreshold
+	}
+	if b.ShareIndex != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 17)
+		x.xxx_hidden_ShareIndex = *b.ShareIndex
+	}
+	x.xxx_hidden_Labels = b.Labels
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 17)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Fingerprint != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 17)
+		x.xxx_hidden_Fingerprint = b.Fingerprint
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 17)
+		x.xxx_hidden_Description = b.Description
+	}
+	x.xxx_hidden_Secrets = &b.Secrets
+	if b.PeerAddress != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 17)
+		x.xxx_hidden_PeerAddress = b.PeerAddress
+	}
+	if b.StartedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 17)
+		x.xxx_hidden_StartedAt = b.StartedAt
+	}
+	if b.DeniedBy != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 17)
+		x.xxx_hidden_DeniedBy = b.DeniedBy
+	}
+	return m0
+}
+
+type ListSessionsResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Machines *[]*Machine            `protobuf:"bytes,1,rep,name=machines"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSessionsResponse) GetMachines() []*Machine {
+	if x != nil {
+		if x.xxx_hidden_Machines != nil {
+			return *x.xxx_hidden_Machines
+		}
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) SetMachines(v []*Machine) {
+	x.xxx_hidden_Machines = &v
+}
+
+type ListSessionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Machines []*Machine
+}
+
+func (b0 ListSessionsResponse_builder) Build() *ListSessionsResponse {
+	m0 := &ListSessionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Machines = &b.Machines
+	return m0
+}
+
+type SubmitKeyRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId   *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_EncKey      []byte                 `protobuf:"bytes,2,opt,name=enc_key,json=encKey"`
+	xxx_hidden_ShareIndex  int32                  `protobuf:"varint,3,opt,name=share_index,json=shareIndex"`
+	xxx_hidden_Secret      *string                `protobuf:"bytes,4,opt,name=secret"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SubmitKeyRequest) Reset() {
+	*x = SubmitKeyRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKeyRequest) ProtoMessage() {}
+
+func (x *SubmitKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SubmitKeyRequest) GetMachineId() string {
+	if x != nil {
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SubmitKeyRequest) GetEncKey() []byte {
+	if x != nil {
+		return x.xxx_hidden_EncKey
+	}
+	return nil
+}
+
+func (x *SubmitKeyRequest) GetShareIndex() int32 {
+	if x != nil {
+		return x.xxx_hidden_ShareIndex
+	}
+	return 0
+}
+
+func (x *SubmitKeyRequest) GetSecret() string {
+	if x != nil {
+		if x.xxx_hidden_Secret != nil {
+			return *x.xxx_hidden_Secret
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SubmitKeyRequest) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SubmitKeyRequest) SetEncKey(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_EncKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *SubmitKeyRequest) SetShareIndex(v int32) {
+	x.xxx_hidden_ShareIndex = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *SubmitKeyRequest) SetSecret(v string) {
+	x.xxx_hidden_Secret = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SubmitKeyRequest) HasMachineId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SubmitKeyRequest) HasEncKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SubmitKeyRequest) HasShareIndex() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SubmitKeyRequest) HasSecret() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SubmitKeyRequest) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
+}
+
+func (x *SubmitKeyRequest) ClearEncKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncKey = nil
+}
+
+func (x *SubmitKeyRequest) ClearShareIndex() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ShareIndex = 0
+}
+
+func (x *SubmitKeyRequest) ClearSecret() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Secret = nil
+}
+
+type SubmitKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MachineId  *string
+	EncKey     []byte
+	ShareIndex *int32
+	Secret     *string
+}
+
+func (b0 SubmitKeyRequest_builder) Build() *SubmitKeyRequest {
+	m0 := &SubmitKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.EncKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_EncKey = b.EncKey
+	}
+	if b.ShareIndex != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_ShareIndex = *b.ShareIndex
+	}
+	if b.Secret != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Secret = b.Secret
+	}
+	return m0
+}
+
+type SubmitKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitKeyResponse) Reset() {
+	*x = SubmitKeyResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKeyResponse) ProtoMessage() {}
+
+func (x *SubmitKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type SubmitKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SubmitKeyResponse_builder) Build() *SubmitKeyResponse {
+	m0 := &SubmitKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ApproveSessionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId   *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_OperatorId  *string                `protobuf:"bytes,2,opt,name=operator_id,json=operatorId"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_Nonce       *string                `protobuf:"bytes,4,opt,name=nonce"`
+	xxx_hidden_SignedAt    *string                `protobuf:"bytes,5,opt,name=signed_at,json=signedAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ApproveSessionRequest) Reset() {
+	*x = ApproveSessionRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSessionRequest) ProtoMessage() {}
+
+func (x *ApproveSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApproveSessionRequest) GetMachineId() string {
+	if x != nil {
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ApproveSessionRequest) GetOperatorId() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorId != nil {
+			return *x.xxx_hidden_OperatorId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ApproveSessionRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *ApproveSessionRequest) GetNonce() string {
+	if x != nil {
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ApproveSessionRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ApproveSessionRequest) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *ApproveSessionRequest) SetOperatorId(v string) {
+	x.xxx_hidden_OperatorId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *ApproveSessionRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *ApproveSessionRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *ApproveSessionRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *ApproveSessionRequest) HasMachineId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ApproveSessionRequest) HasOperatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ApproveSessionRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ApproveSessionRequest) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ApproveSessionRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ApproveSessionRequest) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
+}
+
+func (x *ApproveSessionRequest) ClearOperatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OperatorId = nil
+}
+
+func (x *ApproveSessionRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *ApproveSessionRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *ApproveSessionRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_SignedAt = nil
+}
+
+type ApproveSessionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MachineId  *string
+	OperatorId *string
+	Signature  []byte
+	Nonce      *string
+	SignedAt   *string
+}
+
+func (b0 ApproveSessionRequest_builder) Build() *ApproveSessionRequest {
+	m0 := &ApproveSessionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.OperatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_OperatorId = b.OperatorId
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	return m0
+}
+
+type ApproveSessionResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Approvals         int32                  `protobuf:"varint,1,opt,name=approvals"`
+	xxx_hidden_RequiredApprovals int32                  `protobuf:"varint,2,opt,name=required_approvals,json=requiredApprovals"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ApproveSessionResponse) Reset() {
+	*x = ApproveSessionResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSessionResponse) ProtoMessage() {}
+
+func (x *ApproveSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApproveSessionResponse) GetApprovals() int32 {
+	if x != nil {
+		return x.xxx_hidden_Approvals
+	}
+	return 0
+}
+
+func (x *ApproveSessionResponse) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.xxx_hidden_RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ApproveSessionResponse) SetApprovals(v int32) {
+	x.xxx_hidden_Approvals = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ApproveSessionResponse) SetRequiredApprovals(v int32) {
+	x.xxx_hidden_RequiredApprovals = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ApproveSessionResponse) HasApprovals() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ApproveSessionResponse) HasRequiredApprovals() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ApproveSessionResponse) ClearApprovals() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Approvals = 0
+}
+
+func (x *ApproveSessionResponse) ClearRequiredApprovals() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_RequiredApprovals = 0
+}
+
+type ApproveSessionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Approvals         *int32
+	RequiredApprovals *int32
+}
+
+func (b0 ApproveSessionResponse_builder) Build() *ApproveSessionResponse {
+	m0 := &ApproveSessionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Approvals != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Approvals = *b.Approvals
+	}
+	if b.RequiredApprovals != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_RequiredApprovals = *b.RequiredApprovals
+	}
+	return m0
+}
+
+type DenySessionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId   *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_OperatorId  *string                `protobuf:"bytes,2,opt,name=operator_id,json=operatorId"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_Nonce       *string                `protobuf:"bytes,4,opt,name=nonce"`
+	xxx_hidden_SignedAt    *string                `protobuf:"bytes,5,opt,name=signed_at,json=signedAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DenySessionRequest) Reset() {
+	*x = DenySessionRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenySessionRequest) ProtoMessage() {}
+
+func (x *DenySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DenySessionRequest) GetMachineId() string {
+	if x != nil {
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DenySessionRequest) GetOperatorId() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorId != nil {
+			return *x.xxx_hidden_OperatorId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DenySessionRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *DenySessionRequest) GetNonce() string {
+	if x != nil {
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DenySessionRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DenySessionRequest) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *DenySessionRequest) SetOperatorId(v string) {
+	x.xxx_hidden_OperatorId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DenySessionRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DenySessionRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DenySessionRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *DenySessionRequest) HasMachineId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DenySessionRequest) HasOperatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DenySessionRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DenySessionRequest) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DenySessionRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DenySessionRequest) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
+}
+
+func (x *DenySessionRequest) ClearOperatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OperatorId = nil
+}
+
+func (x *DenySessionRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *DenySessionRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *DenySessionRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_SignedAt = nil
+}
+
+type DenySessionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MachineId  *string
+	OperatorId *string
+	Signature  []byte
+	Nonce      *string
+	SignedAt   *string
+}
+
+func (b0 DenySessionRequest_builder) Build() *DenySessionRequest {
+	m0 := &DenySessionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.OperatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_OperatorId = b.OperatorId
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	return m0
+}
+
+type DenySessionResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenySessionResponse) Reset() {
+	*x = DenySessionResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenySessionResponse) ProtoMessage() {}
+
+func (x *DenySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DenySessionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DenySessionResponse_builder) Build() *DenySessionResponse {
+	m0 := &DenySessionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CancelSessionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId   *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_OperatorId  *string                `protobuf:"bytes,2,opt,name=operator_id,json=operatorId"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_Nonce       *string                `protobuf:"bytes,4,opt,name=nonce"`
+	xxx_hidden_SignedAt    *string                `protobuf:"bytes,5,opt,name=signed_at,json=signedAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CancelSessionRequest) Reset() {
+	*x = CancelSessionRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSessionRequest) ProtoMessage() {}
+
+func (x *CancelSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CancelSessionRequest) GetMachineId() string {
+	if x != nil {
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelSessionRequest) GetOperatorId() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorId != nil {
+			return *x.xxx_hidden_OperatorId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelSessionRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *CancelSessionRequest) GetNonce() string {
+	if x != nil {
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelSessionRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelSessionRequest) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *CancelSessionRequest) SetOperatorId(v string) {
+	x.xxx_hidden_OperatorId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *CancelSessionRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *CancelSessionRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *CancelSessionRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *CancelSessionRequest) HasMachineId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CancelSessionRequest) HasOperatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CancelSessionRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CancelSessionRequest) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CancelSessionRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CancelSessionRequest) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
+}
+
+func (x *CancelSessionRequest) ClearOperatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OperatorId = nil
+}
+
+func (x *CancelSessionRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *CancelSessionRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *CancelSessionRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_SignedAt = nil
+}
+
+type CancelSessionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MachineId  *string
+	OperatorId *string
+	Signature  []byte
+	Nonce      *string
+	SignedAt   *string
+}
+
+func (b0 CancelSessionRequest_builder) Build() *CancelSessionRequest {
+	m0 := &CancelSessionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.OperatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_OperatorId = b.OperatorId
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	return m0
+}
+
+type CancelSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSessionResponse) Reset() {
+	*x = CancelSessionResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSessionResponse) ProtoMessage() {}
+
+func (x *CancelSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type CancelSessionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 CancelSessionResponse_builder) Build() *CancelSessionResponse {
+	m0 := &CancelSessionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityRequest) Reset() {
+	*x = GetIdentityRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityRequest) ProtoMessage() {}
+
+func (x *GetIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetIdentityRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetIdentityRequest_builder) Build() *GetIdentityRequest {
+	m0 := &GetIdentityRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetIdentityResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PublicKey   []byte                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetIdentityResponse) Reset() {
+	*x = GetIdentityResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityResponse) ProtoMessage() {}
+
+func (x *GetIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetIdentityResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.xxx_hidden_PublicKey
+	}
+	return nil
+}
+
+func (x *GetIdentityResponse) SetPublicKey(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_PublicKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetIdentityResponse) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetIdentityResponse) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_PublicKey = nil
+}
+
+type GetIdentityResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PublicKey []byte
+}
+
+func (b0 GetIdentityResponse_builder) Build() *GetIdentityResponse {
+	m0 := &GetIdentityResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	return m0
+}
+
+type VaultStatus struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Initialized bool                   `protobuf:"varint,1,opt,name=initialized"`
+	xxx_hidden_Sealed      bool                   `protobuf:"varint,2,opt,name=sealed"`
+	xxx_hidden_UnsealedAt  *string                `protobuf:"bytes,3,opt,name=unsealed_at,json=unsealedAt"`
+	xxx_hidden_SealsAt     *string                `protobuf:"bytes,4,opt,name=seals_at,json=sealsAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VaultStatus) Reset() {
+	*x = VaultStatus{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultStatus) ProtoMessage() {}
+
+func (x *VaultStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VaultStatus) GetInitialized() bool {
+	if x != nil {
+		return x.xxx_hidden_Initialized
+	}
+	return false
+}
+
+func (x *VaultStatus) GetSealed() bool {
+	if x != nil {
+		return x.xxx_hidden_Sealed
+	}
+	return false
+}
+
+func (x *VaultStatus) GetUnsealedAt() string {
+	if x != nil {
+		if x.xxx_hidden_UnsealedAt != nil {
+			return *x.xxx_hidden_UnsealedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VaultStatus) GetSealsAt() string {
+	if x != nil {
+		if x.xxx_hidden_SealsAt != nil {
+			return *x.xxx_hidden_SealsAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VaultStatus) SetInitialized(v bool) {
+	x.xxx_hidden_Initialized = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *VaultStatus) SetSealed(v bool) {
+	x.xxx_hidden_Sealed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *VaultStatus) SetUnsealedAt(v string) {
+	x.xxx_hidden_UnsealedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *VaultStatus) SetSealsAt(v string) {
+	x.xxx_hidden_SealsAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *VaultStatus) HasInitialized() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VaultStatus) HasSealed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VaultStatus) HasUnsealedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *VaultStatus) HasSealsAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *VaultStatus) ClearInitialized() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Initialized = false
+}
+
+func (x *VaultStatus) ClearSealed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Sealed = false
+}
+
+func (x *VaultStatus) ClearUnsealedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnsealedAt = nil
+}
+
+func (x *VaultStatus) ClearSealsAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_SealsAt = nil
+}
+
+type VaultStatus_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Initialized *bool
+	Sealed      *bool
+	UnsealedAt  *string
+	SealsAt     *string
+}
+
+func (b0 VaultStatus_builder) Build() *VaultStatus {
+	m0 := &VaultStatus{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Initialized != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Initialized = *b.Initialized
+	}
+	if b.Sealed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Sealed = *b.Sealed
+	}
+	if b.UnsealedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_UnsealedAt = b.UnsealedAt
+	}
+	if b.SealsAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_SealsAt = b.SealsAt
+	}
+	return m0
+}
+
+type GetVaultStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultStatusRequest) Reset() {
+	*x = GetVaultStatusRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultStatusRequest) ProtoMessage() {}
+
+func (x *GetVaultStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetVaultStatusRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetVaultStatusRequest_builder) Build() *GetVaultStatusRequest {
+	m0 := &GetVaultStatusRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetVaultStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Status *VaultStatus           `protobuf:"bytes,1,opt,name=status"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetVaultStatusResponse) Reset() {
+	*x = GetVaultStatusResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultStatusResponse) ProtoMessage() {}
+
+func (x *GetVaultStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetVaultStatusResponse) GetStatus() *VaultStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return nil
+}
+
+func (x *GetVaultStatusResponse) SetStatus(v *VaultStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *GetVaultStatusResponse) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Status != nil
+}
+
+func (x *GetVaultStatusResponse) ClearStatus() {
+	x.xxx_hidden_Status = nil
+}
+
+type GetVaultStatusResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Status *VaultStatus
+}
+
+func (b0 GetVaultStatusResponse_builder) Build() *GetVaultStatusResponse {
+	m0 := &GetVaultStatusResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+type UnsealRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OperatorId  *string                `protobuf:"bytes,1,opt,name=operator_id,json=operatorId"`
+	xxx_hidden_EncKey      []byte                 `protobuf:"bytes,2,opt,name=enc_key,json=encKey"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_Nonce       *string                `protobuf:"bytes,4,opt,name=nonce"`
+	xxx_hidden_SignedAt    *string                `protobuf:"bytes,5,opt,name=signed_at,json=signedAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UnsealRequest) Reset() {
+	*x = UnsealRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsealRequest) ProtoMessage() {}
+
+func (x *UnsealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnsealRequest) GetOperatorId() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorId != nil {
+			return *x.xxx_hidden_OperatorId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnsealRequest) GetEncKey() []byte {
+	if x != nil {
+		return x.xxx_hidden_EncKey
+	}
+	return nil
+}
+
+func (x *UnsealRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *UnsealRequest) GetNonce() string {
+	if x != nil {
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnsealRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UnsealRequest) SetOperatorId(v string) {
+	x.xxx_hidden_OperatorId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *UnsealRequest) SetEncKey(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_EncKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *UnsealRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *UnsealRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *UnsealRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *UnsealRequest) HasOperatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UnsealRequest) HasEncKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UnsealRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UnsealRequest) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *UnsealRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *UnsealRequest) ClearOperatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OperatorId = nil
+}
+
+func (x *UnsealRequest) ClearEncKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncKey = nil
+}
+
+func (x *UnsealRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *UnsealRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *UnsealRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_SignedAt = nil
+}
+
+type UnsealRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OperatorId *string
+	EncKey     []byte
+	Signature  []byte
+	Nonce      *string
+	SignedAt   *string
+}
+
+func (b0 UnsealRequest_builder) Build() *UnsealRequest {
+	m0 := &UnsealRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OperatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_OperatorId = b.OperatorId
+	}
+	if b.EncKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_EncKey = b.EncKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	return m0
+}
+
+type UnsealResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Status *VaultStatus           `protobuf:"bytes,1,opt,name=status"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UnsealResponse) Reset() {
+	*x = UnsealResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsealResponse) ProtoMessage() {}
+
+func (x *UnsealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnsealResponse) GetStatus() *VaultStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return nil
+}
+
+func (x *UnsealResponse) SetStatus(v *VaultStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *UnsealResponse) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Status != nil
+}
+
+func (x *UnsealResponse) ClearStatus() {
+	x.xxx_hidden_Status = nil
+}
+
+type UnsealResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Status *VaultStatus
+}
+
+func (b0 UnsealResponse_builder) Build() *UnsealResponse {
+	m0 := &UnsealResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+type SealRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OperatorId  *string                `protobuf:"bytes,1,opt,name=operator_id,json=operatorId"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,2,opt,name=signature"`
+	xxx_hidden_Nonce       *string                `protobuf:"bytes,3,opt,name=nonce"`
+	xxx_hidden_SignedAt    *string                `protobuf:"bytes,4,opt,name=signed_at,json=signedAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SealRequest) Reset() {
+	*x = SealRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealRequest) ProtoMessage() {}
+
+func (x *SealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SealRequest) GetOperatorId() string {
+	if x != nil {
+		if x.xxx_hidden_OperatorId != nil {
+			return *x.xxx_hidden_OperatorId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SealRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *SealRequest) GetNonce() string {
+	if x != nil {
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SealRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SealRequest) SetOperatorId(v string) {
+	x.xxx_hidden_OperatorId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SealRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *SealRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *SealRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SealRequest) HasOperatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SealRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SealRequest) HasNonce() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *Machine) ClearId() {
+func (x *SealRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SealRequest) ClearOperatorId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_OperatorId = nil
 }
 
-func (x *Machine) ClearPublicKey() {
+func (x *SealRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *SealRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *SealRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_SignedAt = nil
+}
+
+type SealRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OperatorId *string
+	Signature  []byte
+	Nonce      *string
+	SignedAt   *string
+}
+
+func (b0 SealRequest_builder) Build() *SealRequest {
+	m0 := &SealRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OperatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_OperatorId = b.OperatorId
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	return m0
+}
+
+type SealResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Status *VaultStatus           `protobuf:"bytes,1,opt,name=status"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SealResponse) Reset() {
+	*x = SealResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealResponse) ProtoMessage() {}
+
+func (x *SealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SealResponse) GetStatus() *VaultStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return nil
+}
+
+func (x *SealResponse) SetStatus(v *VaultStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *SealResponse) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Status != nil
+}
+
+func (x *SealResponse) ClearStatus() {
+	x.xxx_hidden_Status = nil
+}
+
+type SealResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Status *VaultStatus
+}
+
+func (b0 SealResponse_builder) Build() *SealResponse {
+	m0 := &SealResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+type EnrollRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token       *string                `protobuf:"bytes,1,opt,name=token"`
+	xxx_hidden_PublicKey   []byte                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature   []byte                 `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_Hardware    *HardwareAttributes    `protobuf:"bytes,4,opt,name=hardware"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *EnrollRequest) Reset() {
+	*x = EnrollRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollRequest) ProtoMessage() {}
+
+func (x *EnrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EnrollRequest) GetToken() string {
+	if x != nil {
+		if x.xxx_hidden_Token != nil {
+			return *x.xxx_hidden_Token
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *EnrollRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.xxx_hidden_PublicKey
+	}
+	return nil
+}
+
+func (x *EnrollRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *EnrollRequest) GetHardware() *HardwareAttributes {
+	if x != nil {
+		return x.xxx_hidden_Hardware
+	}
+	return nil
+}
+
+func (x *EnrollRequest) SetToken(v string) {
+	x.xxx_hidden_Token = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *EnrollRequest) SetPublicKey(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_PublicKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *EnrollRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *EnrollRequest) SetHardware(v *HardwareAttributes) {
+	x.xxx_hidden_Hardware = v
+}
+
+func (x *EnrollRequest) HasToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *EnrollRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *EnrollRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *EnrollRequest) HasHardware() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hardware != nil
+}
+
+func (x *EnrollRequest) ClearToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Token = nil
+}
+
+func (x *EnrollRequest) ClearPublicKey() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_PublicKey = nil
 }
 
-func (x *Machine) ClearLastAsked() {
+func (x *EnrollRequest) ClearSignature() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_LastAsked = nil
+	x.xxx_hidden_Signature = nil
 }
 
-type Machine_builder struct {
+func (x *EnrollRequest) ClearHardware() {
+	x.xxx_hidden_Hardware = nil
+}
+
+type EnrollRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id        *string
+	Token     *string
 	PublicKey []byte
-	LastAsked *string
+	Signature []byte
+	Hardware  *HardwareAttributes
 }
 
-func (b0 Machine_builder) Build() *Machine {
-	m0 := &Machine{}
+func (b0 EnrollRequest_builder) Build() *EnrollRequest {
+	m0 := &EnrollRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
-		x.xxx_hidden_Id = b.Id
+	if b.Token != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Token = b.Token
 	}
 	if b.PublicKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_PublicKey = b.PublicKey
 	}
-	if b.LastAsked != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
-		x.xxx_hidden_LastAsked = b.LastAsked
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Signature = b.Signature
 	}
+	x.xxx_hidden_Hardware = b.Hardware
 	return m0
 }
 
-type ListSessionsResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Machines *[]*Machine            `protobuf:"bytes,1,rep,name=machines"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type EnrollResponse struct {
+	state                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId           *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_PendingConfirmation bool                   `protobuf:"varint,2,opt,name=pending_confirmation,json=pendingConfirmation"`
+	XXX_raceDetectHookData         protoimpl.RaceDetectHookData
+	XXX_presence                   [1]uint32
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
 }
 
-func (x *ListSessionsResponse) Reset() {
-	*x = ListSessionsResponse{}
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[6]
+func (x *EnrollResponse) Reset() {
+	*x = EnrollResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSessionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EnrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollResponse) ProtoMessage() {}
+
+func (x *EnrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EnrollResponse) GetMachineId() string {
+	if x != nil {
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *EnrollResponse) GetPendingConfirmation() bool {
+	if x != nil {
+		return x.xxx_hidden_PendingConfirmation
+	}
+	return false
+}
+
+func (x *EnrollResponse) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (*ListSessionsResponse) ProtoMessage() {}
+func (x *EnrollResponse) SetPendingConfirmation(v bool) {
+	x.xxx_hidden_PendingConfirmation = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
 
-func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *EnrollResponse) HasMachineId() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *ListSessionsResponse) GetMachines() []*Machine {
-	if x != nil {
-		if x.xxx_hidden_Machines != nil {
-			return *x.xxx_hidden_Machines
-		}
+func (x *EnrollResponse) HasPendingConfirmation() bool {
+	if x == nil {
+		return false
 	}
-	return nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *ListSessionsResponse) SetMachines(v []*Machine) {
-	x.xxx_hidden_Machines = &v
+func (x *EnrollResponse) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
 }
 
-type ListSessionsResponse_builder struct {
+func (x *EnrollResponse) ClearPendingConfirmation() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PendingConfirmation = false
+}
+
+type EnrollResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Machines []*Machine
+	MachineId           *string
+	PendingConfirmation *bool
 }
 
-func (b0 ListSessionsResponse_builder) Build() *ListSessionsResponse {
-	m0 := &ListSessionsResponse{}
+func (b0 EnrollResponse_builder) Build() *EnrollResponse {
+	m0 := &EnrollResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Machines = &b.Machines
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.PendingConfirmation != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_PendingConfirmation = *b.PendingConfirmation
+	}
 	return m0
 }
 
-type SubmitKeyRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_MachineId   *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
-	xxx_hidden_EncKey      []byte                 `protobuf:"bytes,2,opt,name=enc_key,json=encKey"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type RotateMachineKeyRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId    *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_NewPublicKey []byte                 `protobuf:"bytes,2,opt,name=new_public_key,json=newPublicKey"`
+	xxx_hidden_Nonce        *string                `protobuf:"bytes,3,opt,name=nonce"`
+	xxx_hidden_SignedAt     *string                `protobuf:"bytes,4,opt,name=signed_at,json=signedAt"`
+	xxx_hidden_Signature    []byte                 `protobuf:"bytes,5,opt,name=signature"`
+	xxx_hidden_NewSignature []byte                 `protobuf:"bytes,6,opt,name=new_signature,json=newSignature"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *SubmitKeyRequest) Reset() {
-	*x = SubmitKeyRequest{}
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[7]
+func (x *RotateMachineKeyRequest) Reset() {
+	*x = RotateMachineKeyRequest{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitKeyRequest) String() string {
+func (x *RotateMachineKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitKeyRequest) ProtoMessage() {}
+func (*RotateMachineKeyRequest) ProtoMessage() {}
 
-func (x *SubmitKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[7]
+func (x *RotateMachineKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +4224,7 @@ func (x *SubmitKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SubmitKeyRequest) GetMachineId() string {
+func (x *RotateMachineKeyRequest) GetMachineId() string {
 	if x != nil {
 		if x.xxx_hidden_MachineId != nil {
 			return *x.xxx_hidden_MachineId
@@ -689,93 +4234,226 @@ func (x *SubmitKeyRequest) GetMachineId() string {
 	return ""
 }
 
-func (x *SubmitKeyRequest) GetEncKey() []byte {
+func (x *RotateMachineKeyRequest) GetNewPublicKey() []byte {
 	if x != nil {
-		return x.xxx_hidden_EncKey
+		return x.xxx_hidden_NewPublicKey
 	}
 	return nil
 }
 
-func (x *SubmitKeyRequest) SetMachineId(v string) {
+func (x *RotateMachineKeyRequest) GetNonce() string {
+	if x != nil {
+		if x.xxx_hidden_Nonce != nil {
+			return *x.xxx_hidden_Nonce
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RotateMachineKeyRequest) GetSignedAt() string {
+	if x != nil {
+		if x.xxx_hidden_SignedAt != nil {
+			return *x.xxx_hidden_SignedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RotateMachineKeyRequest) GetSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_Signature
+	}
+	return nil
+}
+
+func (x *RotateMachineKeyRequest) GetNewSignature() []byte {
+	if x != nil {
+		return x.xxx_hidden_NewSignature
+	}
+	return nil
+}
+
+func (x *RotateMachineKeyRequest) SetMachineId(v string) {
 	x.xxx_hidden_MachineId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
 }
 
-func (x *SubmitKeyRequest) SetEncKey(v []byte) {
+func (x *RotateMachineKeyRequest) SetNewPublicKey(v []byte) {
 	if v == nil {
 		v = []byte{}
 	}
-	x.xxx_hidden_EncKey = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	x.xxx_hidden_NewPublicKey = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
 }
 
-func (x *SubmitKeyRequest) HasMachineId() bool {
+func (x *RotateMachineKeyRequest) SetNonce(v string) {
+	x.xxx_hidden_Nonce = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *RotateMachineKeyRequest) SetSignedAt(v string) {
+	x.xxx_hidden_SignedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *RotateMachineKeyRequest) SetSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Signature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *RotateMachineKeyRequest) SetNewSignature(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_NewSignature = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *RotateMachineKeyRequest) HasMachineId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *SubmitKeyRequest) HasEncKey() bool {
+func (x *RotateMachineKeyRequest) HasNewPublicKey() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *SubmitKeyRequest) ClearMachineId() {
+func (x *RotateMachineKeyRequest) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RotateMachineKeyRequest) HasSignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RotateMachineKeyRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RotateMachineKeyRequest) HasNewSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *RotateMachineKeyRequest) ClearMachineId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_MachineId = nil
 }
 
-func (x *SubmitKeyRequest) ClearEncKey() {
+func (x *RotateMachineKeyRequest) ClearNewPublicKey() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_EncKey = nil
+	x.xxx_hidden_NewPublicKey = nil
 }
 
-type SubmitKeyRequest_builder struct {
+func (x *RotateMachineKeyRequest) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Nonce = nil
+}
+
+func (x *RotateMachineKeyRequest) ClearSignedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_SignedAt = nil
+}
+
+func (x *RotateMachineKeyRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *RotateMachineKeyRequest) ClearNewSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_NewSignature = nil
+}
+
+type RotateMachineKeyRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	MachineId *string
-	EncKey    []byte
+	MachineId    *string
+	NewPublicKey []byte
+	Nonce        *string
+	SignedAt     *string
+	Signature    []byte
+	NewSignature []byte
 }
 
-func (b0 SubmitKeyRequest_builder) Build() *SubmitKeyRequest {
-	m0 := &SubmitKeyRequest{}
+func (b0 RotateMachineKeyRequest_builder) Build() *RotateMachineKeyRequest {
+	m0 := &RotateMachineKeyRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.MachineId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
 		x.xxx_hidden_MachineId = b.MachineId
 	}
-	if b.EncKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_EncKey = b.EncKey
+	if b.NewPublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_NewPublicKey = b.NewPublicKey
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Nonce = b.Nonce
+	}
+	if b.SignedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_SignedAt = b.SignedAt
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.NewSignature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_NewSignature = b.NewSignature
 	}
 	return m0
 }
 
-type SubmitKeyResponse struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RotateMachineKeyResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MachineId      *string                `protobuf:"bytes,1,opt,name=machine_id,json=machineId"`
+	xxx_hidden_Fingerprint    *string                `protobuf:"bytes,2,opt,name=fingerprint"`
+	xxx_hidden_AliasExpiresAt *string                `protobuf:"bytes,3,opt,name=alias_expires_at,json=aliasExpiresAt"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *SubmitKeyResponse) Reset() {
-	*x = SubmitKeyResponse{}
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[8]
+func (x *RotateMachineKeyResponse) Reset() {
+	*x = RotateMachineKeyResponse{}
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitKeyResponse) String() string {
+func (x *RotateMachineKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitKeyResponse) ProtoMessage() {}
+func (*RotateMachineKeyResponse) ProtoMessage() {}
 
-func (x *SubmitKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[8]
+func (x *RotateMachineKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rgst_klefki_v1_kelfki_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,114 +4464,381 @@ func (x *SubmitKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type SubmitKeyResponse_builder struct {
+func (x *RotateMachineKeyResponse) GetMachineId() string {
+	if x != nil {
+		if x.xxx_hidden_MachineId != nil {
+			return *x.xxx_hidden_MachineId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RotateMachineKeyResponse) GetFingerprint() string {
+	if x != nil {
+		if x.xxx_hidden_Fingerprint != nil {
+			return *x.xxx_hidden_Fingerprint
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RotateMachineKeyResponse) GetAliasExpiresAt() string {
+	if x != nil {
+		if x.xxx_hidden_AliasExpiresAt != nil {
+			return *x.xxx_hidden_AliasExpiresAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RotateMachineKeyResponse) SetMachineId(v string) {
+	x.xxx_hidden_MachineId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *RotateMachineKeyResponse) SetFingerprint(v string) {
+	x.xxx_hidden_Fingerprint = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *RotateMachineKeyResponse) SetAliasExpiresAt(v string) {
+	x.xxx_hidden_AliasExpiresAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *RotateMachineKeyResponse) HasMachineId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RotateMachineKeyResponse) HasFingerprint() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RotateMachineKeyResponse) HasAliasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RotateMachineKeyResponse) ClearMachineId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MachineId = nil
+}
+
+func (x *RotateMachineKeyResponse) ClearFingerprint() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Fingerprint = nil
+}
+
+func (x *RotateMachineKeyResponse) ClearAliasExpiresAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_AliasExpiresAt = nil
+}
+
+type RotateMachineKeyResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	MachineId      *string
+	Fingerprint    *string
+	AliasExpiresAt *string
 }
 
-func (b0 SubmitKeyResponse_builder) Build() *SubmitKeyResponse {
-	m0 := &SubmitKeyResponse{}
+func (b0 RotateMachineKeyResponse_builder) Build() *RotateMachineKeyResponse {
+	m0 := &RotateMachineKeyResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.MachineId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_MachineId = b.MachineId
+	}
+	if b.Fingerprint != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Fingerprint = b.Fingerprint
+	}
+	if b.AliasExpiresAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_AliasExpiresAt = b.AliasExpiresAt
+	}
 	return m0
 }
 
 var File_rgst_klefki_v1_kelfki_proto protoreflect.FileDescriptor
 
-var file_rgst_klefki_v1_kelfki_proto_rawDesc = string([]byte{
-	0x0a, 0x1b, 0x72, 0x67, 0x73, 0x74, 0x2f, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6b, 0x65, 0x6c, 0x66, 0x6b, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x72,
-	0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x21, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67,
-	0x6f, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
-	0x65, 0x6e, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65,
-	0x6e, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x07,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61,
-	0x73, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x41, 0x73, 0x6b, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x72, 0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x6e, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x13,
-	0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xd1, 0x02, 0x0a, 0x0d, 0x4b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x1e, 0x2e, 0x72, 0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x72, 0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x72, 0x67,
-	0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x67, 0x73,
-	0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x72, 0x67, 0x73,
-	0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x72, 0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b,
-	0x65, 0x79, 0x12, 0x20, 0x2e, 0x72, 0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x67, 0x73, 0x74, 0x2e, 0x6b, 0x6c, 0x65, 0x66,
-	0x6b, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x2e, 0x72,
-	0x67, 0x73, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x67,
-	0x6f, 0x2f, 0x72, 0x67, 0x73, 0x74, 0x2f, 0x6b, 0x6c, 0x65, 0x66, 0x6b, 0x69, 0x2f, 0x76, 0x31,
-	0x92, 0x03, 0x05, 0xd2, 0x3e, 0x02, 0x10, 0x03, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x70, 0xe8, 0x07,
-})
-
-var file_rgst_klefki_v1_kelfki_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+const file_rgst_klefki_v1_kelfki_proto_rawDesc = "" +
+	"\n" +
+	"\x1brgst/klefki/v1/kelfki.proto\x12\x0ergst.klefki.v1\x1a!google/protobuf/go_features.proto\"\x10\n" +
+	"\x0eGetTimeRequest\"%\n" +
+	"\x0fGetTimeResponse\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\"b\n" +
+	"\x10BootMeasurements\x12\x16\n" +
+	"\x06kernel\x18\x01 \x01(\tR\x06kernel\x12\x1c\n" +
+	"\tinitramfs\x18\x02 \x01(\tR\tinitramfs\x12\x18\n" +
+	"\acmdline\x18\x03 \x01(\tR\acmdline\"{\n" +
+	"\x12HardwareAttributes\x12!\n" +
+	"\fproduct_uuid\x18\x01 \x01(\tR\vproductUuid\x12!\n" +
+	"\fboard_serial\x18\x02 \x01(\tR\vboardSerial\x12\x1f\n" +
+	"\vmac_address\x18\x03 \x01(\tR\n" +
+	"macAddress\"\xa6\x02\n" +
+	"\rGetKeyRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x04 \x01(\tR\bsignedAt\x12M\n" +
+	"\x11boot_measurements\x18\x05 \x01(\v2 .rgst.klefki.v1.BootMeasurementsR\x10bootMeasurements\x12>\n" +
+	"\bhardware\x18\x06 \x01(\v2\".rgst.klefki.v1.HardwareAttributesR\bhardware\x12\x16\n" +
+	"\x06secret\x18\a \x01(\tR\x06secret\"\x87\x01\n" +
+	"\x0eGetKeyResponse\x12\x17\n" +
+	"\aenc_key\x18\x01 \x01(\fR\x06encKey\x12\x1d\n" +
+	"\n" +
+	"enc_shares\x18\x02 \x03(\fR\tencShares\x12\x1f\n" +
+	"\vshare_index\x18\x03 \x01(\x05R\n" +
+	"shareIndex\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\"\x1e\n" +
+	"\bKeyChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"G\n" +
+	"\x13ListSessionsRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x1a\n" +
+	"\bselector\x18\x02 \x01(\tR\bselector\"q\n" +
+	"\bApproval\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12#\n" +
+	"\roperator_name\x18\x02 \x01(\tR\foperatorName\x12\x1f\n" +
+	"\vapproved_at\x18\x03 \x01(\tR\n" +
+	"approvedAt\"\x8e\x01\n" +
+	"\fSecretStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rkey_submitted\x18\x02 \x01(\bR\fkeySubmitted\x12)\n" +
+	"\x10shares_submitted\x18\x03 \x01(\x05R\x0fsharesSubmitted\x12\x1a\n" +
+	"\breleased\x18\x04 \x01(\bR\breleased\"\xbf\x05\n" +
+	"\aMachine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x1d\n" +
+	"\n" +
+	"last_asked\x18\x03 \x01(\tR\tlastAsked\x126\n" +
+	"\tapprovals\x18\x04 \x03(\v2\x18.rgst.klefki.v1.ApprovalR\tapprovals\x12-\n" +
+	"\x12required_approvals\x18\x05 \x01(\x05R\x11requiredApprovals\x12#\n" +
+	"\rkey_submitted\x18\x06 \x01(\bR\fkeySubmitted\x12)\n" +
+	"\x10shares_submitted\x18\a \x01(\x05R\x0fsharesSubmitted\x12'\n" +
+	"\x0fshare_threshold\x18\b \x01(\x05R\x0eshareThreshold\x12\x1f\n" +
+	"\vshare_index\x18\t \x01(\x05R\n" +
+	"shareIndex\x12;\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2#.rgst.klefki.v1.Machine.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04name\x18\v \x01(\tR\x04name\x12 \n" +
+	"\vfingerprint\x18\f \x01(\tR\vfingerprint\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\x126\n" +
+	"\asecrets\x18\x0e \x03(\v2\x1c.rgst.klefki.v1.SecretStatusR\asecrets\x12!\n" +
+	"\fpeer_address\x18\x0f \x01(\tR\vpeerAddress\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x10 \x01(\tR\tstartedAt\x12\x1b\n" +
+	"\tdenied_by\x18\x11 \x01(\tR\bdeniedBy\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x14ListSessionsResponse\x123\n" +
+	"\bmachines\x18\x01 \x03(\v2\x17.rgst.klefki.v1.MachineR\bmachines\"\x83\x01\n" +
+	"\x10SubmitKeyRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x17\n" +
+	"\aenc_key\x18\x02 \x01(\fR\x06encKey\x12\x1f\n" +
+	"\vshare_index\x18\x03 \x01(\x05R\n" +
+	"shareIndex\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\"\x13\n" +
+	"\x11SubmitKeyResponse\"\xa8\x01\n" +
+	"\x15ApproveSessionRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x05 \x01(\tR\bsignedAt\"e\n" +
+	"\x16ApproveSessionResponse\x12\x1c\n" +
+	"\tapprovals\x18\x01 \x01(\x05R\tapprovals\x12-\n" +
+	"\x12required_approvals\x18\x02 \x01(\x05R\x11requiredApprovals\"\xa5\x01\n" +
+	"\x12DenySessionRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x05 \x01(\tR\bsignedAt\"\x15\n" +
+	"\x13DenySessionResponse\"\xa7\x01\n" +
+	"\x14CancelSessionRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x05 \x01(\tR\bsignedAt\"\x17\n" +
+	"\x15CancelSessionResponse\"\x14\n" +
+	"\x12GetIdentityRequest\"4\n" +
+	"\x13GetIdentityResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\fR\tpublicKey\"\x83\x01\n" +
+	"\vVaultStatus\x12 \n" +
+	"\vinitialized\x18\x01 \x01(\bR\vinitialized\x12\x16\n" +
+	"\x06sealed\x18\x02 \x01(\bR\x06sealed\x12\x1f\n" +
+	"\vunsealed_at\x18\x03 \x01(\tR\n" +
+	"unsealedAt\x12\x19\n" +
+	"\bseals_at\x18\x04 \x01(\tR\asealsAt\"\x17\n" +
+	"\x15GetVaultStatusRequest\"M\n" +
+	"\x16GetVaultStatusResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.rgst.klefki.v1.VaultStatusR\x06status\"\x9a\x01\n" +
+	"\rUnsealRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\aenc_key\x18\x02 \x01(\fR\x06encKey\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x05 \x01(\tR\bsignedAt\"E\n" +
+	"\x0eUnsealResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.rgst.klefki.v1.VaultStatusR\x06status\"\x7f\n" +
+	"\vSealRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x04 \x01(\tR\bsignedAt\"C\n" +
+	"\fSealResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.rgst.klefki.v1.VaultStatusR\x06status\"\xa2\x01\n" +
+	"\rEnrollRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x12>\n" +
+	"\bhardware\x18\x04 \x01(\v2\".rgst.klefki.v1.HardwareAttributesR\bhardware\"b\n" +
+	"\x0eEnrollResponse\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x121\n" +
+	"\x14pending_confirmation\x18\x02 \x01(\bR\x13pendingConfirmation\"\xd4\x01\n" +
+	"\x17RotateMachineKeyRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12$\n" +
+	"\x0enew_public_key\x18\x02 \x01(\fR\fnewPublicKey\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tsigned_at\x18\x04 \x01(\tR\bsignedAt\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\x12#\n" +
+	"\rnew_signature\x18\x06 \x01(\fR\fnewSignature\"\x85\x01\n" +
+	"\x18RotateMachineKeyResponse\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12(\n" +
+	"\x10alias_expires_at\x18\x03 \x01(\tR\x0ealiasExpiresAt2\xfa\t\n" +
+	"\rKlefkiService\x12J\n" +
+	"\aGetTime\x12\x1e.rgst.klefki.v1.GetTimeRequest\x1a\x1f.rgst.klefki.v1.GetTimeResponse\x12G\n" +
+	"\x06GetKey\x12\x1d.rgst.klefki.v1.GetKeyRequest\x1a\x1e.rgst.klefki.v1.GetKeyResponse\x12I\n" +
+	"\fGetKeyStream\x12\x1d.rgst.klefki.v1.GetKeyRequest\x1a\x18.rgst.klefki.v1.KeyChunk0\x01\x12Y\n" +
+	"\fListSessions\x12#.rgst.klefki.v1.ListSessionsRequest\x1a$.rgst.klefki.v1.ListSessionsResponse\x12P\n" +
+	"\tSubmitKey\x12 .rgst.klefki.v1.SubmitKeyRequest\x1a!.rgst.klefki.v1.SubmitKeyResponse\x12P\n" +
+	"\x0fSubmitKeyStream\x12\x18.rgst.klefki.v1.KeyChunk\x1a!.rgst.klefki.v1.SubmitKeyResponse(\x01\x12_\n" +
+	"\x0eApproveSession\x12%.rgst.klefki.v1.ApproveSessionRequest\x1a&.rgst.klefki.v1.ApproveSessionResponse\x12V\n" +
+	"\vDenySession\x12\".rgst.klefki.v1.DenySessionRequest\x1a#.rgst.klefki.v1.DenySessionResponse\x12\\\n" +
+	"\rCancelSession\x12$.rgst.klefki.v1.CancelSessionRequest\x1a%.rgst.klefki.v1.CancelSessionResponse\x12V\n" +
+	"\vGetIdentity\x12\".rgst.klefki.v1.GetIdentityRequest\x1a#.rgst.klefki.v1.GetIdentityResponse\x12_\n" +
+	"\x0eGetVaultStatus\x12%.rgst.klefki.v1.GetVaultStatusRequest\x1a&.rgst.klefki.v1.GetVaultStatusResponse\x12G\n" +
+	"\x06Unseal\x12\x1d.rgst.klefki.v1.UnsealRequest\x1a\x1e.rgst.klefki.v1.UnsealResponse\x12A\n" +
+	"\x04Seal\x12\x1b.rgst.klefki.v1.SealRequest\x1a\x1c.rgst.klefki.v1.SealResponse\x12G\n" +
+	"\x06Enroll\x12\x1d.rgst.klefki.v1.EnrollRequest\x1a\x1e.rgst.klefki.v1.EnrollResponse\x12e\n" +
+	"\x10RotateMachineKey\x12'.rgst.klefki.v1.RotateMachineKeyRequest\x1a(.rgst.klefki.v1.RotateMachineKeyResponseB?Z5git.rgst.io/internal/grpc/generated/go/rgst/klefki/v1\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_rgst_klefki_v1_kelfki_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_rgst_klefki_v1_kelfki_proto_goTypes = []any{
-	(*GetTimeRequest)(nil),       // 0: rgst.klefki.v1.GetTimeRequest
-	(*GetTimeResponse)(nil),      // 1: rgst.klefki.v1.GetTimeResponse
-	(*GetKeyRequest)(nil),        // 2: rgst.klefki.v1.GetKeyRequest
-	(*GetKeyResponse)(nil),       // 3: rgst.klefki.v1.GetKeyResponse
-	(*ListSessionsRequest)(nil),  // 4: rgst.klefki.v1.ListSessionsRequest
-	(*Machine)(nil),              // 5: rgst.klefki.v1.Machine
-	(*ListSessionsResponse)(nil), // 6: rgst.klefki.v1.ListSessionsResponse
-	(*SubmitKeyRequest)(nil),     // 7: rgst.klefki.v1.SubmitKeyRequest
-	(*SubmitKeyResponse)(nil),    // 8: rgst.klefki.v1.SubmitKeyResponse
+	(*GetTimeRequest)(nil),           // 0: rgst.klefki.v1.GetTimeRequest
+	(*GetTimeResponse)(nil),          // 1: rgst.klefki.v1.GetTimeResponse
+	(*BootMeasurements)(nil),         // 2: rgst.klefki.v1.BootMeasurements
+	(*HardwareAttributes)(nil),       // 3: rgst.klefki.v1.HardwareAttributes
+	(*GetKeyRequest)(nil),            // 4: rgst.klefki.v1.GetKeyRequest
+	(*GetKeyResponse)(nil),           // 5: rgst.klefki.v1.GetKeyResponse
+	(*KeyChunk)(nil),                 // 6: rgst.klefki.v1.KeyChunk
+	(*ListSessionsRequest)(nil),      // 7: rgst.klefki.v1.ListSessionsRequest
+	(*Approval)(nil),                 // 8: rgst.klefki.v1.Approval
+	(*SecretStatus)(nil),             // 9: rgst.klefki.v1.SecretStatus
+	(*Machine)(nil),                  // 10: rgst.klefki.v1.Machine
+	(*ListSessionsResponse)(nil),     // 11: rgst.klefki.v1.ListSessionsResponse
+	(*SubmitKeyRequest)(nil),         // 12: rgst.klefki.v1.SubmitKeyRequest
+	(*SubmitKeyResponse)(nil),        // 13: rgst.klefki.v1.SubmitKeyResponse
+	(*ApproveSessionRequest)(nil),    // 14: rgst.klefki.v1.ApproveSessionRequest
+	(*ApproveSessionResponse)(nil),   // 15: rgst.klefki.v1.ApproveSessionResponse
+	(*DenySessionRequest)(nil),       // 16: rgst.klefki.v1.DenySessionRequest
+	(*DenySessionResponse)(nil),      // 17: rgst.klefki.v1.DenySessionResponse
+	(*CancelSessionRequest)(nil),     // 18: rgst.klefki.v1.CancelSessionRequest
+	(*CancelSessionResponse)(nil),    // 19: rgst.klefki.v1.CancelSessionResponse
+	(*GetIdentityRequest)(nil),       // 20: rgst.klefki.v1.GetIdentityRequest
+	(*GetIdentityResponse)(nil),      // 21: rgst.klefki.v1.GetIdentityResponse
+	(*VaultStatus)(nil),              // 22: rgst.klefki.v1.VaultStatus
+	(*GetVaultStatusRequest)(nil),    // 23: rgst.klefki.v1.GetVaultStatusRequest
+	(*GetVaultStatusResponse)(nil),   // 24: rgst.klefki.v1.GetVaultStatusResponse
+	(*UnsealRequest)(nil),            // 25: rgst.klefki.v1.UnsealRequest
+	(*UnsealResponse)(nil),           // 26: rgst.klefki.v1.UnsealResponse
+	(*SealRequest)(nil),              // 27: rgst.klefki.v1.SealRequest
+	(*SealResponse)(nil),             // 28: rgst.klefki.v1.SealResponse
+	(*EnrollRequest)(nil),            // 29: rgst.klefki.v1.EnrollRequest
+	(*EnrollResponse)(nil),           // 30: rgst.klefki.v1.EnrollResponse
+	(*RotateMachineKeyRequest)(nil),  // 31: rgst.klefki.v1.RotateMachineKeyRequest
+	(*RotateMachineKeyResponse)(nil), // 32: rgst.klefki.v1.RotateMachineKeyResponse
+	nil,                              // 33: rgst.klefki.v1.Machine.LabelsEntry
 }
 var file_rgst_klefki_v1_kelfki_proto_depIdxs = []int32{
-	5, // 0: rgst.klefki.v1.ListSessionsResponse.machines:type_name -> rgst.klefki.v1.Machine
-	0, // 1: rgst.klefki.v1.KlefkiService.GetTime:input_type -> rgst.klefki.v1.GetTimeRequest
-	2, // 2: rgst.klefki.v1.KlefkiService.GetKey:input_type -> rgst.klefki.v1.GetKeyRequest
-	4, // 3: rgst.klefki.v1.KlefkiService.ListSessions:input_type -> rgst.klefki.v1.ListSessionsRequest
-	7, // 4: rgst.klefki.v1.KlefkiService.SubmitKey:input_type -> rgst.klefki.v1.SubmitKeyRequest
-	1, // 5: rgst.klefki.v1.KlefkiService.GetTime:output_type -> rgst.klefki.v1.GetTimeResponse
-	3, // 6: rgst.klefki.v1.KlefkiService.GetKey:output_type -> rgst.klefki.v1.GetKeyResponse
-	6, // 7: rgst.klefki.v1.KlefkiService.ListSessions:output_type -> rgst.klefki.v1.ListSessionsResponse
-	8, // 8: rgst.klefki.v1.KlefkiService.SubmitKey:output_type -> rgst.klefki.v1.SubmitKeyResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: rgst.klefki.v1.GetKeyRequest.boot_measurements:type_name -> rgst.klefki.v1.BootMeasurements
+	3,  // 1: rgst.klefki.v1.GetKeyRequest.hardware:type_name -> rgst.klefki.v1.HardwareAttributes
+	8,  // 2: rgst.klefki.v1.Machine.approvals:type_name -> rgst.klefki.v1.Approval
+	33, // 3: rgst.klefki.v1.Machine.labels:type_name -> rgst.klefki.v1.Machine.LabelsEntry
+	9,  // 4: rgst.klefki.v1.Machine.secrets:type_name -> rgst.klefki.v1.SecretStatus
+	10, // 5: rgst.klefki.v1.ListSessionsResponse.machines:type_name -> rgst.klefki.v1.Machine
+	22, // 6: rgst.klefki.v1.GetVaultStatusResponse.status:type_name -> rgst.klefki.v1.VaultStatus
+	22, // 7: rgst.klefki.v1.UnsealResponse.status:type_name -> rgst.klefki.v1.VaultStatus
+	22, // 8: rgst.klefki.v1.SealResponse.status:type_name -> rgst.klefki.v1.VaultStatus
+	3,  // 9: rgst.klefki.v1.EnrollRequest.hardware:type_name -> rgst.klefki.v1.HardwareAttributes
+	0,  // 10: rgst.klefki.v1.KlefkiService.GetTime:input_type -> rgst.klefki.v1.GetTimeRequest
+	4,  // 11: rgst.klefki.v1.KlefkiService.GetKey:input_type -> rgst.klefki.v1.GetKeyRequest
+	4,  // 12: rgst.klefki.v1.KlefkiService.GetKeyStream:input_type -> rgst.klefki.v1.GetKeyRequest
+	7,  // 13: rgst.klefki.v1.KlefkiService.ListSessions:input_type -> rgst.klefki.v1.ListSessionsRequest
+	12, // 14: rgst.klefki.v1.KlefkiService.SubmitKey:input_type -> rgst.klefki.v1.SubmitKeyRequest
+	6,  // 15: rgst.klefki.v1.KlefkiService.SubmitKeyStream:input_type -> rgst.klefki.v1.KeyChunk
+	14, // 16: rgst.klefki.v1.KlefkiService.ApproveSession:input_type -> rgst.klefki.v1.ApproveSessionRequest
+	16, // 17: rgst.klefki.v1.KlefkiService.DenySession:input_type -> rgst.klefki.v1.DenySessionRequest
+	18, // 18: rgst.klefki.v1.KlefkiService.CancelSession:input_type -> rgst.klefki.v1.CancelSessionRequest
+	20, // 19: rgst.klefki.v1.KlefkiService.GetIdentity:input_type -> rgst.klefki.v1.GetIdentityRequest
+	23, // 20: rgst.klefki.v1.KlefkiService.GetVaultStatus:input_type -> rgst.klefki.v1.GetVaultStatusRequest
+	25, // 21: rgst.klefki.v1.KlefkiService.Unseal:input_type -> rgst.klefki.v1.UnsealRequest
+	27, // 22: rgst.klefki.v1.KlefkiService.Seal:input_type -> rgst.klefki.v1.SealRequest
+	29, // 23: rgst.klefki.v1.KlefkiService.Enroll:input_type -> rgst.klefki.v1.EnrollRequest
+	31, // 24: rgst.klefki.v1.KlefkiService.RotateMachineKey:input_type -> rgst.klefki.v1.RotateMachineKeyRequest
+	1,  // 25: rgst.klefki.v1.KlefkiService.GetTime:output_type -> rgst.klefki.v1.GetTimeResponse
+	5,  // 26: rgst.klefki.v1.KlefkiService.GetKey:output_type -> rgst.klefki.v1.GetKeyResponse
+	6,  // 27: rgst.klefki.v1.KlefkiService.GetKeyStream:output_type -> rgst.klefki.v1.KeyChunk
+	11, // 28: rgst.klefki.v1.KlefkiService.ListSessions:output_type -> rgst.klefki.v1.ListSessionsResponse
+	13, // 29: rgst.klefki.v1.KlefkiService.SubmitKey:output_type -> rgst.klefki.v1.SubmitKeyResponse
+	13, // 30: rgst.klefki.v1.KlefkiService.SubmitKeyStream:output_type -> rgst.klefki.v1.SubmitKeyResponse
+	15, // 31: rgst.klefki.v1.KlefkiService.ApproveSession:output_type -> rgst.klefki.v1.ApproveSessionResponse
+	17, // 32: rgst.klefki.v1.KlefkiService.DenySession:output_type -> rgst.klefki.v1.DenySessionResponse
+	19, // 33: rgst.klefki.v1.KlefkiService.CancelSession:output_type -> rgst.klefki.v1.CancelSessionResponse
+	21, // 34: rgst.klefki.v1.KlefkiService.GetIdentity:output_type -> rgst.klefki.v1.GetIdentityResponse
+	24, // 35: rgst.klefki.v1.KlefkiService.GetVaultStatus:output_type -> rgst.klefki.v1.GetVaultStatusResponse
+	26, // 36: rgst.klefki.v1.KlefkiService.Unseal:output_type -> rgst.klefki.v1.UnsealResponse
+	28, // 37: rgst.klefki.v1.KlefkiService.Seal:output_type -> rgst.klefki.v1.SealResponse
+	30, // 38: rgst.klefki.v1.KlefkiService.Enroll:output_type -> rgst.klefki.v1.EnrollResponse
+	32, // 39: rgst.klefki.v1.KlefkiService.RotateMachineKey:output_type -> rgst.klefki.v1.RotateMachineKeyResponse
+	25, // [25:40] is the sub-list for method output_type
+	10, // [10:25] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_rgst_klefki_v1_kelfki_proto_init() }
@@ -907,7 +4852,7 @@ func file_rgst_klefki_v1_kelfki_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rgst_klefki_v1_kelfki_proto_rawDesc), len(file_rgst_klefki_v1_kelfki_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
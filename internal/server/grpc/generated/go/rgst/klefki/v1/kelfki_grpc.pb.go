@@ -20,10 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KlefkiService_GetTime_FullMethodName      = "/rgst.klefki.v1.KlefkiService/GetTime"
-	KlefkiService_GetKey_FullMethodName       = "/rgst.klefki.v1.KlefkiService/GetKey"
-	KlefkiService_ListSessions_FullMethodName = "/rgst.klefki.v1.KlefkiService/ListSessions"
-	KlefkiService_SubmitKey_FullMethodName    = "/rgst.klefki.v1.KlefkiService/SubmitKey"
+	KlefkiService_GetTime_FullMethodName          = "/rgst.klefki.v1.KlefkiService/GetTime"
+	KlefkiService_GetKey_FullMethodName           = "/rgst.klefki.v1.KlefkiService/GetKey"
+	KlefkiService_GetKeyStream_FullMethodName     = "/rgst.klefki.v1.KlefkiService/GetKeyStream"
+	KlefkiService_ListSessions_FullMethodName     = "/rgst.klefki.v1.KlefkiService/ListSessions"
+	KlefkiService_SubmitKey_FullMethodName        = "/rgst.klefki.v1.KlefkiService/SubmitKey"
+	KlefkiService_SubmitKeyStream_FullMethodName  = "/rgst.klefki.v1.KlefkiService/SubmitKeyStream"
+	KlefkiService_ApproveSession_FullMethodName   = "/rgst.klefki.v1.KlefkiService/ApproveSession"
+	KlefkiService_DenySession_FullMethodName      = "/rgst.klefki.v1.KlefkiService/DenySession"
+	KlefkiService_CancelSession_FullMethodName    = "/rgst.klefki.v1.KlefkiService/CancelSession"
+	KlefkiService_GetIdentity_FullMethodName      = "/rgst.klefki.v1.KlefkiService/GetIdentity"
+	KlefkiService_GetVaultStatus_FullMethodName   = "/rgst.klefki.v1.KlefkiService/GetVaultStatus"
+	KlefkiService_Unseal_FullMethodName           = "/rgst.klefki.v1.KlefkiService/Unseal"
+	KlefkiService_Seal_FullMethodName             = "/rgst.klefki.v1.KlefkiService/Seal"
+	KlefkiService_Enroll_FullMethodName           = "/rgst.klefki.v1.KlefkiService/Enroll"
+	KlefkiService_RotateMachineKey_FullMethodName = "/rgst.klefki.v1.KlefkiService/RotateMachineKey"
 )
 
 // KlefkiServiceClient is the client API for KlefkiService service.
@@ -32,8 +43,19 @@ const (
 type KlefkiServiceClient interface {
 	GetTime(ctx context.Context, in *GetTimeRequest, opts ...grpc.CallOption) (*GetTimeResponse, error)
 	GetKey(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (*GetKeyResponse, error)
+	GetKeyStream(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KeyChunk], error)
 	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 	SubmitKey(ctx context.Context, in *SubmitKeyRequest, opts ...grpc.CallOption) (*SubmitKeyResponse, error)
+	SubmitKeyStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[KeyChunk, SubmitKeyResponse], error)
+	ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error)
+	DenySession(ctx context.Context, in *DenySessionRequest, opts ...grpc.CallOption) (*DenySessionResponse, error)
+	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error)
+	GetVaultStatus(ctx context.Context, in *GetVaultStatusRequest, opts ...grpc.CallOption) (*GetVaultStatusResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	Enroll(ctx context.Context, in *EnrollRequest, opts ...grpc.CallOption) (*EnrollResponse, error)
+	RotateMachineKey(ctx context.Context, in *RotateMachineKeyRequest, opts ...grpc.CallOption) (*RotateMachineKeyResponse, error)
 }
 
 type klefkiServiceClient struct {
@@ -64,6 +86,25 @@ func (c *klefkiServiceClient) GetKey(ctx context.Context, in *GetKeyRequest, opt
 	return out, nil
 }
 
+func (c *klefkiServiceClient) GetKeyStream(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KeyChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KlefkiService_ServiceDesc.Streams[0], KlefkiService_GetKeyStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetKeyRequest, KeyChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KlefkiService_GetKeyStreamClient = grpc.ServerStreamingClient[KeyChunk]
+
 func (c *klefkiServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSessionsResponse)
@@ -84,14 +125,128 @@ func (c *klefkiServiceClient) SubmitKey(ctx context.Context, in *SubmitKeyReques
 	return out, nil
 }
 
+func (c *klefkiServiceClient) SubmitKeyStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[KeyChunk, SubmitKeyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KlefkiService_ServiceDesc.Streams[1], KlefkiService_SubmitKeyStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[KeyChunk, SubmitKeyResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KlefkiService_SubmitKeyStreamClient = grpc.ClientStreamingClient[KeyChunk, SubmitKeyResponse]
+
+func (c *klefkiServiceClient) ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveSessionResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_ApproveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) DenySession(ctx context.Context, in *DenySessionRequest, opts ...grpc.CallOption) (*DenySessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenySessionResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_DenySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSessionResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_CancelSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdentityResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_GetIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) GetVaultStatus(ctx context.Context, in *GetVaultStatusRequest, opts ...grpc.CallOption) (*GetVaultStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVaultStatusResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_GetVaultStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_Unseal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_Seal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) Enroll(ctx context.Context, in *EnrollRequest, opts ...grpc.CallOption) (*EnrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_Enroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *klefkiServiceClient) RotateMachineKey(ctx context.Context, in *RotateMachineKeyRequest, opts ...grpc.CallOption) (*RotateMachineKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateMachineKeyResponse)
+	err := c.cc.Invoke(ctx, KlefkiService_RotateMachineKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KlefkiServiceServer is the server API for KlefkiService service.
 // All implementations must embed UnimplementedKlefkiServiceServer
 // for forward compatibility.
 type KlefkiServiceServer interface {
 	GetTime(context.Context, *GetTimeRequest) (*GetTimeResponse, error)
 	GetKey(context.Context, *GetKeyRequest) (*GetKeyResponse, error)
+	GetKeyStream(*GetKeyRequest, grpc.ServerStreamingServer[KeyChunk]) error
 	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	SubmitKey(context.Context, *SubmitKeyRequest) (*SubmitKeyResponse, error)
+	SubmitKeyStream(grpc.ClientStreamingServer[KeyChunk, SubmitKeyResponse]) error
+	ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error)
+	DenySession(context.Context, *DenySessionRequest) (*DenySessionResponse, error)
+	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	GetIdentity(context.Context, *GetIdentityRequest) (*GetIdentityResponse, error)
+	GetVaultStatus(context.Context, *GetVaultStatusRequest) (*GetVaultStatusResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	Enroll(context.Context, *EnrollRequest) (*EnrollResponse, error)
+	RotateMachineKey(context.Context, *RotateMachineKeyRequest) (*RotateMachineKeyResponse, error)
 	mustEmbedUnimplementedKlefkiServiceServer()
 }
 
@@ -108,12 +263,45 @@ func (UnimplementedKlefkiServiceServer) GetTime(context.Context, *GetTimeRequest
 func (UnimplementedKlefkiServiceServer) GetKey(context.Context, *GetKeyRequest) (*GetKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKey not implemented")
 }
+func (UnimplementedKlefkiServiceServer) GetKeyStream(*GetKeyRequest, grpc.ServerStreamingServer[KeyChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method GetKeyStream not implemented")
+}
 func (UnimplementedKlefkiServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
 }
 func (UnimplementedKlefkiServiceServer) SubmitKey(context.Context, *SubmitKeyRequest) (*SubmitKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitKey not implemented")
 }
+func (UnimplementedKlefkiServiceServer) SubmitKeyStream(grpc.ClientStreamingServer[KeyChunk, SubmitKeyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SubmitKeyStream not implemented")
+}
+func (UnimplementedKlefkiServiceServer) ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSession not implemented")
+}
+func (UnimplementedKlefkiServiceServer) DenySession(context.Context, *DenySessionRequest) (*DenySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenySession not implemented")
+}
+func (UnimplementedKlefkiServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
+}
+func (UnimplementedKlefkiServiceServer) GetIdentity(context.Context, *GetIdentityRequest) (*GetIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentity not implemented")
+}
+func (UnimplementedKlefkiServiceServer) GetVaultStatus(context.Context, *GetVaultStatusRequest) (*GetVaultStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultStatus not implemented")
+}
+func (UnimplementedKlefkiServiceServer) Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (UnimplementedKlefkiServiceServer) Seal(context.Context, *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (UnimplementedKlefkiServiceServer) Enroll(context.Context, *EnrollRequest) (*EnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enroll not implemented")
+}
+func (UnimplementedKlefkiServiceServer) RotateMachineKey(context.Context, *RotateMachineKeyRequest) (*RotateMachineKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateMachineKey not implemented")
+}
 func (UnimplementedKlefkiServiceServer) mustEmbedUnimplementedKlefkiServiceServer() {}
 func (UnimplementedKlefkiServiceServer) testEmbeddedByValue()                       {}
 
@@ -171,6 +359,17 @@ func _KlefkiService_GetKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KlefkiService_GetKeyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetKeyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KlefkiServiceServer).GetKeyStream(m, &grpc.GenericServerStream[GetKeyRequest, KeyChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KlefkiService_GetKeyStreamServer = grpc.ServerStreamingServer[KeyChunk]
+
 func _KlefkiService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSessionsRequest)
 	if err := dec(in); err != nil {
@@ -207,6 +406,175 @@ func _KlefkiService_SubmitKey_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KlefkiService_SubmitKeyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KlefkiServiceServer).SubmitKeyStream(&grpc.GenericServerStream[KeyChunk, SubmitKeyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KlefkiService_SubmitKeyStreamServer = grpc.ClientStreamingServer[KeyChunk, SubmitKeyResponse]
+
+func _KlefkiService_ApproveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).ApproveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_ApproveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).ApproveSession(ctx, req.(*ApproveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_DenySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).DenySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_DenySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).DenySession(ctx, req.(*DenySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_CancelSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).CancelSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_CancelSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).CancelSession(ctx, req.(*CancelSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_GetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).GetIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_GetIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).GetIdentity(ctx, req.(*GetIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_GetVaultStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).GetVaultStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_GetVaultStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).GetVaultStatus(ctx, req.(*GetVaultStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_Unseal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).Seal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_Seal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_Enroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).Enroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_Enroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).Enroll(ctx, req.(*EnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KlefkiService_RotateMachineKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateMachineKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KlefkiServiceServer).RotateMachineKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KlefkiService_RotateMachineKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KlefkiServiceServer).RotateMachineKey(ctx, req.(*RotateMachineKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KlefkiService_ServiceDesc is the grpc.ServiceDesc for KlefkiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,7 +598,54 @@ var KlefkiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitKey",
 			Handler:    _KlefkiService_SubmitKey_Handler,
 		},
+		{
+			MethodName: "ApproveSession",
+			Handler:    _KlefkiService_ApproveSession_Handler,
+		},
+		{
+			MethodName: "DenySession",
+			Handler:    _KlefkiService_DenySession_Handler,
+		},
+		{
+			MethodName: "CancelSession",
+			Handler:    _KlefkiService_CancelSession_Handler,
+		},
+		{
+			MethodName: "GetIdentity",
+			Handler:    _KlefkiService_GetIdentity_Handler,
+		},
+		{
+			MethodName: "GetVaultStatus",
+			Handler:    _KlefkiService_GetVaultStatus_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _KlefkiService_Unseal_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _KlefkiService_Seal_Handler,
+		},
+		{
+			MethodName: "Enroll",
+			Handler:    _KlefkiService_Enroll_Handler,
+		},
+		{
+			MethodName: "RotateMachineKey",
+			Handler:    _KlefkiService_RotateMachineKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetKeyStream",
+			Handler:       _KlefkiService_GetKeyStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubmitKeyStream",
+			Handler:       _KlefkiService_SubmitKeyStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rgst/klefki/v1/kelfki.proto",
 }
@@ -0,0 +1,79 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/hardware"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// checkHardware checks the hardware attributes reported by the
+// provided machine against the ones it's bound to, binding it to them
+// if it isn't yet. Mismatches are alerted on, and kept for an operator
+// to accept, but only return an error if the machine's hardware
+// binding is enforced.
+func (s *Server) checkHardware(ctx context.Context, machine *ent.Machine, hw *pbgrpcv1.HardwareAttributes) error {
+	reported := hardware.ToMap(hw)
+
+	// Trust on first use.
+	if len(machine.Hardware) == 0 {
+		if len(reported) == 0 {
+			return nil
+		}
+
+		if err := machine.Update().SetHardware(reported).ClearPendingHardware().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to bind machine to hardware: %w", err)
+		}
+		return audit.Record(ctx, s.db, &audit.Event{
+			Type:      audit.EventHardwareBound,
+			MachineID: machine.ID,
+			Details:   "bound to hardware attributes: " + hardware.Format(reported),
+		})
+	}
+
+	mismatches := hardware.Mismatches(machine.Hardware, reported)
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	if !maps.Equal(machine.PendingHardware, reported) {
+		if err := machine.Update().SetPendingHardware(reported).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to store reported hardware: %w", err)
+		}
+	}
+
+	s.alertOnce(ctx, &audit.Event{
+		Type:      audit.EventHardwareMismatch,
+		MachineID: machine.ID,
+		Details: fmt.Sprintf("reported hardware attributes don't match (%s): %s",
+			strings.Join(mismatches, ", "), hardware.Format(reported)),
+	})
+
+	if machine.HardwareBinding == entmachine.HardwareBindingEnforce {
+		return fmt.Errorf("hardware attributes don't match those the machine is bound to")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"git.rgst.io/homelab/klefki/internal/machines"
+)
+
+// IdentityPath is the path to the private key of the server.
+const IdentityPath = "data/identity.key"
+
+// loadIdentity loads the private key of the server from the provided
+// path, generating it first if it doesn't exist yet.
+func loadIdentity(path string) (ed25519.PrivateKey, error) {
+	b, err := os.ReadFile(path)
+	if err == nil {
+		return machines.DecodePrivateKey(b)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	// The identity uses the same key material, and encoding, as machines.
+	m, err := machines.NewMachine()
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := m.EncodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create identity directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(privKey), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write identity: %w", err)
+	}
+
+	fprint, err := m.Fingerprint()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("generated new server identity %s\n", fprint)
+
+	return m.PrivateKey, nil
+}
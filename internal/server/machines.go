@@ -0,0 +1,58 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// grpcMachine converts a [ent.Machine] into a [pbgrpcv1.Machine].
+func grpcMachine(m *ent.Machine) *pbgrpcv1.Machine {
+	requiredApprovals := int32(m.RequiredApprovals) //nolint:gosec // Why: Set by operators.
+	shareThreshold := int32(m.ShareThreshold)       //nolint:gosec // Why: Set by operators.
+	shareIndex := int32(m.ShareIndex)               //nolint:gosec // Why: At most 255.
+	return (&pbgrpcv1.Machine_builder{
+		Id:                &m.ID,
+		PublicKey:         m.PublicKey,
+		RequiredApprovals: &requiredApprovals,
+		ShareThreshold:    &shareThreshold,
+		ShareIndex:        &shareIndex,
+		Labels:            m.Labels,
+		Name:              &m.Name,
+		Fingerprint:       &m.Fingerprint,
+		Description:       &m.Description,
+	}).Build()
+}
+
+// grpcSecrets returns the status of the secrets of the machine of the
+// session with the provided names.
+func (ses *Session) grpcSecrets(names []string) []*pbgrpcv1.SecretStatus {
+	statuses := make([]*pbgrpcv1.SecretStatus, 0, len(names))
+	for _, name := range names {
+		status := &pbgrpcv1.SecretStatus{}
+		status.SetName(name)
+		if sec, ok := ses.Secrets[name]; ok {
+			status.SetKeySubmitted(len(sec.EncKey) != 0)
+			status.SetSharesSubmitted(int32(len(sec.EncShares))) //nolint:gosec // Why: At most 255 shares.
+			status.SetReleased(!sec.ReleasedAt.IsZero())
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
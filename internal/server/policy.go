@@ -0,0 +1,75 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/policy"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// evaluatePolicies returns the unlock policy that applies to the key
+// request made by the provided machine, or nil if none do.
+func (s *Server) evaluatePolicies(ctx context.Context, machine *ent.Machine) (*ent.UnlockPolicy, error) {
+	policies, err := policy.List(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	in := &policy.Input{
+		MachineID:   machine.ID,
+		MachineName: machine.Name,
+		Labels:      machine.Labels,
+		Now:         time.Now(),
+	}
+	if machine.LastUnlockedAt != nil {
+		in.LastUnlocked = *machine.LastUnlockedAt
+	}
+	in.Peer, in.Metadata = requestPeer(ctx)
+
+	return s.policies.Evaluate(policies, in)
+}
+
+// requestPeer returns the IP address the request was made from, and
+// the gRPC metadata sent with it.
+func requestPeer(ctx context.Context) (string, map[string]string) {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+	}
+
+	md := make(map[string]string)
+	if imd, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, v := range imd {
+			md[k] = strings.Join(v, ",")
+		}
+	}
+	return addr, md
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// RotateMachineKey implements the RotateMachineKey RPC.
+func (s *Server) RotateMachineKey(ctx context.Context, req *pbgrpcv1.RotateMachineKeyRequest) (*pbgrpcv1.RotateMachineKeyResponse, error) {
+	if _, err := parseSignedAt(req.GetSignedAt()); err != nil {
+		return nil, err
+	}
+
+	// Only the current key of the machine can rotate it, not a key it
+	// rotated before.
+	machine, pubKey, err := registry.ByFingerprint(ctx, s.db, req.GetMachineId())
+	if err != nil {
+		return nil, err
+	}
+	if machine.Fingerprint != req.GetMachineId() {
+		return nil, fmt.Errorf("key was rotated, only the current key of the machine can rotate it")
+	}
+
+	if err := machines.VerifyRotateKeyRequest(pubKey, req); err != nil {
+		return nil, err
+	}
+	if machine.PendingConfirmation {
+		return nil, fmt.Errorf("machine is awaiting confirmation by an operator")
+	}
+	if err := s.checkState(ctx, machine, "RotateMachineKey"); err != nil {
+		return nil, err
+	}
+
+	grace := s.KeyRotationGrace
+	if grace == 0 {
+		grace = registry.DefaultAliasGrace
+	}
+	alias, err := registry.Rotate(ctx, s.db, machine, req.GetNewPublicKey(), grace)
+	if err != nil {
+		return nil, err
+	}
+
+	fprint, err := machines.Fingerprint(req.GetNewPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	if err := audit.Record(ctx, s.db, &audit.Event{
+		Type:      audit.EventMachineKeyRotated,
+		MachineID: machine.ID,
+		Details: fmt.Sprintf("rotated key %s to %s, accepting the old key until %s",
+			machine.Fingerprint, fprint, alias.ExpiresAt.UTC().Format(time.RFC3339)),
+	}); err != nil {
+		return nil, err
+	}
+
+	resp := &pbgrpcv1.RotateMachineKeyResponse{}
+	resp.SetMachineId(machine.ID)
+	resp.SetFingerprint(fprint)
+	resp.SetAliasExpiresAt(alias.ExpiresAt.UTC().Format(time.RFC3339))
+	return resp, nil
+}
@@ -19,38 +19,198 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	"git.rgst.io/homelab/klefki/internal/alerts"
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/credhelper"
 	"git.rgst.io/homelab/klefki/internal/db"
 	"git.rgst.io/homelab/klefki/internal/db/ent"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/groups"
+	"git.rgst.io/homelab/klefki/internal/hardware"
+	"git.rgst.io/homelab/klefki/internal/labels"
 	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/measurements"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	"git.rgst.io/homelab/klefki/internal/policy"
+	"git.rgst.io/homelab/klefki/internal/registry"
+	"git.rgst.io/homelab/klefki/internal/secrets"
 	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
-	"git.rgst.io/homelab/sigtool/v3/sign"
+	"git.rgst.io/homelab/klefki/internal/vault"
+	"git.rgst.io/homelab/klefki/internal/vaultkv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 // Session represents a session where a machine is attempting to receive
 // a private key from SubmitKey.
 type Session struct {
+	// StartedAt is when the machine first called GetKey for this
+	// session.
+	StartedAt time.Time
+
 	// LastAsked is the last time the machine called GetKey without
 	// receiving a key.
 	LastAsked time.Time
 
+	// PeerAddress is the address the machine last called GetKey from.
+	PeerAddress string
+
+	// PublicKey is the public key the machine last called GetKey with,
+	// which the key is encrypted to. This is the key of the machine,
+	// unless it rotated its key within the grace period.
+	PublicKey ed25519.PublicKey
+
+	// Secrets is a name -> SessionSecret map of the secrets of the
+	// machine that were provided by SubmitKey or released during this
+	// session.
+	Secrets map[string]*SessionSecret
+
+	// Approvals is an operator_id -> Approval map of the operators that
+	// have approved releasing the key for this session.
+	Approvals map[string]*Approval
+
+	// DeniedBy is the operator that denied the session, if any. Denied
+	// sessions are refused until an operator cancels them.
+	DeniedBy *ent.Operator
+}
+
+// SessionSecret is the state of a secret of the machine of a
+// [Session], e.g., the key of one of its volumes.
+type SessionSecret struct {
 	// EncKey is the encrypted provided by SubmitKey. If not set, no key
 	// has been provided.
 	EncKey []byte
+
+	// EncShares is a share_index -> encrypted share map of the Shamir
+	// shares provided by SubmitKey, used instead of EncKey when the
+	// machine's key is split.
+	EncShares map[int32][]byte
+
+	// ReleasedAt is when the secret was released to the machine, zero
+	// if it hasn't been.
+	ReleasedAt time.Time
+}
+
+// newSession creates a new [Session] starting now.
+func newSession() *Session {
+	return &Session{
+		StartedAt: time.Now(),
+		Secrets:   make(map[string]*SessionSecret),
+		Approvals: make(map[string]*Approval),
+	}
+}
+
+// secret returns the secret with the provided name of the session,
+// creating it if it doesn't exist yet.
+func (ses *Session) secret(name string) *SessionSecret {
+	sec, ok := ses.Secrets[name]
+	if !ok {
+		sec = &SessionSecret{EncShares: make(map[int32][]byte)}
+		ses.Secrets[name] = sec
+	}
+	return sec
+}
+
+// released returns whether the secret with the provided name was
+// released during the session.
+func (ses *Session) released(name string) bool {
+	sec, ok := ses.Secrets[name]
+	return ok && !sec.ReleasedAt.IsZero()
+}
+
+// session returns the session of the provided machine, starting one if
+// it has none. [Server.sesMu] must be held.
+func (s *Server) session(machineID string) *Session {
+	if _, ok := s.ses[machineID]; !ok {
+		s.ses[machineID] = newSession()
+	}
+	return s.ses[machineID]
+}
+
+// Approval is an approval of a [Session] by an operator.
+type Approval struct {
+	// Operator is the operator that approved the session.
+	Operator *ent.Operator
+
+	// ApprovedAt is when the approval was made.
+	ApprovedAt time.Time
 }
 
 // Server is a Klefki gRPC server
 type Server struct {
+	// SealAfter is how long the vault stays unsealed before it is
+	// sealed again automatically. Zero disables automatic sealing.
+	SealAfter time.Duration
+
+	// AlertWebhook is the URL alerts for operators are posted to. If
+	// empty, alerts are only logged.
+	AlertWebhook string
+
+	// TangAddress is the address to serve the Tang protocol on. If
+	// empty, it isn't served.
+	TangAddress string
+
+	// TangAllowed are the networks Tang recovery requests are allowed
+	// from. If empty, all are allowed.
+	TangAllowed []*net.IPNet
+
+	// KeyRotationGrace is how long the key a machine rotated is still
+	// accepted. If zero, [registry.DefaultAliasGrace] is used.
+	KeyRotationGrace time.Duration
+
+	// MaxKeySize is the maximum size, in bytes, of submitted keys once
+	// encrypted. If zero, [DefaultMaxKeySize] is used.
+	MaxKeySize int
+
+	// VaultKV is where keys are read from for machines allowed to be
+	// unlocked automatically, if they aren't stored in the vault. If
+	// nil, only the vault is used.
+	VaultKV *vaultkv.Client
+
+	// CredentialHelper is where keys are read from for machines allowed
+	// to be unlocked automatically, if they aren't stored in the vault
+	// or Vault KV. If nil, it isn't used.
+	CredentialHelper *credhelper.Helper
+
 	gs *grpc.Server
 	db *ent.Client
 
+	// ts serves the Tang protocol, if enabled.
+	ts *http.Server
+
+	// identity is the private key of the server, used by operators to
+	// encrypt data only the server should be able to read.
+	identity ed25519.PrivateKey
+
+	// vault holds the keys of machines that can be unlocked
+	// automatically.
+	vault *vault.Vault
+
+	// policies evaluates the unlock policies on each key request.
+	policies *policy.Engine
+
+	// alerter sends alerts to operators.
+	alerter *alerts.Alerter
+
+	// alerted is a machine_id/type -> details map of the last alert
+	// sent, so that polling machines don't repeat it.
+	alerted   map[string]string
+	alertedMu sync.Mutex
+
 	// ses is a machine_id -> Session map
 	ses   map[string]*Session
 	sesMu sync.RWMutex
@@ -61,12 +221,25 @@ type Server struct {
 // Run starts the server
 func (s *Server) Run(ctx context.Context) error {
 	s.ses = make(map[string]*Session)
+	s.alerted = make(map[string]string)
+	s.alerter = &alerts.Alerter{WebhookURL: s.AlertWebhook}
 
 	var err error
 	s.db, err = db.New(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to open DB: %w", err)
 	}
+	s.vault = vault.New(s.db)
+
+	s.policies, err = policy.NewEngine()
+	if err != nil {
+		return fmt.Errorf("failed to create policy engine: %w", err)
+	}
+
+	s.identity, err = loadIdentity(IdentityPath)
+	if err != nil {
+		return fmt.Errorf("failed to load server identity: %w", err)
+	}
 
 	// TODO(jaredallard): Clean up expired sessions after X time period.
 
@@ -74,6 +247,12 @@ func (s *Server) Run(ctx context.Context) error {
 	pbgrpcv1.RegisterKlefkiServiceServer(s.gs, s)
 	reflection.Register(s.gs)
 
+	if s.TangAddress != "" {
+		if err := s.serveTang(ctx); err != nil {
+			return err
+		}
+	}
+
 	lis, err := net.Listen("tcp", ":5300") //nolint:gosec // Why: This is fine.
 	if err != nil {
 		return fmt.Errorf("failed to create listener: %w", err)
@@ -91,7 +270,7 @@ func (s *Server) GetTime(_ context.Context, _ *pbgrpcv1.GetTimeRequest) (*pbgrpc
 }
 
 // SubmitKey implements the SubmitKey RPC
-func (s *Server) SubmitKey(_ context.Context, req *pbgrpcv1.SubmitKeyRequest) (*pbgrpcv1.SubmitKeyResponse, error) {
+func (s *Server) SubmitKey(ctx context.Context, req *pbgrpcv1.SubmitKeyRequest) (*pbgrpcv1.SubmitKeyResponse, error) {
 	machineID := req.GetMachineId()
 	s.sesMu.RLock()
 	if _, ok := s.ses[machineID]; !ok {
@@ -100,10 +279,71 @@ func (s *Server) SubmitKey(_ context.Context, req *pbgrpcv1.SubmitKeyRequest) (*
 	}
 	s.sesMu.RUnlock()
 
+	machine, err := s.db.Machine.Get(ctx, machineID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machine %q: %w", machineID, err)
+	}
+
+	secret, err := secrets.Resolve(machine.Secrets, req.GetSecret())
+	if err != nil {
+		return nil, fmt.Errorf("machine %q: %w", machineID, err)
+	}
+
+	if size := len(req.GetEncKey()); size == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "key is empty")
+	} else if size > s.maxKeySize() {
+		return nil, status.Errorf(codes.ResourceExhausted, "key is %d bytes encrypted, larger than the maximum of %d", size, s.maxKeySize())
+	}
+
+	shareIndex := req.GetShareIndex()
+	switch {
+	case machine.ShareThreshold > 0:
+		if shareIndex < 1 || shareIndex > 255 {
+			return nil, fmt.Errorf("machine %q requires a key share with an index between 1 and 255", machineID)
+		}
+	case machine.ShareIndex > 0:
+		// This server holds a single share of a key distributed across
+		// multiple servers, which is stored as if it were the whole key.
+		if int(shareIndex) != machine.ShareIndex {
+			return nil, fmt.Errorf("server holds share %d for machine %q, got share %d", machine.ShareIndex, machineID, shareIndex)
+		}
+	case shareIndex != 0:
+		return nil, fmt.Errorf("machine %q does not accept key shares", machineID)
+	}
+
 	s.sesMu.Lock()
 	defer s.sesMu.Unlock()
 
-	s.ses[machineID].EncKey = req.GetEncKey()
+	ses, ok := s.ses[machineID]
+	if !ok {
+		return nil, fmt.Errorf("failed to find machine ID %q", machineID)
+	}
+
+	if ses.DeniedBy != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "session of machine %q was denied by operator %q", machineID, ses.DeniedBy.Name)
+	}
+
+	// Machines only ask for a released secret again after rebooting,
+	// which starts a new session, so this key would never be released.
+	if ses.released(secret) {
+		return nil, fmt.Errorf("secret %q of machine %q was already released in this session", secret, machineID)
+	}
+
+	// Approvals are for releasing the keys that were held when they were
+	// made, so they don't carry over to a different one.
+	sec := ses.secret(secret)
+	if machine.ShareThreshold > 0 {
+		if held := sec.EncShares[shareIndex]; len(held) != 0 && !bytes.Equal(held, req.GetEncKey()) {
+			clear(ses.Approvals)
+		}
+		sec.EncShares[shareIndex] = req.GetEncKey()
+		return &pbgrpcv1.SubmitKeyResponse{}, nil
+	}
+
+	if len(sec.EncKey) != 0 && !bytes.Equal(sec.EncKey, req.GetEncKey()) {
+		clear(ses.Approvals)
+	}
+	sec.EncKey = req.GetEncKey()
 	return &pbgrpcv1.SubmitKeyResponse{}, nil
 }
 
@@ -111,64 +351,196 @@ func (s *Server) SubmitKey(_ context.Context, req *pbgrpcv1.SubmitKeyRequest) (*
 func (s *Server) GetKey(ctx context.Context, req *pbgrpcv1.GetKeyRequest) (*pbgrpcv1.GetKeyResponse, error) {
 	resp := &pbgrpcv1.GetKeyResponse{}
 
-	nonce := req.GetNonce()
-	ts, err := time.Parse(time.RFC3339Nano, req.GetSignedAt())
-	if err != nil || ts.IsZero() {
-		return nil, fmt.Errorf("failed to parsed signed at %q: %w", req.GetSignedAt(), err)
+	if _, err := parseSignedAt(req.GetSignedAt()); err != nil {
+		return nil, err
 	}
-	ts = ts.UTC() // Always operate with UTC time.
-	if time.Since(ts) > 5*time.Minute {
-		return nil, fmt.Errorf("signature has expired")
+
+	machine, pubKey, err := registry.ByRequest(ctx, s.db, req.GetMachineId())
+	if err != nil {
+		return nil, err
 	}
 
-	sig := req.GetSignature()
+	if err := machines.VerifyKeyRequest(pubKey, req); err != nil {
+		if machines.VerifyLegacyKeyRequest(pubKey, req) != nil {
+			return nil, err
+		}
+		if req, err = s.legacyKeyRequest(ctx, machine, req); err != nil {
+			return nil, err
+		}
+	}
+	if err := machine.Update().SetLastAskedAt(time.Now()).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record key request: %w", err)
+	}
+	if machine.PendingConfirmation {
+		return nil, fmt.Errorf("machine is awaiting confirmation by an operator")
+	}
 
-	machine, err := s.db.Machine.Get(ctx, req.GetMachineId())
-	if err != nil {
+	request := fmt.Sprintf("GetKey with boot measurements %s, hardware %s",
+		measurements.Format(req.GetBootMeasurements()), hardware.Format(hardware.ToMap(req.GetHardware())))
+	if err := s.checkState(ctx, machine, request); err != nil {
+		// Quarantined machines, e.g., stolen ones, are kept asking as if
+		// their key wasn't submitted yet, instead of learning they were.
+		if machine.State == entmachine.StateQuarantined {
+			return nil, fmt.Errorf("key not available")
+		}
 		return nil, err
 	}
 
-	if err := machines.Verify(machine.PublicKey, sig, nonce); err != nil {
+	// A machine booting something unexpected could have a tampered
+	// initramfs, which is where its private key lives.
+	if err := measurements.Check(ctx, s.db, machine.ID, req.GetBootMeasurements()); err != nil {
+		if errors.Is(err, measurements.ErrMismatch) {
+			s.alertMeasurementMismatch(ctx, machine, req.GetBootMeasurements())
+		}
 		return nil, err
 	}
 
-	spubk, err := sign.PublicKeyFromBytes(machine.PublicKey)
+	if err := s.checkHardware(ctx, machine, req.GetHardware()); err != nil {
+		return nil, err
+	}
+
+	secret, err := secrets.Resolve(machine.Secrets, req.GetSecret())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create pub key for encryption: %w", err)
+		return nil, err
 	}
 
-	enc, err := sign.NewEncryptor(nil, 1024)
+	d, err := s.decide(ctx, machine)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create encryptor instance: %w", err)
+		return nil, err
+	}
+	requiredApprovals, autoUnlock := d.RequiredApprovals, d.AutoUnlock
+	ev := &audit.Event{
+		Type:              audit.EventKeyReleased,
+		MachineID:         machine.ID,
+		MaintenanceWindow: d.MaintenanceWindow,
+		Details:           d.Details,
 	}
 
-	if err := enc.AddRecipient(spubk); err != nil {
-		return nil, fmt.Errorf("failed to add instance public key to encryptor: %w", err)
+	// Machines that are allowed to be unlocked automatically receive the
+	// key stored in the vault, if it's unsealed, instead of waiting for
+	// an operator to submit it.
+	var storedKey []byte
+	storedIn := "vault"
+	if autoUnlock && machine.ShareThreshold == 0 {
+		storedKey, err = s.vault.Get(ctx, machine.ID, secrets.StoredName(machine.Secrets, secret))
+		if err != nil && !errors.Is(err, vault.ErrSealed) && !errors.Is(err, vault.ErrNotFound) {
+			return nil, err
+		}
+		if storedKey == nil && s.VaultKV != nil {
+			storedKey, storedIn = s.getVaultKVKey(ctx, machine, secret), "Vault KV"
+		}
+		if storedKey == nil && s.CredentialHelper != nil {
+			storedKey, storedIn = s.getCredentialHelperKey(ctx, machine, secret), "credential helper "+s.CredentialHelper.Name
+		}
 	}
 
 	s.sesMu.Lock()
 	defer s.sesMu.Unlock()
 
 	// Track the last time the machine asked for a key. This is what backs
-	// the sessions api
-	if _, ok := s.ses[machine.ID]; !ok {
-		s.ses[machine.ID] = &Session{}
+	// the sessions api. A machine asking for a secret that was already
+	// released to it has rebooted, which starts a new session.
+	ses := s.session(machine.ID)
+	if ses.released(secret) {
+		ses = newSession()
+		s.ses[machine.ID] = ses
+	}
+	ses.LastAsked = time.Now()
+	ses.PeerAddress, _ = requestPeer(ctx)
+
+	if ses.DeniedBy != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "boot was denied by operator %q", ses.DeniedBy.Name)
+	}
+
+	// Keys submitted for the session are encrypted to the key it was
+	// started with, which the machine no longer uses. Approvals were
+	// for releasing them, so they go too.
+	if !pubKey.Equal(ses.PublicKey) {
+		ses.PublicKey = pubKey
+		clear(ses.Secrets)
+		clear(ses.Approvals)
 	}
-	s.ses[machine.ID].LastAsked = time.Now()
 
-	if len(s.ses[machine.ID].EncKey) == 0 {
+	sec := ses.secret(secret)
+	if len(sec.EncKey) == 0 && storedKey != nil {
+		sec.EncKey, err = machines.Encrypt(pubKey, storedKey)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("releasing stored key from %s for secret %q of machine %q\n", storedIn, secret, machine.ID)
+	}
+
+	if machine.ShareThreshold > 0 {
+		if len(sec.EncShares) < machine.ShareThreshold {
+			return nil, fmt.Errorf("key not available (%d/%d shares)", len(sec.EncShares), machine.ShareThreshold)
+		}
+	} else if len(sec.EncKey) == 0 {
 		return nil, fmt.Errorf("key not available")
 	}
-	resp.SetEncKey(s.ses[machine.ID].EncKey)
+	if approvals := len(ses.Approvals); approvals < requiredApprovals {
+		return nil, fmt.Errorf("key awaiting approval (%d/%d)", approvals, requiredApprovals)
+	}
 
-	// Reset the session
-	delete(s.ses, machine.ID)
+	if machine.ShareThreshold > 0 {
+		encShares := make([][]byte, 0, len(sec.EncShares))
+		for _, encShare := range sec.EncShares {
+			encShares = append(encShares, encShare)
+		}
+		resp.SetEncShares(encShares)
+	} else {
+		resp.SetEncKey(sec.EncKey)
+		resp.SetShareIndex(int32(machine.ShareIndex)) //nolint:gosec // Why: At most 255.
+	}
+	machines.SignKeyResponse(s.identity, req.GetNonce(), resp)
+
+	if ev.Details == "" {
+		ev.Details = "released without approval"
+		if len(ses.Approvals) != 0 {
+			approvers := make([]string, 0, len(ses.Approvals))
+			for _, a := range ses.Approvals {
+				approvers = append(approvers, a.Operator.Name)
+			}
+			slices.Sort(approvers)
+			ev.Details = "released after approval by " + strings.Join(approvers, ", ")
+		}
+	}
+	if len(machine.Secrets) != 0 {
+		ev.Details += fmt.Sprintf(" (secret %q)", secret)
+	}
+	if err := audit.Record(ctx, s.db, ev); err != nil {
+		return nil, err
+	}
+
+	if err := machine.Update().SetLastUnlockedAt(time.Now()).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record unlock: %w", err)
+	}
+
+	// The session ends once every secret of the machine was released.
+	sec.EncKey = nil
+	clear(sec.EncShares)
+	sec.ReleasedAt = time.Now()
+	if !slices.ContainsFunc(secrets.Names(machine.Secrets), func(name string) bool { return !ses.released(name) }) {
+		delete(s.ses, machine.ID)
+	}
 
 	return resp, nil
 }
 
 // ListSessions implements the ListSessions RPC.
-func (s *Server) ListSessions(ctx context.Context, _ *pbgrpcv1.ListSessionsRequest) (*pbgrpcv1.ListSessionsResponse, error) {
+func (s *Server) ListSessions(ctx context.Context, req *pbgrpcv1.ListSessionsRequest) (*pbgrpcv1.ListSessionsResponse, error) {
+	selector, err := labels.ParseSelector(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	var group *ent.MachineGroup
+	if req.GetGroup() != "" {
+		group, err = groups.Get(ctx, s.db, req.GetGroup())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s.sesMu.RLock()
 	defer s.sesMu.RUnlock()
 
@@ -180,10 +552,39 @@ func (s *Server) ListSessions(ctx context.Context, _ *pbgrpcv1.ListSessionsReque
 		if err != nil {
 			return nil, fmt.Errorf("failed to get machine %q: %w", machineID, err)
 		}
+		if !selector.Matches(machine.Labels) {
+			continue
+		}
+		if group != nil {
+			if ok, err := groups.Contains(group, machine); err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+		}
 
 		// If the machine asked recently, return it
-		gMachine := machines.GRPCMachine(machine)
-		gMachine.SetLastAsked(s.ses[machineID].LastAsked.Format(time.RFC3339Nano))
+		ses := s.ses[machineID]
+		gMachine := grpcMachine(machine)
+		gMachine.SetPublicKey(ses.PublicKey)
+		gMachine.SetLastAsked(ses.LastAsked.Format(time.RFC3339Nano))
+		gMachine.SetStartedAt(ses.StartedAt.Format(time.RFC3339Nano))
+		gMachine.SetPeerAddress(ses.PeerAddress)
+		if ses.DeniedBy != nil {
+			gMachine.SetDeniedBy(ses.DeniedBy.Name)
+		}
+		names := secrets.Names(machine.Secrets)
+		gMachine.SetSecrets(ses.grpcSecrets(names))
+		if def, ok := ses.Secrets[names[0]]; ok {
+			gMachine.SetKeySubmitted(len(def.EncKey) != 0)
+			gMachine.SetSharesSubmitted(int32(len(def.EncShares))) //nolint:gosec // Why: At most 255 shares.
+		}
+
+		approvals := make([]*pbgrpcv1.Approval, 0, len(ses.Approvals))
+		for _, a := range ses.Approvals {
+			approvals = append(approvals, operators.GRPCApproval(a.Operator, a.ApprovedAt))
+		}
+		gMachine.SetApprovals(approvals)
 		grpcMachines = append(grpcMachines, gMachine)
 	}
 
@@ -191,13 +592,132 @@ func (s *Server) ListSessions(ctx context.Context, _ *pbgrpcv1.ListSessionsReque
 	return resp, nil
 }
 
+// ApproveSession implements the ApproveSession RPC.
+func (s *Server) ApproveSession(ctx context.Context, req *pbgrpcv1.ApproveSessionRequest) (*pbgrpcv1.ApproveSessionResponse, error) {
+	machineID := req.GetMachineId()
+	signedAt, err := parseSignedAt(req.GetSignedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := s.verifyOperator(ctx, req.GetOperatorId(), req.GetSignature(),
+		operators.ActionApprove, machineID, req.GetNonce(), req.GetSignedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	machine, err := s.db.Machine.Get(ctx, machineID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machine %q: %w", machineID, err)
+	}
+
+	s.sesMu.Lock()
+	defer s.sesMu.Unlock()
+
+	ses, ok := s.ses[machineID]
+	if !ok {
+		return nil, fmt.Errorf("failed to find machine ID %q", machineID)
+	}
+
+	// Approvals signed before the session started can only be replays of
+	// an approval for an earlier session.
+	if signedAt.Before(ses.StartedAt) {
+		return nil, fmt.Errorf("approval was signed before the session started")
+	}
+	if ses.DeniedBy != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "session of machine %q was denied by operator %q", machineID, ses.DeniedBy.Name)
+	}
+
+	if _, ok := ses.Approvals[operator.ID]; !ok {
+		if err := audit.Record(ctx, s.db, &audit.Event{
+			Type:       audit.EventSessionApproved,
+			MachineID:  machineID,
+			OperatorID: operator.ID,
+			Details: fmt.Sprintf("session approved by %s (%d/%d)",
+				operator.Name, len(ses.Approvals)+1, machine.RequiredApprovals),
+		}); err != nil {
+			return nil, err
+		}
+		ses.Approvals[operator.ID] = &Approval{Operator: operator, ApprovedAt: time.Now()}
+	}
+
+	resp := &pbgrpcv1.ApproveSessionResponse{}
+	resp.SetApprovals(int32(len(ses.Approvals)))                //nolint:gosec // Why: Bounded by the number of operators.
+	resp.SetRequiredApprovals(int32(machine.RequiredApprovals)) //nolint:gosec // Why: Set by operators.
+	return resp, nil
+}
+
+// verifyOperator returns the operator with the provided ID if the
+// provided signature is theirs for a request to perform the provided
+// action on the provided subject.
+func (s *Server) verifyOperator(ctx context.Context, operatorID string, sig []byte,
+	action, subject, nonce, signedAt string) (*ent.Operator, error) {
+	if _, err := parseSignedAt(signedAt); err != nil {
+		return nil, err
+	}
+
+	operator, err := s.db.Operator.Get(ctx, operatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find operator %q: %w", operatorID, err)
+	}
+
+	if err := operators.VerifyRequest(operator.PublicKey, sig, action, subject, nonce, signedAt); err != nil {
+		return nil, err
+	}
+
+	return operator, nil
+}
+
+// legacyKeyRequest returns the provided key request, signed by an older
+// client over only its nonce, with everything that isn't authenticated
+// by it removed. Such requests are rejected for machines relying on
+// their boot measurements or bound to hardware attributes, neither of
+// which the signature covers.
+func (s *Server) legacyKeyRequest(ctx context.Context, machine *ent.Machine,
+	req *pbgrpcv1.GetKeyRequest) (*pbgrpcv1.GetKeyRequest, error) {
+	hasAllowlist, err := measurements.HasAllowlist(ctx, s.db, machine.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasAllowlist || len(machine.Hardware) != 0 {
+		return nil, fmt.Errorf("key request must sign its boot measurements and hardware attributes, update the client")
+	}
+
+	legacy := &pbgrpcv1.GetKeyRequest{}
+	legacy.SetMachineId(req.GetMachineId())
+	legacy.SetNonce(req.GetNonce())
+	legacy.SetSignedAt(req.GetSignedAt())
+	legacy.SetSignature(req.GetSignature())
+	return legacy, nil
+}
+
+// parseSignedAt parses the provided signed at timestamp, returning an
+// error if it is invalid or too old to be accepted.
+func parseSignedAt(signedAt string) (time.Time, error) {
+	ts, err := time.Parse(time.RFC3339Nano, signedAt)
+	if err != nil || ts.IsZero() {
+		return time.Time{}, fmt.Errorf("failed to parsed signed at %q: %w", signedAt, err)
+	}
+	ts = ts.UTC() // Always operate with UTC time.
+	if time.Since(ts) > 5*time.Minute {
+		return time.Time{}, fmt.Errorf("signature has expired")
+	}
+
+	return ts, nil
+}
+
 // Close closes the server
-func (s *Server) Close(_ context.Context) error {
+func (s *Server) Close(ctx context.Context) error {
 	if s.gs == nil {
 		return nil
 	}
 
 	fmt.Println("shutting down server")
 	s.gs.GracefulStop()
+	if s.ts != nil {
+		if err := s.ts.Shutdown(ctx); err != nil {
+			return fmt.Errorf("failed to shut down tang server: %w", err)
+		}
+	}
 	return s.db.Close()
 }
@@ -0,0 +1,462 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"maps"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/alerts"
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/hardware"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// newTestServer returns a new [Server] with an empty DB, which isn't
+// serving anything.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	t.Cleanup(func() { db.Close() })
+	return &Server{
+		db:      db,
+		ses:     make(map[string]*Session),
+		alerter: &alerts.Alerter{},
+		alerted: make(map[string]string),
+	}
+}
+
+// newTestMachine adds a new machine with the provided name to the DB of
+// the provided server, with a session that started a minute ago.
+func newTestMachine(t *testing.T, s *Server, name string) (*ent.Machine, ed25519.PrivateKey) {
+	t.Helper()
+
+	m, err := machines.NewMachine()
+	if err != nil {
+		t.Fatalf("failed to create machine: %v", err)
+	}
+	fprint, err := m.Fingerprint()
+	if err != nil {
+		t.Fatalf("failed to get fingerprint: %v", err)
+	}
+
+	machine, err := s.db.Machine.Create().SetName(name).SetID(fprint).SetPublicKey(m.PublicKey).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create machine: %v", err)
+	}
+
+	ses := newSession()
+	ses.StartedAt = time.Now().Add(-time.Minute)
+	s.ses[machine.ID] = ses
+	return machine, m.PrivateKey
+}
+
+// newTestOperator adds a new operator with the provided name to the DB
+// of the provided server.
+func newTestOperator(t *testing.T, s *Server, name string) (*ent.Operator, ed25519.PrivateKey) {
+	t.Helper()
+
+	o, err := operators.NewOperator()
+	if err != nil {
+		t.Fatalf("failed to create operator: %v", err)
+	}
+	fprint, err := o.Fingerprint()
+	if err != nil {
+		t.Fatalf("failed to get fingerprint: %v", err)
+	}
+
+	operator, err := s.db.Operator.Create().SetName(name).SetID(fprint).SetPublicKey(o.PublicKey).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create operator: %v", err)
+	}
+	return operator, o.PrivateKey
+}
+
+// approve approves the session of the provided machine as the provided
+// operator.
+func approve(t *testing.T, s *Server, machineID string, o *ent.Operator, pk ed25519.PrivateKey) {
+	t.Helper()
+
+	nonce, signedAt := "nonce", time.Now().UTC().Format(time.RFC3339Nano)
+	req := &pbgrpcv1.ApproveSessionRequest{}
+	req.SetMachineId(machineID)
+	req.SetOperatorId(o.ID)
+	req.SetNonce(nonce)
+	req.SetSignedAt(signedAt)
+	req.SetSignature(operators.SignRequest(pk, operators.ActionApprove, machineID, nonce, signedAt))
+	if _, err := s.ApproveSession(context.Background(), req); err != nil {
+		t.Fatalf("ApproveSession() error = %v", err)
+	}
+}
+
+// submitKey submits the provided encrypted key, or share if shareIndex
+// isn't zero, for the provided machine.
+func submitKey(t *testing.T, s *Server, machineID string, encKey []byte, shareIndex int32) {
+	t.Helper()
+
+	req := &pbgrpcv1.SubmitKeyRequest{}
+	req.SetMachineId(machineID)
+	req.SetEncKey(encKey)
+	req.SetShareIndex(shareIndex)
+	if _, err := s.SubmitKey(context.Background(), req); err != nil {
+		t.Fatalf("SubmitKey() error = %v", err)
+	}
+}
+
+func TestSubmitKeyApprovals(t *testing.T) {
+	tests := []struct {
+		name          string
+		shareIndex    int32
+		before, after []byte
+		wantApprovals int
+	}{
+		{name: "first key", after: []byte("key1"), wantApprovals: 1},
+		{name: "same key", before: []byte("key1"), after: []byte("key1"), wantApprovals: 1},
+		{name: "different key", before: []byte("key1"), after: []byte("key2"), wantApprovals: 0},
+		{name: "first share", shareIndex: 1, after: []byte("share1"), wantApprovals: 1},
+		{name: "same share", shareIndex: 1, before: []byte("share1"), after: []byte("share1"), wantApprovals: 1},
+		{name: "different share", shareIndex: 1, before: []byte("share1"), after: []byte("share2"), wantApprovals: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			o, pk := newTestOperator(t, s, "alice")
+			if tt.shareIndex != 0 {
+				if err := machine.Update().SetShareThreshold(2).Exec(context.Background()); err != nil {
+					t.Fatalf("failed to update machine: %v", err)
+				}
+			}
+
+			if tt.before != nil {
+				submitKey(t, s, machine.ID, tt.before, tt.shareIndex)
+			}
+			approve(t, s, machine.ID, o, pk)
+			submitKey(t, s, machine.ID, tt.after, tt.shareIndex)
+
+			if got := len(s.ses[machine.ID].Approvals); got != tt.wantApprovals {
+				t.Errorf("approvals = %d, want %d", got, tt.wantApprovals)
+			}
+		})
+	}
+}
+
+func TestSubmitKeySecrets(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+	machine, _ := newTestMachine(t, s, "nas1")
+	if err := machine.Update().SetSecrets([]string{"root", "tank"}).Exec(ctx); err != nil {
+		t.Fatalf("failed to update machine: %v", err)
+	}
+	o, pk := newTestOperator(t, s, "alice")
+
+	submit := func(secret string, encKey []byte) error {
+		req := &pbgrpcv1.SubmitKeyRequest{}
+		req.SetMachineId(machine.ID)
+		req.SetSecret(secret)
+		req.SetEncKey(encKey)
+		_, err := s.SubmitKey(ctx, req)
+		return err
+	}
+
+	if err := submit("root", []byte("root1")); err != nil {
+		t.Fatalf("SubmitKey() error = %v", err)
+	}
+	approve(t, s, machine.ID, o, pk)
+
+	// Approvals cover the session, so another secret's first key keeps
+	// them, but changing a held key doesn't.
+	if err := submit("tank", []byte("tank1")); err != nil {
+		t.Fatalf("SubmitKey() error = %v", err)
+	}
+	if got := len(s.ses[machine.ID].Approvals); got != 1 {
+		t.Errorf("approvals after another secret = %d, want 1", got)
+	}
+	if err := submit("tank", []byte("tank2")); err != nil {
+		t.Fatalf("SubmitKey() error = %v", err)
+	}
+	if got := len(s.ses[machine.ID].Approvals); got != 0 {
+		t.Errorf("approvals after a changed key = %d, want 0", got)
+	}
+	if got := string(s.ses[machine.ID].Secrets["tank"].EncKey); got != "tank2" {
+		t.Errorf("key of tank = %q, want %q", got, "tank2")
+	}
+
+	if err := submit("backup", []byte("backup1")); err == nil {
+		t.Error("SubmitKey() of an unknown secret error = nil, want error")
+	}
+
+	s.ses[machine.ID].Secrets["root"].ReleasedAt = time.Now()
+	if err := submit("root", []byte("root2")); err == nil {
+		t.Error("SubmitKey() of a released secret error = nil, want error")
+	}
+}
+
+func TestApproveSession(t *testing.T) {
+	tests := []struct {
+		name          string
+		approvers     []string
+		wantApprovals int
+	}{
+		{name: "single operator", approvers: []string{"alice"}, wantApprovals: 1},
+		{name: "same operator twice", approvers: []string{"alice", "alice"}, wantApprovals: 1},
+		{name: "distinct operators", approvers: []string{"alice", "bob"}, wantApprovals: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+
+			type operator struct {
+				o  *ent.Operator
+				pk ed25519.PrivateKey
+			}
+			ops := make(map[string]operator)
+			for _, name := range tt.approvers {
+				op, ok := ops[name]
+				if !ok {
+					op.o, op.pk = newTestOperator(t, s, name)
+					ops[name] = op
+				}
+				approve(t, s, machine.ID, op.o, op.pk)
+			}
+
+			if got := len(s.ses[machine.ID].Approvals); got != tt.wantApprovals {
+				t.Errorf("approvals = %d, want %d", got, tt.wantApprovals)
+			}
+
+			// Every approval is recorded once, with who made it.
+			events, err := s.db.AuditEvent.Query().
+				Where(auditevent.Type(audit.EventSessionApproved), auditevent.MachineID(machine.ID)).All(ctx)
+			if err != nil {
+				t.Fatalf("failed to query audit log: %v", err)
+			}
+			if len(events) != tt.wantApprovals {
+				t.Fatalf("audit events = %d, want %d", len(events), tt.wantApprovals)
+			}
+			for _, e := range events {
+				if _, ok := s.ses[machine.ID].Approvals[e.OperatorID]; !ok {
+					t.Errorf("audit event has operator %q, which didn't approve", e.OperatorID)
+				}
+			}
+		})
+	}
+}
+
+func TestApproveSessionRejectsOtherMachine(t *testing.T) {
+	s := newTestServer(t)
+	machine, _ := newTestMachine(t, s, "nas1")
+	other, _ := newTestMachine(t, s, "nas2")
+	o, pk := newTestOperator(t, s, "alice")
+
+	// A signature approving one machine must not approve another.
+	nonce, signedAt := "nonce", time.Now().UTC().Format(time.RFC3339Nano)
+	req := &pbgrpcv1.ApproveSessionRequest{}
+	req.SetMachineId(other.ID)
+	req.SetOperatorId(o.ID)
+	req.SetNonce(nonce)
+	req.SetSignedAt(signedAt)
+	req.SetSignature(operators.SignRequest(pk, operators.ActionApprove, machine.ID, nonce, signedAt))
+	if _, err := s.ApproveSession(context.Background(), req); err == nil {
+		t.Fatal("ApproveSession() error = nil, want error")
+	}
+	if got := len(s.ses[other.ID].Approvals); got != 0 {
+		t.Errorf("approvals = %d, want 0", got)
+	}
+}
+
+func TestSubmitKeyShareIndex(t *testing.T) {
+	tests := []struct {
+		name           string
+		shareThreshold int
+		shareIndex     int
+		submitted      int32
+		wantErr        bool
+	}{
+		{name: "whole key", submitted: 0},
+		{name: "share for whole key", submitted: 1, wantErr: true},
+		{name: "split key", shareThreshold: 2, submitted: 1},
+		{name: "whole key for split key", shareThreshold: 2, submitted: 0, wantErr: true},
+		{name: "held share", shareIndex: 3, submitted: 3},
+		{name: "other share", shareIndex: 3, submitted: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			if err := machine.Update().SetShareThreshold(tt.shareThreshold).SetShareIndex(tt.shareIndex).
+				Exec(ctx); err != nil {
+				t.Fatalf("failed to update machine: %v", err)
+			}
+
+			req := &pbgrpcv1.SubmitKeyRequest{}
+			req.SetMachineId(machine.ID)
+			req.SetEncKey([]byte("key"))
+			req.SetShareIndex(tt.submitted)
+			if _, err := s.SubmitKey(ctx, req); (err != nil) != tt.wantErr {
+				t.Errorf("SubmitKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLegacyKeyRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		hasAllowlist bool
+		hardware     map[string]string
+		wantErr      bool
+	}{
+		{name: "no allowlist"},
+		{name: "allowlist", hasAllowlist: true, wantErr: true},
+		{name: "hardware bound", hardware: map[string]string{hardware.BoardSerial: "abc"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			if tt.hasAllowlist {
+				if err := s.db.BootMeasurement.Create().SetMachineID(machine.ID).SetKernel("aaaa").Exec(ctx); err != nil {
+					t.Fatalf("failed to create allowlist entry: %v", err)
+				}
+			}
+			if tt.hardware != nil {
+				var err error
+				if machine, err = machine.Update().SetHardware(tt.hardware).Save(ctx); err != nil {
+					t.Fatalf("failed to update machine: %v", err)
+				}
+			}
+
+			bm := &pbgrpcv1.BootMeasurements{}
+			bm.SetKernel("aaaa")
+			req := &pbgrpcv1.GetKeyRequest{}
+			req.SetMachineId(machine.ID)
+			req.SetNonce("nonce")
+			req.SetBootMeasurements(bm)
+
+			got, err := s.legacyKeyRequest(ctx, machine, req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("legacyKeyRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			// Measurements aren't signed by older clients, so they must not
+			// be trusted.
+			if got.HasBootMeasurements() {
+				t.Error("legacyKeyRequest() kept the unsigned boot measurements")
+			}
+			if got.GetMachineId() != machine.ID || got.GetNonce() != "nonce" {
+				t.Errorf("legacyKeyRequest() = %v, want the machine ID and nonce kept", got)
+			}
+		})
+	}
+}
+
+func TestCheckHardware(t *testing.T) {
+	bound := map[string]string{hardware.BoardSerial: "abc"}
+
+	tests := []struct {
+		name        string
+		bound       map[string]string
+		binding     entmachine.HardwareBinding
+		reported    string
+		wantErr     bool
+		wantBound   map[string]string
+		wantPending map[string]string
+	}{
+		{name: "nothing reported"},
+		{name: "first use", reported: "abc", wantBound: bound},
+		{name: "match", bound: bound, reported: "abc", wantBound: bound},
+		{
+			name:        "mismatch warn",
+			bound:       bound,
+			binding:     entmachine.HardwareBindingWarn,
+			reported:    "def",
+			wantBound:   bound,
+			wantPending: map[string]string{hardware.BoardSerial: "def"},
+		},
+		{
+			name:        "mismatch enforce",
+			bound:       bound,
+			binding:     entmachine.HardwareBindingEnforce,
+			reported:    "def",
+			wantErr:     true,
+			wantBound:   bound,
+			wantPending: map[string]string{hardware.BoardSerial: "def"},
+		},
+		{
+			name:        "missing enforce",
+			bound:       bound,
+			binding:     entmachine.HardwareBindingEnforce,
+			wantErr:     true,
+			wantBound:   bound,
+			wantPending: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			upd := machine.Update().SetHardware(tt.bound)
+			if tt.binding != "" {
+				upd.SetHardwareBinding(tt.binding)
+			}
+			machine, err := upd.Save(ctx)
+			if err != nil {
+				t.Fatalf("failed to update machine: %v", err)
+			}
+
+			hw := &pbgrpcv1.HardwareAttributes{}
+			hw.SetBoardSerial(tt.reported)
+			if err := s.checkHardware(ctx, machine, hw); (err != nil) != tt.wantErr {
+				t.Errorf("checkHardware() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			machine = s.db.Machine.GetX(ctx, machine.ID)
+			if !maps.Equal(machine.Hardware, tt.wantBound) {
+				t.Errorf("hardware = %v, want %v", machine.Hardware, tt.wantBound)
+			}
+			if !maps.Equal(machine.PendingHardware, tt.wantPending) {
+				t.Errorf("pending hardware = %v, want %v", machine.PendingHardware, tt.wantPending)
+			}
+		})
+	}
+}
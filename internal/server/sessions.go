@@ -0,0 +1,122 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DenySession implements the DenySession RPC. Keys submitted for the
+// session are dropped, and the machine is refused until an operator
+// cancels the session.
+func (s *Server) DenySession(ctx context.Context, req *pbgrpcv1.DenySessionRequest) (*pbgrpcv1.DenySessionResponse, error) {
+	machineID := req.GetMachineId()
+	operator, err := s.verifySessionAction(ctx, operators.ActionDeny, machineID,
+		req.GetOperatorId(), req.GetSignature(), req.GetNonce(), req.GetSignedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	s.sesMu.Lock()
+	ses, ok := s.ses[machineID]
+	if ok && ses.DeniedBy == nil {
+		ses.DeniedBy = operator
+		for _, sec := range ses.Secrets {
+			sec.EncKey = nil
+			clear(sec.EncShares)
+		}
+	}
+	s.sesMu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no session for machine ID %q", machineID)
+	}
+
+	fmt.Printf("operator %q (%s) denied session for machine %q\n", operator.Name, operator.ID, machineID)
+	if err := audit.Record(ctx, s.db, &audit.Event{
+		Type:       audit.EventSessionDenied,
+		MachineID:  machineID,
+		OperatorID: operator.ID,
+		Details:    fmt.Sprintf("session denied by %s", operator.Name),
+	}); err != nil {
+		return nil, err
+	}
+	return &pbgrpcv1.DenySessionResponse{}, nil
+}
+
+// CancelSession implements the CancelSession RPC. The session, with the
+// keys submitted for it, its approvals and any denial, is dropped, so
+// that the machine starts a new one the next time it asks for its key.
+func (s *Server) CancelSession(ctx context.Context, req *pbgrpcv1.CancelSessionRequest) (*pbgrpcv1.CancelSessionResponse, error) {
+	machineID := req.GetMachineId()
+	operator, err := s.verifySessionAction(ctx, operators.ActionCancel, machineID,
+		req.GetOperatorId(), req.GetSignature(), req.GetNonce(), req.GetSignedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	s.sesMu.Lock()
+	_, ok := s.ses[machineID]
+	delete(s.ses, machineID)
+	s.sesMu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no session for machine ID %q", machineID)
+	}
+
+	fmt.Printf("operator %q (%s) cancelled session for machine %q\n", operator.Name, operator.ID, machineID)
+	if err := audit.Record(ctx, s.db, &audit.Event{
+		Type:       audit.EventSessionCancelled,
+		MachineID:  machineID,
+		OperatorID: operator.ID,
+		Details:    fmt.Sprintf("session cancelled by %s", operator.Name),
+	}); err != nil {
+		return nil, err
+	}
+	return &pbgrpcv1.CancelSessionResponse{}, nil
+}
+
+// verifySessionAction returns the operator that signed the provided
+// action on the session of the machine with the provided ID. Like
+// approvals, actions signed before the session started are rejected as
+// replays.
+func (s *Server) verifySessionAction(ctx context.Context, action, machineID, operatorID string, sig []byte,
+	nonce, signedAt string) (*ent.Operator, error) {
+	signedAtTime, err := parseSignedAt(signedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := s.verifyOperator(ctx, operatorID, sig, action, machineID, nonce, signedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sesMu.RLock()
+	defer s.sesMu.RUnlock()
+	if ses, ok := s.ses[machineID]; ok && signedAtTime.Before(ses.StartedAt) {
+		return nil, fmt.Errorf("request was signed before the session started")
+	}
+	return operator, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// sessionAction is a signed request of an operator acting on the
+// session of a machine.
+type sessionAction interface {
+	SetMachineId(string)
+	SetOperatorId(string)
+	SetNonce(string)
+	SetSignedAt(string)
+	SetSignature([]byte)
+}
+
+// signSessionAction signs the provided request of the provided operator
+// to do action on the session of the machine with the provided ID, as
+// of signedAt.
+func signSessionAction(req sessionAction, action, machineID string, o *ent.Operator, pk ed25519.PrivateKey,
+	signedAt time.Time) {
+	nonce, at := "nonce", signedAt.UTC().Format(time.RFC3339Nano)
+	req.SetMachineId(machineID)
+	req.SetOperatorId(o.ID)
+	req.SetNonce(nonce)
+	req.SetSignedAt(at)
+	req.SetSignature(operators.SignRequest(pk, action, machineID, nonce, at))
+}
+
+func TestDenySession(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+	machine, _ := newTestMachine(t, s, "nas1")
+	o, pk := newTestOperator(t, s, "alice")
+	submitKey(t, s, machine.ID, []byte("key1"), 0)
+
+	req := &pbgrpcv1.DenySessionRequest{}
+	signSessionAction(req, operators.ActionDeny, machine.ID, o, pk, time.Now())
+	if _, err := s.DenySession(ctx, req); err != nil {
+		t.Fatalf("DenySession() error = %v", err)
+	}
+
+	ses := s.ses[machine.ID]
+	if ses.DeniedBy == nil || ses.DeniedBy.ID != o.ID {
+		t.Errorf("session denied by = %v, want %s", ses.DeniedBy, o.ID)
+	}
+	for name, sec := range ses.Secrets {
+		if len(sec.EncKey) != 0 {
+			t.Errorf("key of secret %q was kept after denial", name)
+		}
+	}
+
+	// A denied session can't be approved or given a key until it's
+	// cancelled.
+	approveReq := &pbgrpcv1.ApproveSessionRequest{}
+	signSessionAction(approveReq, operators.ActionApprove, machine.ID, o, pk, time.Now())
+	if _, err := s.ApproveSession(ctx, approveReq); err == nil {
+		t.Error("ApproveSession() of a denied session error = nil, want error")
+	}
+	submitReq := &pbgrpcv1.SubmitKeyRequest{}
+	submitReq.SetMachineId(machine.ID)
+	submitReq.SetEncKey([]byte("key2"))
+	if _, err := s.SubmitKey(ctx, submitReq); err == nil {
+		t.Error("SubmitKey() to a denied session error = nil, want error")
+	}
+
+	n, err := s.db.AuditEvent.Query().
+		Where(auditevent.Type(audit.EventSessionDenied), auditevent.MachineID(machine.ID), auditevent.OperatorID(o.ID)).
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to query audit log: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("denial audit events = %d, want 1", n)
+	}
+}
+
+func TestCancelSession(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+	machine, _ := newTestMachine(t, s, "nas1")
+	o, pk := newTestOperator(t, s, "alice")
+	approve(t, s, machine.ID, o, pk)
+
+	req := &pbgrpcv1.CancelSessionRequest{}
+	signSessionAction(req, operators.ActionCancel, machine.ID, o, pk, time.Now())
+	if _, err := s.CancelSession(ctx, req); err != nil {
+		t.Fatalf("CancelSession() error = %v", err)
+	}
+	if _, ok := s.ses[machine.ID]; ok {
+		t.Error("session was kept after cancelling it")
+	}
+
+	// There is nothing left to cancel.
+	if _, err := s.CancelSession(ctx, req); err == nil {
+		t.Error("CancelSession() without a session error = nil, want error")
+	}
+}
+
+func TestSessionActionSignatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		signedAt time.Duration
+	}{
+		// Signatures are bound to the action they were made for.
+		{name: "approval used as denial", action: operators.ActionApprove},
+		// Newer sessions can't be acted on with older signatures.
+		{name: "signed before session", action: operators.ActionDeny, signedAt: -time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			o, pk := newTestOperator(t, s, "alice")
+
+			req := &pbgrpcv1.DenySessionRequest{}
+			signSessionAction(req, tt.action, machine.ID, o, pk, time.Now().Add(tt.signedAt))
+			if _, err := s.DenySession(context.Background(), req); err == nil {
+				t.Fatal("DenySession() error = nil, want error")
+			}
+			if s.ses[machine.ID].DeniedBy != nil {
+				t.Error("session was denied")
+			}
+		})
+	}
+}
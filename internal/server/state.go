@@ -0,0 +1,76 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkState returns an error if the provided machine isn't allowed to
+// make requests, because it isn't active or has expired. Requests made
+// by quarantined machines, described by request, are recorded and
+// alerted on.
+func (s *Server) checkState(ctx context.Context, machine *ent.Machine, request string) error {
+	if machine.State == entmachine.StateQuarantined {
+		s.recordQuarantined(ctx, machine, request)
+	}
+	if machine.State != entmachine.StateActive {
+		return status.Errorf(codes.PermissionDenied, "machine is %s", machine.State)
+	}
+	if machine.ExpiresAt != nil && time.Now().After(*machine.ExpiresAt) {
+		return status.Errorf(codes.PermissionDenied, "machine expired at %s", machine.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// recordQuarantined records the provided request made by the provided
+// quarantined machine, with where it was made from. Every request is
+// recorded, but operators are only alerted when a request differs from
+// the last one, so that polling machines don't repeat alerts.
+func (s *Server) recordQuarantined(ctx context.Context, machine *ent.Machine, request string) {
+	addr, md := requestPeer(ctx)
+
+	pairs := make([]string, 0, len(md))
+	for _, k := range slices.Sorted(maps.Keys(md)) {
+		pairs = append(pairs, k+"="+md[k])
+	}
+
+	ev := &audit.Event{
+		Type:      audit.EventQuarantinedRequest,
+		MachineID: machine.ID,
+		Details: fmt.Sprintf("%s from %s, metadata: %s",
+			request, addr, strings.Join(pairs, " ")),
+	}
+	if s.alertOnce(ctx, ev) {
+		return
+	}
+	if err := audit.Record(ctx, s.db, ev); err != nil {
+		fmt.Printf("failed to record quarantined request: %v\n", err)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+)
+
+func TestCheckState(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        entmachine.State
+		expiresIn    time.Duration
+		wantErr      bool
+		wantRecorded bool
+	}{
+		{name: "active"},
+		{name: "not expired yet", expiresIn: time.Hour},
+		{name: "expired", expiresIn: -time.Hour, wantErr: true},
+		{name: "disabled", state: entmachine.StateDisabled, wantErr: true},
+		{name: "quarantined", state: entmachine.StateQuarantined, wantErr: true, wantRecorded: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			upd := machine.Update()
+			if tt.state != "" {
+				upd.SetState(tt.state)
+			}
+			if tt.expiresIn != 0 {
+				upd.SetExpiresAt(time.Now().Add(tt.expiresIn))
+			}
+			machine, err := upd.Save(ctx)
+			if err != nil {
+				t.Fatalf("failed to update machine: %v", err)
+			}
+
+			if err := s.checkState(ctx, machine, "GetKey"); (err != nil) != tt.wantErr {
+				t.Errorf("checkState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// Requests of quarantined machines are kept as evidence.
+			recorded := s.db.AuditEvent.Query().Where(auditevent.Type(audit.EventQuarantinedRequest)).ExistX(ctx)
+			if recorded != tt.wantRecorded {
+				t.Errorf("request recorded = %v, want %v", recorded, tt.wantRecorded)
+			}
+		})
+	}
+}
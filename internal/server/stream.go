@@ -0,0 +1,63 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"git.rgst.io/homelab/klefki/internal/chunks"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"google.golang.org/grpc"
+)
+
+// DefaultMaxKeySize is the default maximum size, in bytes, of submitted
+// keys once encrypted.
+const DefaultMaxKeySize = chunks.DefaultMaxKeySize
+
+// GetKeyStream implements the GetKeyStream RPC, which is GetKey with
+// the response sent in chunks, for keys too large for a single message.
+func (s *Server) GetKeyStream(req *pbgrpcv1.GetKeyRequest, stream grpc.ServerStreamingServer[pbgrpcv1.KeyChunk]) error {
+	resp, err := s.GetKey(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return chunks.Send(resp, stream.Send)
+}
+
+// SubmitKeyStream implements the SubmitKeyStream RPC, which is
+// SubmitKey with the request sent in chunks, for keys too large for a
+// single message.
+func (s *Server) SubmitKeyStream(stream grpc.ClientStreamingServer[pbgrpcv1.KeyChunk, pbgrpcv1.SubmitKeyResponse]) error {
+	req := &pbgrpcv1.SubmitKeyRequest{}
+	if err := chunks.Receive(stream.Recv, s.maxKeySize()+chunks.EnvelopeSize, req); err != nil {
+		return err
+	}
+
+	resp, err := s.SubmitKey(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// maxKeySize returns the maximum size of submitted keys once
+// encrypted.
+func (s *Server) maxKeySize() int {
+	if s.MaxKeySize <= 0 {
+		return DefaultMaxKeySize
+	}
+	return s.MaxKeySize
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/measurements"
+	"git.rgst.io/homelab/klefki/internal/tang"
+	"google.golang.org/grpc/peer"
+)
+
+// serveTang starts serving the Tang protocol on [Server.TangAddress] in
+// the background, generating keys for it if there are none.
+func (s *Server) serveTang(ctx context.Context) error {
+	if err := tang.EnsureKeys(ctx, s.db); err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", s.TangAddress)
+	if err != nil {
+		return fmt.Errorf("failed to create tang listener: %w", err)
+	}
+
+	s.ts = &http.Server{
+		Handler:           tang.NewHandler(s.db, s.TangAllowed, s.authorizeTang),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Printf("starting tang server on %s\n", lis.Addr())
+	go func() {
+		if err := s.ts.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("tang server failed: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+// authorizeTang implements [tang.AuthorizeFunc]. Tang recovery goes
+// through the provided machine's session like GetKey does, with the
+// same policies, maintenance windows and approvals, except that there's
+// no key for an operator to submit: unless the machine may be unlocked
+// automatically, at least one approval is required instead.
+func (s *Server) authorizeTang(ctx context.Context, machineID, peerAddr string) error {
+	machine, err := s.db.Machine.Get(ctx, machineID)
+	if err != nil {
+		return fmt.Errorf("failed to get machine %q: %w", machineID, err)
+	}
+	if machine.PendingConfirmation {
+		return fmt.Errorf("machine is awaiting confirmation by an operator")
+	}
+
+	// Policies and the audit log use the peer of gRPC requests.
+	if ip := net.ParseIP(peerAddr); ip != nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.IPAddr{IP: ip}})
+	}
+	if err := s.checkState(ctx, machine, "Tang recovery"); err != nil {
+		return err
+	}
+
+	// Tang requests aren't signed, so they can't vouch for what the
+	// machine booted or which hardware it runs on.
+	hasAllowlist, err := measurements.HasAllowlist(ctx, s.db, machine.ID)
+	if err != nil {
+		return err
+	}
+	if hasAllowlist || len(machine.Hardware) != 0 {
+		return fmt.Errorf("machine %q checks boot measurements or hardware attributes, which tang can't report", machine.ID)
+	}
+
+	d, err := s.decide(ctx, machine)
+	if err != nil {
+		return err
+	}
+
+	requiredApprovals := d.RequiredApprovals
+	if !d.AutoUnlock {
+		requiredApprovals = max(requiredApprovals, 1)
+	}
+
+	s.sesMu.Lock()
+	defer s.sesMu.Unlock()
+
+	ses := s.session(machine.ID)
+	ses.LastAsked = time.Now()
+	ses.PeerAddress = peerAddr
+	if ses.DeniedBy != nil {
+		return fmt.Errorf("boot was denied by operator %q", ses.DeniedBy.Name)
+	}
+	if approvals := len(ses.Approvals); approvals < requiredApprovals {
+		return fmt.Errorf("recovery awaiting approval (%d/%d)", approvals, requiredApprovals)
+	}
+
+	ev := &audit.Event{
+		Type:              audit.EventTangRecovery,
+		MachineID:         machine.ID,
+		MaintenanceWindow: d.MaintenanceWindow,
+		Details:           fmt.Sprintf("recovery from %s without approval", peerAddr),
+	}
+	if d.Details != "" {
+		ev.Details = fmt.Sprintf("recovery from %s %s", peerAddr, d.Details)
+	} else if len(ses.Approvals) != 0 {
+		approvers := make([]string, 0, len(ses.Approvals))
+		for _, a := range ses.Approvals {
+			approvers = append(approvers, a.Operator.Name)
+		}
+		slices.Sort(approvers)
+		ev.Details = fmt.Sprintf("recovery from %s after approval by %s", peerAddr, strings.Join(approvers, ", "))
+	}
+	if err := audit.Record(ctx, s.db, ev); err != nil {
+		return err
+	}
+
+	if err := machine.Update().SetLastUnlockedAt(time.Now()).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record unlock: %w", err)
+	}
+
+	// Reset the session
+	delete(s.ses, machine.ID)
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/hardware"
+)
+
+func TestAuthorizeTang(t *testing.T) {
+	tests := []struct {
+		name              string
+		autoUnlock        bool
+		requiredApprovals int
+		approvers         int
+		hasAllowlist      bool
+		hardware          map[string]string
+		pending           bool
+		state             entmachine.State
+		expired           bool
+		denied            bool
+		wantErr           bool
+	}{
+		{name: "no approval", wantErr: true},
+		{name: "approved", approvers: 1},
+		{name: "auto unlock", autoUnlock: true},
+		{name: "auto unlock requiring approvals", autoUnlock: true, requiredApprovals: 2, approvers: 1, wantErr: true},
+		{name: "multiple approvals", requiredApprovals: 2, approvers: 2},
+		{name: "allowlist", approvers: 1, hasAllowlist: true, wantErr: true},
+		{name: "pending confirmation", approvers: 1, pending: true, wantErr: true},
+		{name: "disabled", approvers: 1, state: entmachine.StateDisabled, wantErr: true},
+		{name: "quarantined", approvers: 1, state: entmachine.StateQuarantined, wantErr: true},
+		{name: "expired", approvers: 1, expired: true, wantErr: true},
+		{name: "denied", approvers: 1, denied: true, wantErr: true},
+		{name: "auto unlock denied", autoUnlock: true, denied: true, wantErr: true},
+		{name: "hardware bound", approvers: 1, hardware: map[string]string{hardware.BoardSerial: "abc"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestServer(t)
+			machine, _ := newTestMachine(t, s, "nas1")
+			upd := machine.Update().SetAutoUnlock(tt.autoUnlock).SetRequiredApprovals(tt.requiredApprovals).
+				SetHardware(tt.hardware).SetPendingConfirmation(tt.pending)
+			if tt.state != "" {
+				upd.SetState(tt.state)
+			}
+			if tt.expired {
+				upd.SetExpiresAt(time.Now().Add(-time.Minute))
+			}
+			if err := upd.Exec(ctx); err != nil {
+				t.Fatalf("failed to update machine: %v", err)
+			}
+			if tt.hasAllowlist {
+				if err := s.db.BootMeasurement.Create().SetMachineID(machine.ID).SetKernel("aaaa").Exec(ctx); err != nil {
+					t.Fatalf("failed to create allowlist entry: %v", err)
+				}
+			}
+			for _, name := range []string{"alice", "bob"}[:tt.approvers] {
+				o, pk := newTestOperator(t, s, name)
+				approve(t, s, machine.ID, o, pk)
+			}
+			if tt.denied {
+				o, _ := newTestOperator(t, s, "carol")
+				s.ses[machine.ID].DeniedBy = o
+			}
+
+			err := s.authorizeTang(ctx, machine.ID, "192.0.2.1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("authorizeTang() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// A recovery ends the session and is recorded, a rejected one
+			// keeps waiting.
+			_, hasSession := s.ses[machine.ID]
+			if hasSession != tt.wantErr {
+				t.Errorf("session kept = %v, want %v", hasSession, tt.wantErr)
+			}
+			recorded := s.db.AuditEvent.Query().
+				Where(auditevent.Type(audit.EventTangRecovery), auditevent.MachineID(machine.ID)).ExistX(ctx)
+			if recorded == tt.wantErr {
+				t.Errorf("recovery recorded = %v, want %v", recorded, !tt.wantErr)
+			}
+		})
+	}
+}
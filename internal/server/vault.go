@@ -0,0 +1,164 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package server
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/credhelper"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	"git.rgst.io/homelab/klefki/internal/operators"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/internal/vaultkv"
+)
+
+// GetIdentity implements the GetIdentity RPC.
+func (s *Server) GetIdentity(_ context.Context, _ *pbgrpcv1.GetIdentityRequest) (*pbgrpcv1.GetIdentityResponse, error) {
+	resp := &pbgrpcv1.GetIdentityResponse{}
+	resp.SetPublicKey(s.identity.Public().(ed25519.PublicKey))
+	return resp, nil
+}
+
+// GetVaultStatus implements the GetVaultStatus RPC.
+func (s *Server) GetVaultStatus(ctx context.Context, _ *pbgrpcv1.GetVaultStatusRequest) (*pbgrpcv1.GetVaultStatusResponse, error) {
+	status, err := s.vaultStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbgrpcv1.GetVaultStatusResponse{}
+	resp.SetStatus(status)
+	return resp, nil
+}
+
+// Unseal implements the Unseal RPC.
+func (s *Server) Unseal(ctx context.Context, req *pbgrpcv1.UnsealRequest) (*pbgrpcv1.UnsealResponse, error) {
+	operator, err := s.verifyOperator(ctx, req.GetOperatorId(), req.GetSignature(),
+		operators.ActionUnseal, operators.KeyDigest(req.GetEncKey()), req.GetNonce(), req.GetSignedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	// The master key is encrypted to the identity of the server so that
+	// it's never sent in the clear.
+	key, err := machines.Decrypt(s.identity, req.GetEncKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt master key: %w", err)
+	}
+
+	if err := s.vault.Unseal(ctx, key, s.SealAfter); err != nil {
+		return nil, fmt.Errorf("failed to unseal vault: %w", err)
+	}
+	fmt.Printf("operator %q (%s) unsealed the vault\n", operator.Name, operator.ID)
+
+	status, err := s.vaultStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbgrpcv1.UnsealResponse{}
+	resp.SetStatus(status)
+	return resp, nil
+}
+
+// Seal implements the Seal RPC.
+func (s *Server) Seal(ctx context.Context, req *pbgrpcv1.SealRequest) (*pbgrpcv1.SealResponse, error) {
+	operator, err := s.verifyOperator(ctx, req.GetOperatorId(), req.GetSignature(),
+		operators.ActionSeal, "", req.GetNonce(), req.GetSignedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	s.vault.Seal()
+	fmt.Printf("operator %q (%s) sealed the vault\n", operator.Name, operator.ID)
+
+	status, err := s.vaultStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbgrpcv1.SealResponse{}
+	resp.SetStatus(status)
+	return resp, nil
+}
+
+// vaultStatus returns the current status of the vault.
+func (s *Server) vaultStatus(ctx context.Context) (*pbgrpcv1.VaultStatus, error) {
+	initialized, err := s.db.Vault.Query().Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if vault is initialized: %w", err)
+	}
+
+	sealed, unsealedAt, sealsAt := s.vault.Status()
+
+	status := &pbgrpcv1.VaultStatus{}
+	status.SetInitialized(initialized)
+	status.SetSealed(sealed)
+	if !unsealedAt.IsZero() {
+		status.SetUnsealedAt(unsealedAt.Format(time.RFC3339Nano))
+	}
+	if !sealsAt.IsZero() {
+		status.SetSealsAt(sealsAt.Format(time.RFC3339Nano))
+	}
+	return status, nil
+}
+
+// getVaultKVKey returns the key of the provided secret of the provided
+// machine stored in Vault KV, or nil if there is none. Errors are only
+// logged, so that an unreachable Vault doesn't prevent an operator from
+// submitting the key instead.
+func (s *Server) getVaultKVKey(ctx context.Context, machine *ent.Machine, secret string) []byte {
+	key, err := s.VaultKV.Get(ctx, &vaultkv.Machine{
+		ID:          machine.ID,
+		Name:        machine.Name,
+		Fingerprint: machine.Fingerprint,
+		Secret:      secret,
+	})
+	if err != nil {
+		if !errors.Is(err, vaultkv.ErrNotFound) {
+			fmt.Printf("failed to read key of secret %q of machine %q from Vault KV: %v\n", secret, machine.ID, err)
+		}
+		return nil
+	}
+	return key
+}
+
+// getCredentialHelperKey returns the key of the provided secret of the
+// provided machine held by the credential helper, or nil if there is
+// none. Like [Server.getVaultKVKey], errors are only logged.
+func (s *Server) getCredentialHelperKey(ctx context.Context, machine *ent.Machine, secret string) []byte {
+	key, err := s.CredentialHelper.Get(ctx, &credhelper.Request{
+		MachineID:   machine.ID,
+		Name:        machine.Name,
+		Fingerprint: machine.Fingerprint,
+		Labels:      machine.Labels,
+		Secret:      secret,
+	})
+	if err != nil {
+		if !errors.Is(err, credhelper.ErrNotFound) {
+			fmt.Printf("failed to get key of secret %q of machine %q from credential helper: %v\n", secret, machine.ID, err)
+		}
+		return nil
+	}
+	return key
+}
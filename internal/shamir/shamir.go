@@ -0,0 +1,177 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package shamir implements Shamir's Secret Sharing over GF(2^8). It is
+// used to split a passphrase into shares that are held by different
+// operators and combined again on the machine.
+//
+// Every share is the same length as the secret plus one byte. The last
+// byte of a share is its x coordinate, which doubles as the index of
+// the share and is never zero.
+package shamir
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// Split splits the provided secret into n shares, of which any k can
+// be used to recover the secret through [Combine].
+func Split(secret []byte, n, k int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("cannot split an empty secret")
+	}
+	if k < 2 || k > n {
+		return nil, fmt.Errorf("threshold must be between 2 and the number of shares (%d), got %d", n, k)
+	}
+	if n > 255 {
+		return nil, fmt.Errorf("cannot split into more than 255 shares, got %d", n)
+	}
+
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][len(secret)] = byte(i + 1) //nolint:gosec // Why: Checked above.
+	}
+
+	// Each byte of the secret is the constant term of its own random
+	// polynomial of degree k-1.
+	coeffs := make([]byte, k)
+	for i, b := range secret {
+		if _, err := rand.Read(coeffs[1:]); err != nil {
+			return nil, fmt.Errorf("failed to generate polynomial: %w", err)
+		}
+		coeffs[0] = b
+
+		for _, share := range shares {
+			share[i] = evaluate(coeffs, share[len(secret)])
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine recovers a secret from shares created by [Split]. At least
+// the threshold number of shares must be provided, otherwise the
+// returned secret will be incorrect.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("at least two shares are required, got %d", len(shares))
+	}
+
+	size := len(shares[0])
+	if size < 2 {
+		return nil, fmt.Errorf("share is too short")
+	}
+
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]struct{}, len(shares))
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, fmt.Errorf("shares are not all the same length")
+		}
+
+		x := share[size-1]
+		if x == 0 {
+			return nil, fmt.Errorf("share has invalid index 0")
+		}
+		if _, ok := seen[x]; ok {
+			return nil, fmt.Errorf("duplicate share with index %d", x)
+		}
+		seen[x] = struct{}{}
+		xs[i] = x
+	}
+
+	secret := make([]byte, size-1)
+	ys := make([]byte, len(shares))
+	for i := range secret {
+		for j, share := range shares {
+			ys[j] = share[i]
+		}
+		secret[i] = interpolate(xs, ys)
+	}
+
+	return secret, nil
+}
+
+// Index returns the index of the provided share.
+func Index(share []byte) (int, error) {
+	if len(share) < 2 || share[len(share)-1] == 0 {
+		return 0, fmt.Errorf("invalid share")
+	}
+	return int(share[len(share)-1]), nil
+}
+
+// evaluate returns the value of the polynomial with the provided
+// coefficients at x.
+func evaluate(coeffs []byte, x byte) byte {
+	var y byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = add(mul(y, x), coeffs[i])
+	}
+	return y
+}
+
+// interpolate returns the value at x=0 of the polynomial going through
+// the provided points using Lagrange interpolation.
+func interpolate(xs, ys []byte) byte {
+	var result byte
+	for i := range xs {
+		basis := byte(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			basis = mul(basis, div(xs[j], add(xs[i], xs[j])))
+		}
+		result = add(result, mul(ys[i], basis))
+	}
+	return result
+}
+
+// add adds two numbers in GF(2^8).
+func add(a, b byte) byte {
+	return a ^ b
+}
+
+// mul multiplies two numbers in GF(2^8) using the AES polynomial. This
+// runs in constant time regardless of the inputs.
+func mul(a, b byte) byte {
+	var p byte
+	for range 8 {
+		p ^= a & -(b & 1)
+		hi := a >> 7
+		a = (a << 1) ^ (0x1b & -hi)
+		b >>= 1
+	}
+	return p
+}
+
+// div divides a by b in GF(2^8). b must not be zero.
+func div(a, b byte) byte {
+	return mul(a, inverse(b))
+}
+
+// inverse returns the multiplicative inverse of a in GF(2^8), computed
+// as a^254.
+func inverse(a byte) byte {
+	result := byte(1)
+	for range 254 {
+		result = mul(result, a)
+	}
+	return result
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package shamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSplitCombine(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		n, k   int
+	}{
+		{name: "single byte", secret: []byte{0x42}, n: 2, k: 2},
+		{name: "passphrase", secret: []byte("correct horse battery staple"), n: 3, k: 2},
+		{name: "zero bytes", secret: make([]byte, 16), n: 5, k: 3},
+		{name: "all shares required", secret: []byte("hunter2"), n: 4, k: 4},
+		{name: "maximum shares", secret: []byte("hunter2"), n: 255, k: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := Split(tt.secret, tt.n, tt.k)
+			if err != nil {
+				t.Fatalf("Split() error = %v", err)
+			}
+			if len(shares) != tt.n {
+				t.Fatalf("Split() returned %d shares, want %d", len(shares), tt.n)
+			}
+
+			for i, share := range shares {
+				if len(share) != len(tt.secret)+1 {
+					t.Errorf("share %d is %d bytes, want %d", i, len(share), len(tt.secret)+1)
+				}
+				if index, err := Index(share); err != nil || index != i+1 {
+					t.Errorf("Index(share %d) = %d, %v, want %d", i, index, err, i+1)
+				}
+			}
+
+			// Any k shares recover the secret, as do all of them.
+			for name, subset := range map[string][][]byte{
+				"first k": shares[:tt.k],
+				"last k":  shares[tt.n-tt.k:],
+				"all":     shares,
+			} {
+				secret, err := Combine(subset)
+				if err != nil {
+					t.Fatalf("Combine(%s) error = %v", name, err)
+				}
+				if !bytes.Equal(secret, tt.secret) {
+					t.Errorf("Combine(%s) = %x, want %x", name, secret, tt.secret)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		n, k   int
+	}{
+		{name: "empty secret", secret: nil, n: 3, k: 2},
+		{name: "threshold of one", secret: []byte("x"), n: 3, k: 1},
+		{name: "threshold above shares", secret: []byte("x"), n: 2, k: 3},
+		{name: "too many shares", secret: []byte("x"), n: 256, k: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Split(tt.secret, tt.n, tt.k); err == nil {
+				t.Errorf("Split(%d, %d) error = nil, want an error", tt.n, tt.k)
+			}
+		})
+	}
+}
+
+func TestCombineErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		shares [][]byte
+	}{
+		{name: "no shares", shares: nil},
+		{name: "single share", shares: [][]byte{{0x01, 0x01}}},
+		{name: "too short", shares: [][]byte{{0x01}, {0x02}}},
+		{name: "different lengths", shares: [][]byte{{0x01, 0x01}, {0x01, 0x02, 0x02}}},
+		{name: "index zero", shares: [][]byte{{0x01, 0x01}, {0x02, 0x00}}},
+		{name: "duplicate index", shares: [][]byte{{0x01, 0x01}, {0x02, 0x01}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Combine(tt.shares); err == nil {
+				t.Error("Combine() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestCombineBelowThreshold(t *testing.T) {
+	secret := []byte("correct horse battery staple")
+	shares, err := Split(secret, 5, 3)
+	if err != nil {
+		t.Fatalf("Split() error = %v", err)
+	}
+
+	got, err := Combine(shares[:2])
+	if err != nil {
+		t.Fatalf("Combine() error = %v", err)
+	}
+	if bytes.Equal(got, secret) {
+		t.Error("Combine() recovered the secret from fewer shares than the threshold")
+	}
+}
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		share   []byte
+		want    int
+		wantErr bool
+	}{
+		{name: "first", share: []byte{0xaa, 0x01}, want: 1},
+		{name: "last", share: []byte{0xaa, 0xbb, 0xff}, want: 255},
+		{name: "index zero", share: []byte{0xaa, 0x00}, wantErr: true},
+		{name: "too short", share: []byte{0x01}, wantErr: true},
+		{name: "empty", share: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Index(tt.share)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Index() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Index() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldArithmetic(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		x := byte(a)
+		if got := mul(x, inverse(x)); got != 1 {
+			t.Fatalf("mul(%#x, inverse(%#x)) = %#x, want 1", x, x, got)
+		}
+		if got := div(mul(x, 0x53), 0x53); got != x {
+			t.Fatalf("div(mul(%#x, 0x53), 0x53) = %#x, want %#x", x, got, x)
+		}
+	}
+}
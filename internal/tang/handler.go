@@ -0,0 +1,234 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package tang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	entmachine "git.rgst.io/homelab/klefki/internal/db/ent/machine"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+)
+
+// maxRequestSize is the maximum size of a recovery request body.
+const maxRequestSize = 4096
+
+// AuthorizeFunc decides if the provided machine may recover its key,
+// for a request from the provided IP address, returning an error if
+// not. It's called once the response is ready, so it must record the
+// recovery in the audit log before allowing it.
+type AuthorizeFunc func(ctx context.Context, machineID, peer string) error
+
+// Handler is an [http.Handler] serving the Tang protocol from the keys
+// in a DB. Each machine has its own exchange key, served under
+// /machines/<id>, so that recovery can be authorized per machine.
+type Handler struct {
+	db  *ent.Client
+	mux *http.ServeMux
+
+	// allowed are the networks recovery requests are allowed from. If
+	// empty, all are allowed.
+	allowed []*net.IPNet
+
+	// authorize authorizes recovery requests.
+	authorize AuthorizeFunc
+}
+
+// NewHandler creates a new [Handler] serving the keys in the provided
+// DB. Recovery requests are only allowed from the provided networks,
+// or from anywhere if none are provided, and if authorized by the
+// provided function.
+func NewHandler(db *ent.Client, allowed []*net.IPNet, authorize AuthorizeFunc) *Handler {
+	h := &Handler{db: db, mux: http.NewServeMux(), allowed: allowed, authorize: authorize}
+	h.mux.HandleFunc("GET /machines/{machine}/adv", h.advertise)
+	h.mux.HandleFunc("GET /machines/{machine}/adv/", h.advertise)
+	h.mux.HandleFunc("GET /machines/{machine}/adv/{kid}", h.advertise)
+	h.mux.HandleFunc("POST /machines/{machine}/rec/{kid}", h.recover)
+	return h
+}
+
+// ServeHTTP implements [http.Handler].
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// advertise serves the advertisement of the signing keys and the
+// exchange key of the provided machine, signed by every advertised
+// signing key or, if a key ID is provided, by that signing key.
+func (h *Handler) advertise(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	machineID := r.PathValue("machine")
+
+	exists, err := h.db.Machine.Query().Where(entmachine.ID(machineID)).Exist(ctx)
+	if err != nil {
+		h.error(w, http.StatusInternalServerError, fmt.Errorf("failed to get machine: %w", err))
+		return
+	}
+	if !exists {
+		h.error(w, http.StatusNotFound, fmt.Errorf("machine %q not found", machineID))
+		return
+	}
+
+	if err := EnsureExchangeKey(ctx, h.db, machineID); err != nil {
+		h.error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	keys, err := h.machineKeys(ctx, machineID)
+	if err != nil {
+		h.error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	kid := r.PathValue("kid")
+	var signers []*Key
+	for _, k := range keys {
+		if k.Use != tangkey.UseSign {
+			continue
+		}
+		if (kid == "" && k.Advertised) || (kid != "" && k.HasThumbprint(kid)) {
+			signers = append(signers, k)
+		}
+	}
+	if len(signers) == 0 {
+		h.error(w, http.StatusNotFound, fmt.Errorf("no signing key found"))
+		return
+	}
+
+	adv, err := Advertisement(keys, signers)
+	if err != nil {
+		h.error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/jose+json")
+	w.Write(adv) //nolint:errcheck // Why: Nothing to do if the client went away.
+}
+
+// recover performs key exchange with the exchange key with the provided
+// ID of the provided machine.
+func (h *Handler) recover(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	machineID, kid := r.PathValue("machine"), r.PathValue("kid")
+
+	peer, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peer = r.RemoteAddr
+	}
+
+	if !h.isAllowed(peer) {
+		if err := audit.Record(ctx, h.db, &audit.Event{
+			Type:      audit.EventTangDenied,
+			MachineID: machineID,
+			Details:   fmt.Sprintf("denied recovery with key %s from %s", kid, peer),
+		}); err != nil {
+			h.error(w, http.StatusInternalServerError, err)
+			return
+		}
+		h.error(w, http.StatusForbidden, fmt.Errorf("recovery from %s is not allowed", peer))
+		return
+	}
+
+	keys, err := h.machineKeys(ctx, machineID)
+	if err != nil {
+		h.error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	var key *Key
+	for _, k := range keys {
+		if k.Use == tangkey.UseExchange && k.HasThumbprint(kid) {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		h.error(w, http.StatusNotFound, fmt.Errorf("exchange key %q of machine %q not found", kid, machineID))
+		return
+	}
+
+	var req JWK
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req); err != nil {
+		h.error(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+		return
+	}
+
+	resp, err := Recover(key, &req)
+	if err != nil {
+		h.error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Only authorized once the response is ready, as authorizing ends
+	// the machine's session.
+	if err := h.authorize(ctx, machineID, peer); err != nil {
+		h.error(w, http.StatusForbidden, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/jwk+json")
+	json.NewEncoder(w).Encode(resp) //nolint:errcheck // Why: Nothing to do if the client went away.
+}
+
+// machineKeys returns the signing keys and the exchange keys of the
+// provided machine.
+func (h *Handler) machineKeys(ctx context.Context, machineID string) ([]*Key, error) {
+	keys, err := LoadKeys(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+
+	machineKeys := make([]*Key, 0, len(keys))
+	for _, k := range keys {
+		if k.Use == tangkey.UseSign || k.MachineID == machineID {
+			machineKeys = append(machineKeys, k)
+		}
+	}
+	return machineKeys, nil
+}
+
+// isAllowed returns if recovery requests are allowed from the provided
+// IP address.
+func (h *Handler) isAllowed(peer string) bool {
+	if len(h.allowed) == 0 {
+		return true
+	}
+
+	ip := net.ParseIP(peer)
+	if ip == nil {
+		return false
+	}
+	for _, n := range h.allowed {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// error logs and responds with the provided error.
+func (h *Handler) error(w http.ResponseWriter, code int, err error) {
+	fmt.Printf("tang: %v\n", err)
+	http.Error(w, http.StatusText(code), code)
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package tang
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"git.rgst.io/homelab/klefki/internal/audit"
+	"git.rgst.io/homelab/klefki/internal/db/ent/auditevent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+)
+
+// exchangeKey returns the advertised exchange key of the provided
+// machine.
+func exchangeKey(t *testing.T, h *Handler, machineID string) *Key {
+	t.Helper()
+
+	keys, err := h.machineKeys(context.Background(), machineID)
+	if err != nil {
+		t.Fatalf("failed to load keys: %v", err)
+	}
+	for _, k := range keys {
+		if k.Use == tangkey.UseExchange && k.Advertised {
+			return k
+		}
+	}
+	t.Fatalf("machine %q has no exchange key", machineID)
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+	_, allowed, err := net.ParseCIDR("192.0.2.0/24")
+	if err != nil {
+		t.Fatalf("failed to parse CIDR: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		machine      string
+		keyOf        string
+		remoteAddr   string
+		authorizeErr error
+		wantCode     int
+		wantAuthz    bool
+	}{
+		{name: "authorized", machine: "nas1", keyOf: "nas1", wantCode: http.StatusOK, wantAuthz: true},
+		{
+			name:         "not authorized",
+			machine:      "nas1",
+			keyOf:        "nas1",
+			authorizeErr: errors.New("recovery awaiting approval (0/1)"),
+			wantCode:     http.StatusForbidden,
+			wantAuthz:    true,
+		},
+		{name: "other machine's key", machine: "nas1", keyOf: "nas2", wantCode: http.StatusNotFound},
+		{name: "not allowed", machine: "nas1", keyOf: "nas1", remoteAddr: "198.51.100.1:1234", wantCode: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := newTestDB(t)
+			for _, name := range []string{"nas1", "nas2"} {
+				if err := db.Machine.Create().SetID(name).SetName(name).SetPublicKey([]byte(name)).Exec(ctx); err != nil {
+					t.Fatalf("failed to create machine: %v", err)
+				}
+			}
+			if err := EnsureKeys(ctx, db); err != nil {
+				t.Fatalf("EnsureKeys() error = %v", err)
+			}
+
+			var authorized []string
+			h := NewHandler(db, []*net.IPNet{allowed}, func(_ context.Context, machineID, peer string) error {
+				authorized = append(authorized, machineID+"@"+peer)
+				return tt.authorizeErr
+			})
+
+			// Fetching the advertisement generates the machine's exchange
+			// key.
+			for _, name := range []string{"nas1", "nas2"} {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/machines/"+name+"/adv", http.NoBody))
+				if rec.Code != http.StatusOK {
+					t.Fatalf("GET /machines/%s/adv = %d, want %d", name, rec.Code, http.StatusOK)
+				}
+			}
+
+			client := newTestKey(t, tangkey.UseExchange)
+			body, err := json.Marshal(newJWK(client.Private.X, client.Private.Y, AlgExchange))
+			if err != nil {
+				t.Fatalf("failed to encode request: %v", err)
+			}
+			kid := exchangeKey(t, h, tt.keyOf).JWK().Thumbprint(crypto.SHA256)
+			req := httptest.NewRequest(http.MethodPost, "/machines/"+tt.machine+"/rec/"+kid, bytes.NewReader(body))
+			if tt.remoteAddr != "" {
+				req.RemoteAddr = tt.remoteAddr
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("POST /rec = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if got := len(authorized) != 0; got != tt.wantAuthz {
+				t.Errorf("authorized = %v, want called %v", authorized, tt.wantAuthz)
+			}
+			if tt.wantAuthz && authorized[0] != tt.machine+"@192.0.2.1" {
+				t.Errorf("authorized %q, want %q", authorized[0], tt.machine+"@192.0.2.1")
+			}
+
+			// Nothing may be released without authorization.
+			if rec.Code != http.StatusOK && rec.Header().Get("Content-Type") == "application/jwk+json" {
+				t.Error("response contains a key")
+			}
+
+			denied := db.AuditEvent.Query().Where(auditevent.Type(audit.EventTangDenied)).ExistX(ctx)
+			if want := tt.remoteAddr != ""; denied != want {
+				t.Errorf("denial recorded = %v, want %v", denied, want)
+			}
+		})
+	}
+}
+
+func TestHandlerUnknownMachine(t *testing.T) {
+	db := newTestDB(t)
+	if err := EnsureKeys(context.Background(), db); err != nil {
+		t.Fatalf("EnsureKeys() error = %v", err)
+	}
+	h := NewHandler(db, nil, func(context.Context, string, string) error { return nil })
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/machines/nas1/adv", http.NoBody))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GET /machines/nas1/adv = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if n := db.TangKey.Query().Where(tangkey.UseEQ(tangkey.UseExchange)).CountX(context.Background()); n != 0 {
+		t.Errorf("exchange keys = %d, want 0", n)
+	}
+}
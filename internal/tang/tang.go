@@ -0,0 +1,294 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package tang implements the server side of the Tang protocol, so
+// that clevis clients using the tang pin can use klefki.
+//
+// See https://github.com/latchset/tang for the protocol.
+package tang
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	_ "crypto/sha1" //nolint:gosec // Why: Clients may identify keys by SHA-1 thumbprints.
+	_ "crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+)
+
+// Algorithms of the keys used by the Tang protocol.
+const (
+	// AlgSign is the algorithm of signing keys.
+	AlgSign = "ES512"
+
+	// AlgExchange is the algorithm of exchange keys, used for
+	// McCallum-Relyea key exchange.
+	AlgExchange = "ECMR"
+)
+
+// coordSize is the size in bytes of the coordinates of a P-521 point.
+const coordSize = 66
+
+// JWK is a P-521 public key as a JSON Web Key.
+type JWK struct {
+	Alg    string   `json:"alg,omitempty"`
+	Crv    string   `json:"crv"`
+	KeyOps []string `json:"key_ops,omitempty"`
+	Kty    string   `json:"kty"`
+	X      string   `json:"x"`
+	Y      string   `json:"y"`
+}
+
+// newJWK creates a [JWK] for the provided point.
+func newJWK(x, y *big.Int, alg string, keyOps ...string) *JWK {
+	return &JWK{
+		Alg:    alg,
+		Crv:    "P-521",
+		KeyOps: keyOps,
+		Kty:    "EC",
+		X:      base64.RawURLEncoding.EncodeToString(x.FillBytes(make([]byte, coordSize))),
+		Y:      base64.RawURLEncoding.EncodeToString(y.FillBytes(make([]byte, coordSize))),
+	}
+}
+
+// Point returns the point of the JWK, returning an error if it isn't a
+// valid P-521 point.
+func (j *JWK) Point() (x, y *big.Int, err error) {
+	if j.Kty != "EC" || j.Crv != "P-521" {
+		return nil, nil, fmt.Errorf("unsupported key type %s/%s", j.Kty, j.Crv)
+	}
+
+	xb, err := base64.RawURLEncoding.DecodeString(j.X)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode x: %w", err)
+	}
+	yb, err := base64.RawURLEncoding.DecodeString(j.Y)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode y: %w", err)
+	}
+
+	x, y = new(big.Int).SetBytes(xb), new(big.Int).SetBytes(yb)
+	if !elliptic.P521().IsOnCurve(x, y) { //nolint:staticcheck // Why: Raw point math is required by the protocol.
+		return nil, nil, fmt.Errorf("point is not on P-521")
+	}
+	return x, y, nil
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of the JWK using the
+// provided hash.
+func (j *JWK) Thumbprint(h crypto.Hash) string {
+	// Members must be in lexicographic order without whitespace.
+	canonical := fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, j.Crv, j.Kty, j.X, j.Y)
+
+	hasher := h.New()
+	hasher.Write([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(hasher.Sum(nil))
+}
+
+// Key is a key used by the Tang protocol.
+type Key struct {
+	*ent.TangKey
+
+	// Private is the decoded private key.
+	Private *ecdsa.PrivateKey
+}
+
+// JWK returns the public key of the key as a [JWK], with the key
+// operations clients expect for its use.
+func (k *Key) JWK() *JWK {
+	if k.Use == tangkey.UseSign {
+		return newJWK(k.Private.X, k.Private.Y, AlgSign, "verify")
+	}
+	return newJWK(k.Private.X, k.Private.Y, AlgExchange, "deriveKey")
+}
+
+// HasThumbprint returns if the provided thumbprint, using any of the
+// hashes supported by clients, identifies the key.
+func (k *Key) HasThumbprint(thp string) bool {
+	jwk := k.JWK()
+	return jwk.Thumbprint(crypto.SHA256) == thp || jwk.Thumbprint(crypto.SHA1) == thp
+}
+
+// LoadKeys returns all of the Tang keys in the provided DB.
+func LoadKeys(ctx context.Context, db *ent.Client) ([]*Key, error) {
+	tks, err := db.TangKey.Query().Order(ent.Asc(tangkey.FieldID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tang keys: %w", err)
+	}
+
+	keys := make([]*Key, 0, len(tks))
+	for _, tk := range tks {
+		pk, err := x509.ParsePKCS8PrivateKey(tk.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tang key %d: %w", tk.ID, err)
+		}
+
+		ecpk, ok := pk.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("tang key %d is not an ECDSA key", tk.ID)
+		}
+		keys = append(keys, &Key{TangKey: tk, Private: ecpk})
+	}
+	return keys, nil
+}
+
+// generateKey generates and stores a new advertised key with the
+// provided use, for the provided machine if it's an exchange key.
+func generateKey(ctx context.Context, db *ent.Client, use tangkey.Use, machineID string) error {
+	pk, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate %s key: %w", use, err)
+	}
+
+	b, err := x509.MarshalPKCS8PrivateKey(pk)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s key: %w", use, err)
+	}
+
+	if err := db.TangKey.Create().SetUse(use).SetMachineID(machineID).SetPrivateKey(b).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store %s key: %w", use, err)
+	}
+	return nil
+}
+
+// Rotate generates a new signing key to advertise. The keys that were
+// advertised before stop being advertised, but can still be used for
+// recovery until they are deleted. New exchange keys are generated
+// through [EnsureExchangeKey] when machines next fetch the
+// advertisement.
+func Rotate(ctx context.Context, db *ent.Client) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // Why: No-op after commit.
+
+	if err := tx.TangKey.Update().SetAdvertised(false).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to stop advertising keys: %w", err)
+	}
+	if err := generateKey(ctx, tx.Client(), tangkey.UseSign, ""); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// EnsureKeys generates a signing key to advertise through [Rotate] if
+// there is none.
+func EnsureKeys(ctx context.Context, db *ent.Client) error {
+	exists, err := db.TangKey.Query().Where(tangkey.UseEQ(tangkey.UseSign), tangkey.Advertised(true)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for tang keys: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	return Rotate(ctx, db)
+}
+
+// EnsureExchangeKey generates an exchange key to advertise for the
+// provided machine if it has none.
+func EnsureExchangeKey(ctx context.Context, db *ent.Client, machineID string) error {
+	exists, err := db.TangKey.Query().
+		Where(tangkey.UseEQ(tangkey.UseExchange), tangkey.MachineID(machineID), tangkey.Advertised(true)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for tang keys: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	return generateKey(ctx, db, tangkey.UseExchange, machineID)
+}
+
+// jws is a JWS in the general JSON serialization.
+type jws struct {
+	Payload    string         `json:"payload"`
+	Signatures []jwsSignature `json:"signatures"`
+}
+
+// jwsSignature is a signature of a [jws].
+type jwsSignature struct {
+	Protected string `json:"protected"`
+	Signature string `json:"signature"`
+}
+
+// Advertisement returns the advertisement of the provided keys: a JWS
+// of the advertised public keys signed by the provided signing keys.
+func Advertisement(keys, signers []*Key) ([]byte, error) {
+	jwks := struct {
+		Keys []*JWK `json:"keys"`
+	}{Keys: []*JWK{}}
+	for _, k := range keys {
+		if k.Advertised {
+			jwks.Keys = append(jwks.Keys, k.JWK())
+		}
+	}
+
+	payload, err := json.Marshal(jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode advertisement: %w", err)
+	}
+
+	protected := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES512","cty":"jwk-set+json"}`))
+	out := &jws{Payload: base64.RawURLEncoding.EncodeToString(payload)}
+	for _, signer := range signers {
+		digest := sha512.Sum512([]byte(protected + "." + out.Payload))
+		r, s, err := ecdsa.Sign(rand.Reader, signer.Private, digest[:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign advertisement: %w", err)
+		}
+
+		sig := append(r.FillBytes(make([]byte, coordSize)), s.FillBytes(make([]byte, coordSize))...)
+		out.Signatures = append(out.Signatures, jwsSignature{
+			Protected: protected,
+			Signature: base64.RawURLEncoding.EncodeToString(sig),
+		})
+	}
+
+	return json.Marshal(out)
+}
+
+// Recover performs the server side of McCallum-Relyea key exchange,
+// multiplying the point provided by the client with the exchange key.
+func Recover(k *Key, req *JWK) (*JWK, error) {
+	if k.Use != tangkey.UseExchange {
+		return nil, fmt.Errorf("key is not an exchange key")
+	}
+	if req.Alg != "" && req.Alg != AlgExchange {
+		return nil, fmt.Errorf("unsupported algorithm %q", req.Alg)
+	}
+
+	x, y, err := req.Point()
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:staticcheck // Why: Raw point math is required by the protocol.
+	rx, ry := elliptic.P521().ScalarMult(x, y, k.Private.D.Bytes())
+	return newJWK(rx, ry, AlgExchange, "deriveKey"), nil
+}
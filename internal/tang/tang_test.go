@@ -0,0 +1,236 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package tang
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	"git.rgst.io/homelab/klefki/internal/db/ent/tangkey"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// newTestDB returns a new empty DB.
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newTestKey returns a new key with the provided use, which isn't
+// stored anywhere.
+func newTestKey(t *testing.T, use tangkey.Use) *Key {
+	t.Helper()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return &Key{TangKey: &ent.TangKey{Use: use, Advertised: true}, Private: pk}
+}
+
+// scalarMult and add are the point math a clevis client does.
+func scalarMult(x, y *big.Int, k []byte) (*big.Int, *big.Int) {
+	return elliptic.P521().ScalarMult(x, y, k) //nolint:staticcheck // Why: Raw point math is required by the protocol.
+}
+
+func add(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	return elliptic.P521().Add(x1, y1, x2, y2) //nolint:staticcheck // Why: Raw point math is required by the protocol.
+}
+
+func TestRecover(t *testing.T) {
+	server := newTestKey(t, tangkey.UseExchange)
+	sx, sy, err := server.JWK().Point()
+	if err != nil {
+		t.Fatalf("failed to decode advertised key: %v", err)
+	}
+
+	// Binding: the client derives its key from a point only it knows,
+	// multiplied with the server's key.
+	client := newTestKey(t, tangkey.UseExchange)
+	kx, ky := scalarMult(sx, sy, client.Private.D.Bytes())
+
+	// Recovery: the client blinds its point with an ephemeral one, and
+	// removes it from the response.
+	eph := newTestKey(t, tangkey.UseExchange)
+	x, y := add(client.Private.X, client.Private.Y, eph.Private.X, eph.Private.Y)
+	resp, err := Recover(server, newJWK(x, y, AlgExchange))
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+
+	rx, ry, err := resp.Point()
+	if err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	ex, ey := scalarMult(sx, sy, eph.Private.D.Bytes())
+	gotX, gotY := add(rx, ry, ex, new(big.Int).Sub(elliptic.P521().Params().P, ey))
+	if gotX.Cmp(kx) != 0 || gotY.Cmp(ky) != 0 {
+		t.Error("recovered key doesn't match the bound one")
+	}
+}
+
+func TestRecoverRejects(t *testing.T) {
+	exchange := newTestKey(t, tangkey.UseExchange)
+	point := newJWK(exchange.Private.X, exchange.Private.Y, AlgExchange)
+
+	tests := []struct {
+		name string
+		key  *Key
+		req  *JWK
+	}{
+		{name: "signing key", key: newTestKey(t, tangkey.UseSign), req: point},
+		{name: "other algorithm", key: exchange, req: &JWK{Alg: "ECDH-ES", Crv: point.Crv, Kty: point.Kty, X: point.X, Y: point.Y}},
+		{name: "not on curve", key: exchange, req: &JWK{Crv: point.Crv, Kty: point.Kty, X: point.X, Y: point.X}},
+		{name: "other curve", key: exchange, req: &JWK{Crv: "P-256", Kty: point.Kty, X: point.X, Y: point.Y}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Recover(tt.key, tt.req); err == nil {
+				t.Error("Recover() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestAdvertisement(t *testing.T) {
+	signer := newTestKey(t, tangkey.UseSign)
+	exchange := newTestKey(t, tangkey.UseExchange)
+	rotated := newTestKey(t, tangkey.UseExchange)
+	rotated.Advertised = false
+
+	b, err := Advertisement([]*Key{signer, exchange, rotated}, []*Key{signer})
+	if err != nil {
+		t.Fatalf("Advertisement() error = %v", err)
+	}
+
+	var adv jws
+	if err := json.Unmarshal(b, &adv); err != nil {
+		t.Fatalf("failed to decode advertisement: %v", err)
+	}
+	if len(adv.Signatures) != 1 {
+		t.Fatalf("signatures = %d, want 1", len(adv.Signatures))
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(adv.Signatures[0].Signature)
+	if err != nil || len(sig) != 2*coordSize {
+		t.Fatalf("invalid signature %q: %v", adv.Signatures[0].Signature, err)
+	}
+	digest := sha512.Sum512([]byte(adv.Signatures[0].Protected + "." + adv.Payload))
+	r, s := new(big.Int).SetBytes(sig[:coordSize]), new(big.Int).SetBytes(sig[coordSize:])
+	if !ecdsa.Verify(&signer.Private.PublicKey, digest[:], r, s) {
+		t.Error("advertisement signature doesn't verify")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(adv.Payload)
+	if err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	var jwks struct {
+		Keys []*JWK `json:"keys"`
+	}
+	if err := json.Unmarshal(payload, &jwks); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+
+	// Rotated keys are only used for recovery.
+	got := make(map[string]bool)
+	for _, k := range jwks.Keys {
+		got[k.Thumbprint(crypto.SHA256)] = true
+	}
+	for _, k := range []*Key{signer, exchange} {
+		if !got[k.JWK().Thumbprint(crypto.SHA256)] {
+			t.Errorf("advertisement is missing %s key", k.Use)
+		}
+	}
+	if got[rotated.JWK().Thumbprint(crypto.SHA256)] || len(jwks.Keys) != 2 {
+		t.Errorf("advertisement has %d keys, want only the advertised ones", len(jwks.Keys))
+	}
+}
+
+func TestThumbprint(t *testing.T) {
+	k := newTestKey(t, tangkey.UseExchange)
+	for _, h := range []crypto.Hash{crypto.SHA256, crypto.SHA1} {
+		if !k.HasThumbprint(k.JWK().Thumbprint(h)) {
+			t.Errorf("HasThumbprint() = false for the %s thumbprint", h)
+		}
+	}
+	if k.HasThumbprint(newTestKey(t, tangkey.UseExchange).JWK().Thumbprint(crypto.SHA256)) {
+		t.Error("HasThumbprint() = true for another key")
+	}
+}
+
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	if err := EnsureKeys(ctx, db); err != nil {
+		t.Fatalf("EnsureKeys() error = %v", err)
+	}
+	if err := EnsureExchangeKey(ctx, db, "nas1"); err != nil {
+		t.Fatalf("EnsureExchangeKey() error = %v", err)
+	}
+
+	// Ensuring keys again doesn't generate new ones.
+	if err := EnsureKeys(ctx, db); err != nil {
+		t.Fatalf("EnsureKeys() error = %v", err)
+	}
+	if err := EnsureExchangeKey(ctx, db, "nas1"); err != nil {
+		t.Fatalf("EnsureExchangeKey() error = %v", err)
+	}
+	if n := db.TangKey.Query().CountX(ctx); n != 2 {
+		t.Fatalf("keys = %d, want 2", n)
+	}
+
+	if err := Rotate(ctx, db); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	if err := EnsureExchangeKey(ctx, db, "nas1"); err != nil {
+		t.Fatalf("EnsureExchangeKey() error = %v", err)
+	}
+
+	keys, err := LoadKeys(ctx, db)
+	if err != nil {
+		t.Fatalf("LoadKeys() error = %v", err)
+	}
+	advertised := make(map[tangkey.Use]int)
+	for _, k := range keys {
+		if k.Advertised {
+			advertised[k.Use]++
+		}
+	}
+	if len(keys) != 4 || advertised[tangkey.UseSign] != 1 || advertised[tangkey.UseExchange] != 1 {
+		t.Errorf("keys = %d, advertised = %v, want 4 keys with one of each use advertised", len(keys), advertised)
+	}
+}
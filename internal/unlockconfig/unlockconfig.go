@@ -0,0 +1,91 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package unlockconfig contains the config of klefki-unlock, shared
+// with klefkictl which writes it to initramfs bundles.
+package unlockconfig
+
+// DefaultPath is the path the config is read from when none is
+// provided.
+const DefaultPath = "/etc/klefki/unlock.json"
+
+// Config is the configuration of klefki-unlock.
+type Config struct {
+	// Servers are the klefki servers to request the key from.
+	Servers []Server `json:"servers"`
+
+	// Threshold is the number of key shares required from Servers to
+	// rebuild the key, if the key is split across them. Otherwise,
+	// Servers are asked in turn until one releases the key.
+	Threshold int `json:"threshold,omitempty"`
+
+	// Key is the path to the machine's private key.
+	Key string `json:"key,omitempty"`
+
+	// Secret is the name of the secret of the machine to request, if
+	// not its default one.
+	Secret string `json:"secret,omitempty"`
+
+	// Volumes maps LUKS volumes, by crypttab name or source device (e.g.,
+	// /dev/sdb1), to the name of the secret of the machine unlocking
+	// them. Other volumes are unlocked with Secret.
+	Volumes map[string]string `json:"volumes,omitempty"`
+
+	// Timeout is how long to keep asking for the key before giving up,
+	// as a Go duration (e.g., 10m). Asks forever if empty.
+	Timeout string `json:"timeout,omitempty"`
+
+	// MinBackoff and MaxBackoff bound the time waited between attempts,
+	// as Go durations. Default to 1s and 30s.
+	MinBackoff string `json:"min_backoff,omitempty"`
+	MaxBackoff string `json:"max_backoff,omitempty"`
+
+	// ReportHardware reports the hardware attributes of this machine
+	// with every request.
+	ReportHardware bool `json:"report_hardware,omitempty"`
+
+	// MeasureKernel and MeasureInitramfs are the paths of the booted
+	// kernel and initramfs to report measurements of, if set.
+	MeasureKernel    string `json:"measure_kernel,omitempty"`
+	MeasureInitramfs string `json:"measure_initramfs,omitempty"`
+
+	// MeasureCmdline reports a measurement of the kernel command line.
+	MeasureCmdline bool `json:"measure_cmdline,omitempty"`
+
+	// Keyring is the kernel keyring (user or session) to add the key
+	// to instead of printing it, if set. Not used by the agent.
+	Keyring string `json:"keyring,omitempty"`
+
+	// KeyDescription is the description of the key added to Keyring.
+	// Defaults to the name systemd uses for cached passphrases.
+	KeyDescription string `json:"key_description,omitempty"`
+
+	// KeyTimeout is how long the key added to Keyring is kept, as a Go
+	// duration. Defaults to 150s, 0 keeps it.
+	KeyTimeout string `json:"key_timeout,omitempty"`
+}
+
+// Server is a klefki server to request the key from.
+type Server struct {
+	// Address is the address of the server (e.g., 10.0.0.1:5300).
+	Address string `json:"address"`
+
+	// Identity is the base64 encoded public key of the server, as
+	// printed by klefkictl requests identity. Responses not signed by
+	// it are rejected. Not verified if empty.
+	Identity string `json:"identity,omitempty"`
+}
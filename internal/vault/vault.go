@@ -0,0 +1,268 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package vault implements the sealed vault, which stores the
+// passphrases of machines encrypted under a master key. The master key
+// is never stored, instead it is only held in memory while the vault
+// is unsealed by an operator.
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+)
+
+// KeySize is the size, in bytes, of the master key.
+const KeySize = 32
+
+var (
+	// ErrSealed is returned when the vault is sealed.
+	ErrSealed = errors.New("vault is sealed")
+
+	// ErrNotInitialized is returned when the vault has not been
+	// initialized yet.
+	ErrNotInitialized = errors.New("vault is not initialized")
+
+	// ErrNotFound is returned when no key is stored for a machine.
+	ErrNotFound = errors.New("no key stored for machine")
+)
+
+// Vault provides access to the keys stored in the DB while it is
+// unsealed.
+type Vault struct {
+	db *ent.Client
+
+	mu         sync.RWMutex
+	key        []byte
+	unsealedAt time.Time
+	sealsAt    time.Time
+	sealTimer  *time.Timer
+}
+
+// New creates a new, sealed, [Vault] backed by the provided DB.
+func New(db *ent.Client) *Vault {
+	return &Vault{db: db}
+}
+
+// GenerateKey generates a new master key.
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate master key: %w", err)
+	}
+	return key, nil
+}
+
+// Init initializes the vault in the provided DB to use the provided
+// master key. This can only be done once.
+func Init(ctx context.Context, db *ent.Client, key []byte) error {
+	if len(key) != KeySize {
+		return fmt.Errorf("master key must be %d bytes, got %d", KeySize, len(key))
+	}
+
+	exists, err := db.Vault.Query().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for existing vault: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("vault is already initialized")
+	}
+
+	hash := sha256.Sum256(key)
+	return db.Vault.Create().SetKeyHash(hash[:]).Exec(ctx)
+}
+
+// CheckKey returns an error if the provided master key is not the one
+// the vault in the provided DB was initialized with.
+func CheckKey(ctx context.Context, db *ent.Client, key []byte) error {
+	v, err := db.Vault.Query().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotInitialized
+		}
+		return fmt.Errorf("failed to get vault: %w", err)
+	}
+
+	hash := sha256.Sum256(key)
+	if subtle.ConstantTimeCompare(hash[:], v.KeyHash) != 1 {
+		return fmt.Errorf("incorrect master key")
+	}
+	return nil
+}
+
+// Store encrypts the provided passphrase with the provided master key
+// and stores it as the secret with the provided name of the provided
+// machine, replacing any existing key. The default secret of a machine
+// has no name.
+func Store(ctx context.Context, db *ent.Client, key []byte, machineID, secret string, passphrase []byte) error {
+	if err := CheckKey(ctx, db, key); err != nil {
+		return err
+	}
+
+	ciphertext, err := encrypt(key, additionalData(machineID, secret), passphrase)
+	if err != nil {
+		return err
+	}
+
+	updated, err := db.StoredKey.Update().Where(storedkey.MachineID(machineID), storedkey.Secret(secret)).
+		SetCiphertext(ciphertext).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update stored key: %w", err)
+	}
+	if updated != 0 {
+		return nil
+	}
+
+	return db.StoredKey.Create().SetMachineID(machineID).SetSecret(secret).SetCiphertext(ciphertext).Exec(ctx)
+}
+
+// Unseal unseals the vault with the provided master key. If sealAfter
+// is not zero, the vault is sealed again automatically after it has
+// passed.
+func (v *Vault) Unseal(ctx context.Context, key []byte, sealAfter time.Duration) error {
+	if err := CheckKey(ctx, v.db, key); err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.key = key
+	v.unsealedAt = time.Now()
+	v.sealsAt = time.Time{}
+	if v.sealTimer != nil {
+		v.sealTimer.Stop()
+		v.sealTimer = nil
+	}
+	if sealAfter > 0 {
+		v.sealsAt = v.unsealedAt.Add(sealAfter)
+		v.sealTimer = time.AfterFunc(sealAfter, v.Seal)
+	}
+	return nil
+}
+
+// Seal seals the vault, dropping the master key from memory.
+func (v *Vault) Seal() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	clear(v.key)
+	v.key = nil
+	v.unsealedAt = time.Time{}
+	v.sealsAt = time.Time{}
+	if v.sealTimer != nil {
+		v.sealTimer.Stop()
+		v.sealTimer = nil
+	}
+}
+
+// Status returns whether the vault is sealed, when it was unsealed and
+// when it will be sealed again automatically. The times are zero if
+// not applicable.
+func (v *Vault) Status() (sealed bool, unsealedAt, sealsAt time.Time) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.key == nil, v.unsealedAt, v.sealsAt
+}
+
+// Get returns the decrypted passphrase stored as the secret with the
+// provided name of the provided machine.
+func (v *Vault) Get(ctx context.Context, machineID, secret string) ([]byte, error) {
+	sk, err := v.db.StoredKey.Query().Where(storedkey.MachineID(machineID), storedkey.Secret(secret)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get stored key: %w", err)
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.key == nil {
+		return nil, ErrSealed
+	}
+
+	return decrypt(v.key, additionalData(machineID, secret), sk.Ciphertext)
+}
+
+// additionalData returns the additional data stored keys are encrypted
+// with, so that ciphertexts can't be swapped between machines or their
+// secrets. The default secret only uses the machine ID, as it did
+// before machines had named secrets.
+func additionalData(machineID, secret string) string {
+	if secret == "" {
+		return machineID
+	}
+	return machineID + "/" + secret
+}
+
+// encrypt encrypts the provided plaintext with AES-256-GCM, with the
+// provided additional data.
+func encrypt(key []byte, ad string, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, []byte(ad)), nil
+}
+
+// decrypt decrypts ciphertext created by [encrypt].
+func decrypt(key []byte, ad string, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("stored key is too short")
+	}
+
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(ad))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt stored key: %w", err)
+	}
+	return plaintext, nil
+}
+
+// newAEAD creates an AES-256-GCM [cipher.AEAD] from the master key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
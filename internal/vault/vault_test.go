@@ -0,0 +1,233 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+	"git.rgst.io/homelab/klefki/internal/db/ent/storedkey"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// newTestVault returns a DB with an initialized vault, and its master
+// key.
+func newTestVault(t *testing.T) (*ent.Client, []byte) {
+	t.Helper()
+
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	t.Cleanup(func() { db.Close() })
+
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	if err := Init(context.Background(), db, key); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	return db, key
+}
+
+func TestInit(t *testing.T) {
+	ctx := context.Background()
+	db, key := newTestVault(t)
+
+	if err := Init(ctx, db, key); err == nil {
+		t.Error("Init() of an initialized vault error = nil, want an error")
+	}
+
+	empty := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	defer empty.Close()
+	if err := Init(ctx, empty, key[:16]); err == nil {
+		t.Error("Init() with a short key error = nil, want an error")
+	}
+	if err := CheckKey(ctx, empty, key); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("CheckKey() of an uninitialized vault error = %v, want %v", err, ErrNotInitialized)
+	}
+}
+
+func TestCheckKey(t *testing.T) {
+	db, key := newTestVault(t)
+	other, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		key     []byte
+		wantErr bool
+	}{
+		{name: "correct", key: key},
+		{name: "incorrect", key: other, wantErr: true},
+		{name: "truncated", key: key[:KeySize-1], wantErr: true},
+		{name: "empty", key: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckKey(context.Background(), db, tt.key); (err != nil) != tt.wantErr {
+				t.Errorf("CheckKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStoreGet(t *testing.T) {
+	ctx := context.Background()
+	db, key := newTestVault(t)
+	for _, sk := range []struct {
+		machineID, secret, passphrase string
+	}{
+		{"nas1", "", "old root passphrase"},
+		{"nas1", "", "root passphrase"},
+		{"nas1", "tank", "tank passphrase"},
+		{"web1", "", "web passphrase"},
+	} {
+		if err := Store(ctx, db, key, sk.machineID, sk.secret, []byte(sk.passphrase)); err != nil {
+			t.Fatalf("Store() error = %v", err)
+		}
+	}
+
+	v := New(db)
+	if _, err := v.Get(ctx, "nas1", ""); !errors.Is(err, ErrSealed) {
+		t.Fatalf("Get() while sealed error = %v, want %v", err, ErrSealed)
+	}
+	if err := v.Unseal(ctx, bytes.Clone(key), 0); err != nil {
+		t.Fatalf("Unseal() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		machineID string
+		secret    string
+		want      string
+		wantErr   error
+	}{
+		{name: "default secret, replaced", machineID: "nas1", want: "root passphrase"},
+		{name: "named secret", machineID: "nas1", secret: "tank", want: "tank passphrase"},
+		{name: "other machine", machineID: "web1", want: "web passphrase"},
+		{name: "unknown secret", machineID: "web1", secret: "tank", wantErr: ErrNotFound},
+		{name: "unknown machine", machineID: "db1", wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Get(ctx, tt.machineID, tt.secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Get() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Get() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	v.Seal()
+	if _, err := v.Get(ctx, "nas1", ""); !errors.Is(err, ErrSealed) {
+		t.Errorf("Get() after Seal() error = %v, want %v", err, ErrSealed)
+	}
+}
+
+func TestStoreIncorrectKey(t *testing.T) {
+	db, _ := newTestVault(t)
+	other, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	if err := Store(context.Background(), db, other, "nas1", "", []byte("x")); err == nil {
+		t.Error("Store() with an incorrect key error = nil, want an error")
+	}
+}
+
+func TestSwappedCiphertext(t *testing.T) {
+	ctx := context.Background()
+	db, key := newTestVault(t)
+	if err := Store(ctx, db, key, "nas1", "", []byte("nas1 passphrase")); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	if err := Store(ctx, db, key, "web1", "", []byte("web1 passphrase")); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	// Copying the ciphertext of one machine to another must not release
+	// it to the other machine.
+	sk, err := db.StoredKey.Query().Where(storedkey.MachineID("nas1")).Only(ctx)
+	if err != nil {
+		t.Fatalf("failed to get stored key: %v", err)
+	}
+	if err := db.StoredKey.Update().Where(storedkey.MachineID("web1")).
+		SetCiphertext(sk.Ciphertext).Exec(ctx); err != nil {
+		t.Fatalf("failed to update stored key: %v", err)
+	}
+
+	v := New(db)
+	if err := v.Unseal(ctx, key, 0); err != nil {
+		t.Fatalf("Unseal() error = %v", err)
+	}
+	if got, err := v.Get(ctx, "web1", ""); err == nil {
+		t.Errorf("Get() of a swapped ciphertext = %q, want an error", got)
+	}
+}
+
+func TestUnseal(t *testing.T) {
+	ctx := context.Background()
+	db, key := newTestVault(t)
+	other, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	v := New(db)
+	if err := v.Unseal(ctx, other, 0); err == nil {
+		t.Fatal("Unseal() with an incorrect key error = nil, want an error")
+	}
+	if sealed, _, _ := v.Status(); !sealed {
+		t.Fatal("Status() after a failed Unseal() is unsealed, want sealed")
+	}
+
+	if err := v.Unseal(ctx, bytes.Clone(key), time.Hour); err != nil {
+		t.Fatalf("Unseal() error = %v", err)
+	}
+	sealed, unsealedAt, sealsAt := v.Status()
+	if sealed || unsealedAt.IsZero() || sealsAt.Sub(unsealedAt) != time.Hour {
+		t.Errorf("Status() = %v, %s, %s, want unsealed, sealing an hour after unsealing", sealed, unsealedAt, sealsAt)
+	}
+
+	if err := v.Unseal(ctx, bytes.Clone(key), 10*time.Millisecond); err != nil {
+		t.Fatalf("Unseal() error = %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		sealed, _, _ := v.Status()
+		if sealed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("vault was not sealed again automatically")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package vaultkv implements reading the keys of machines from a
+// HashiCorp Vault KV v2 secrets engine, authenticating with a token or
+// AppRole.
+package vaultkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// DefaultPath is the default template of the path of the key of a
+// machine, the first segment of which is the mount of the KV v2
+// secrets engine.
+const DefaultPath = "secret/klefki/{{.Name}}"
+
+// passphraseField is the field the key is read from if the data has no
+// field named after the secret.
+const passphraseField = "passphrase"
+
+// ErrNotFound is returned when no key is stored for a machine.
+var ErrNotFound = errors.New("no key stored in Vault for machine")
+
+// Config configures a [Client].
+type Config struct {
+	// Address is the address of Vault, e.g., http://127.0.0.1:8200.
+	Address string
+
+	// Namespace is the Vault Enterprise namespace, if any.
+	Namespace string
+
+	// Token is the Vault token to use. If empty, RoleID and SecretID
+	// are used to log in with AppRole instead.
+	Token string
+
+	// RoleID and SecretID are the AppRole credentials.
+	RoleID   string
+	SecretID string
+
+	// AuthMount is the mount of the AppRole auth method, defaults to
+	// approle.
+	AuthMount string
+
+	// Path is the template of the path of the key of a machine,
+	// defaults to [DefaultPath]. See [Machine] for the fields
+	// available.
+	Path string
+}
+
+// ConfigFromEnv returns the [Config] provided through the standard
+// Vault environment variables (VAULT_ADDR, VAULT_NAMESPACE, VAULT_TOKEN,
+// VAULT_ROLE_ID and VAULT_SECRET_ID), with the provided path.
+func ConfigFromEnv(path string) Config {
+	return Config{
+		Address:   os.Getenv("VAULT_ADDR"),
+		Namespace: os.Getenv("VAULT_NAMESPACE"),
+		Token:     os.Getenv("VAULT_TOKEN"),
+		RoleID:    os.Getenv("VAULT_ROLE_ID"),
+		SecretID:  os.Getenv("VAULT_SECRET_ID"),
+		Path:      path,
+	}
+}
+
+// Machine is a machine to read the key of, available in the path
+// template.
+type Machine struct {
+	// ID is the ID of the machine.
+	ID string
+
+	// Name is the name of the machine.
+	Name string
+
+	// Fingerprint is the fingerprint of the public key of the machine.
+	Fingerprint string
+
+	// Secret is the name of the secret to read.
+	Secret string
+}
+
+// Client reads the keys of machines from Vault.
+type Client struct {
+	cfg  Config
+	path *template.Template
+	hc   *http.Client
+
+	// mu guards token and tokenExpiresAt, which are set when logging in
+	// with AppRole.
+	mu             sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
+}
+
+// New creates a new [Client] with the provided config.
+func New(cfg Config) (*Client, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("no Vault address provided (VAULT_ADDR)")
+	}
+	if cfg.Token == "" && (cfg.RoleID == "" || cfg.SecretID == "") {
+		return nil, fmt.Errorf("no Vault token (VAULT_TOKEN) or AppRole credentials (VAULT_ROLE_ID, VAULT_SECRET_ID) provided")
+	}
+	if cfg.AuthMount == "" {
+		cfg.AuthMount = "approle"
+	}
+	if cfg.Path == "" {
+		cfg.Path = DefaultPath
+	}
+
+	path, err := template.New("path").Option("missingkey=error").Parse(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Vault path %q: %w", cfg.Path, err)
+	}
+
+	return &Client{
+		cfg:   cfg,
+		path:  path,
+		hc:    &http.Client{Timeout: 10 * time.Second},
+		token: cfg.Token,
+	}, nil
+}
+
+// Get returns the key of the provided secret of the provided machine.
+// It's read from the field named after the secret, or the passphrase
+// field if there is none, of the data at the path of the machine.
+// [ErrNotFound] is returned if there is no such key.
+func (c *Client) Get(ctx context.Context, m *Machine) ([]byte, error) {
+	var b strings.Builder
+	if err := c.path.Execute(&b, m); err != nil {
+		return nil, fmt.Errorf("failed to render Vault path: %w", err)
+	}
+	mount, path, ok := strings.Cut(strings.Trim(b.String(), "/"), "/")
+	if !ok || path == "" {
+		return nil, fmt.Errorf("invalid Vault path %q, expected <mount>/<path>", b.String())
+	}
+
+	var resp struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v1/"+mount+"/data/"+path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	v, ok := resp.Data.Data[m.Secret]
+	if !ok {
+		v, ok = resp.Data.Data[passphraseField]
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: no %q or %q field at %s", ErrNotFound, m.Secret, passphraseField, b.String())
+	}
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil, fmt.Errorf("key of secret %q at %s isn't a non-empty string", m.Secret, b.String())
+	}
+	return []byte(s), nil
+}
+
+// do makes an authenticated request to Vault, logging in again once if
+// the AppRole token was rejected.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	token, err := c.authenticate(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.request(ctx, method, path, token, body, out)
+	if status == http.StatusForbidden && c.cfg.Token == "" {
+		if token, err = c.authenticate(ctx, true); err != nil {
+			return err
+		}
+		status, err = c.request(ctx, method, path, token, body, out)
+	}
+	if status == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return err
+}
+
+// authenticate returns the token to use, logging in with AppRole if
+// there is no valid one, or force is set.
+func (c *Client) authenticate(ctx context.Context, force bool) (string, error) {
+	if c.cfg.Token != "" {
+		return c.cfg.Token, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !force && c.token != "" && (c.tokenExpiresAt.IsZero() || time.Now().Before(c.tokenExpiresAt)) {
+		return c.token, nil
+	}
+
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	login := map[string]string{"role_id": c.cfg.RoleID, "secret_id": c.cfg.SecretID}
+	if _, err := c.request(ctx, http.MethodPost, "/v1/auth/"+c.cfg.AuthMount+"/login", "", login, &resp); err != nil {
+		return "", fmt.Errorf("failed to log in to Vault with AppRole: %w", err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("failed to log in to Vault with AppRole: no token returned")
+	}
+
+	c.token = resp.Auth.ClientToken
+	c.tokenExpiresAt = time.Time{}
+	if resp.Auth.LeaseDuration > 0 {
+		// Logged in again a bit early, so that requests in flight don't
+		// race the expiry.
+		lease := time.Duration(resp.Auth.LeaseDuration) * time.Second
+		c.tokenExpiresAt = time.Now().Add(lease - lease/10)
+	}
+	return c.token, nil
+}
+
+// request makes a request to Vault, decoding the response into out.
+// The status code of the response is returned, if one was received.
+func (c *Client) request(ctx context.Context, method, path, token string, body, out any) (int, error) {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode Vault request: %w", err)
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.cfg.Address, "/")+path, r)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create Vault request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.cfg.Namespace)
+	}
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var verr struct {
+			Errors []string `json:"errors"`
+		}
+		//nolint:errcheck // Why: The errors are only used for the message.
+		json.NewDecoder(resp.Body).Decode(&verr)
+		if len(verr.Errors) != 0 {
+			return resp.StatusCode, fmt.Errorf("vault returned %s: %s", resp.Status, strings.Join(verr.Errors, ", "))
+		}
+		return resp.StatusCode, fmt.Errorf("vault returned %s", resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode Vault response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package vaultkv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeVault is a fake Vault serving a KV v2 secrets engine mounted at
+// secret, and AppRole logins.
+type fakeVault struct {
+	// data is the data stored in the KV engine, by path.
+	data map[string]map[string]any
+
+	// token is the token accepted, which AppRole logins return.
+	token string
+
+	// logins is how many AppRole logins were made.
+	logins atomic.Int32
+}
+
+// ServeHTTP implements [http.Handler].
+func (fv *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/v1/auth/approle/login" {
+		var login map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login["role_id"] != "role" || login["secret_id"] != "secret" {
+			http.Error(w, `{"errors": ["invalid credentials"]}`, http.StatusBadRequest)
+			return
+		}
+		fv.logins.Add(1)
+		//nolint:errcheck // Why: Best effort in tests.
+		json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{"client_token": fv.token, "lease_duration": 3600}})
+		return
+	}
+
+	if r.Header.Get("X-Vault-Token") != fv.token {
+		http.Error(w, `{"errors": ["permission denied"]}`, http.StatusForbidden)
+		return
+	}
+	data, ok := fv.data[r.URL.Path]
+	if !ok {
+		http.Error(w, `{"errors": []}`, http.StatusNotFound)
+		return
+	}
+	//nolint:errcheck // Why: Best effort in tests.
+	json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}})
+}
+
+func TestGet(t *testing.T) {
+	fv := &fakeVault{
+		token: "token",
+		data: map[string]map[string]any{
+			"/v1/secret/data/klefki/nas1": {"passphrase": "root passphrase", "tank": "tank passphrase"},
+			"/v1/secret/data/klefki/web1": {"passphrase": ""},
+		},
+	}
+	srv := httptest.NewServer(fv)
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		machine Machine
+		want    string
+		wantErr error
+	}{
+		{name: "default field", cfg: Config{Token: "token"}, machine: Machine{Name: "nas1", Secret: "root"}, want: "root passphrase"},
+		{name: "secret field", cfg: Config{Token: "token"}, machine: Machine{Name: "nas1", Secret: "tank"}, want: "tank passphrase"},
+		{
+			name:    "path template",
+			cfg:     Config{Token: "token", Path: "secret/klefki/{{.Fingerprint}}"},
+			machine: Machine{Name: "other", Fingerprint: "nas1", Secret: "root"},
+			want:    "root passphrase",
+		},
+		{name: "approle", cfg: Config{RoleID: "role", SecretID: "secret"}, machine: Machine{Name: "nas1"}, want: "root passphrase"},
+		{name: "not stored", cfg: Config{Token: "token"}, machine: Machine{Name: "nas2"}, wantErr: ErrNotFound},
+		{name: "empty key", cfg: Config{Token: "token"}, machine: Machine{Name: "web1"}, wantErr: errAny},
+		{name: "wrong token", cfg: Config{Token: "other"}, machine: Machine{Name: "nas1"}, wantErr: errAny},
+		{name: "wrong approle", cfg: Config{RoleID: "role", SecretID: "other"}, machine: Machine{Name: "nas1"}, wantErr: errAny},
+		{name: "invalid path", cfg: Config{Token: "token", Path: "{{.Name}}"}, machine: Machine{Name: "nas1"}, wantErr: errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Address = srv.URL
+			c, err := New(tt.cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			got, err := c.Get(context.Background(), &tt.machine)
+			switch {
+			case tt.wantErr == errAny && err == nil:
+				t.Fatal("Get() error = nil, want error")
+			case tt.wantErr != errAny && !errors.Is(err, tt.wantErr):
+				t.Fatalf("Get() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Get() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// errAny is used in tests expecting any error.
+var errAny = errors.New("any error")
+
+func TestGetApproleRelogin(t *testing.T) {
+	fv := &fakeVault{
+		token: "token1",
+		data:  map[string]map[string]any{"/v1/secret/data/klefki/nas1": {"passphrase": "root passphrase"}},
+	}
+	srv := httptest.NewServer(fv)
+	defer srv.Close()
+
+	c, err := New(Config{Address: srv.URL, RoleID: "role", SecretID: "secret"})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	for _, token := range []string{"token1", "token1", "token2"} {
+		// Tokens revoked before their lease ends are replaced by logging
+		// in again.
+		fv.token = token
+		if _, err := c.Get(context.Background(), &Machine{Name: "nas1"}); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	if got := fv.logins.Load(); got != 2 {
+		t.Errorf("logins = %d, want 2", got)
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "token", cfg: Config{Address: "http://vault:8200", Token: "token"}},
+		{name: "approle", cfg: Config{Address: "http://vault:8200", RoleID: "role", SecretID: "secret"}},
+		{name: "no address", cfg: Config{Token: "token"}, wantErr: true},
+		{name: "no credentials", cfg: Config{Address: "http://vault:8200", RoleID: "role"}, wantErr: true},
+		{name: "invalid path", cfg: Config{Address: "http://vault:8200", Token: "token", Path: "{{.Name"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
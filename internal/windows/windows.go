@@ -0,0 +1,112 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+// Package windows implements maintenance windows, recurring periods
+// during which keys are released to machines without approval.
+package windows
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/labels"
+	"github.com/robfig/cron/v3"
+)
+
+// ParseSchedule parses the provided standard cron expression, e.g.,
+// "0 2 * * SUN". A time zone can be set with a "CRON_TZ=" prefix,
+// otherwise the local time zone is used.
+func ParseSchedule(schedule string) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schedule %q: %w", schedule, err)
+	}
+	return sched, nil
+}
+
+// IsOpen returns if the provided window is open at the provided time.
+func IsOpen(w *ent.MaintenanceWindow, now time.Time) (bool, error) {
+	sched, err := ParseSchedule(w.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	// The window is open if it opened at some point during the last
+	// duration.
+	duration := time.Duration(w.Duration) * time.Second
+	return !sched.Next(now.Add(-duration)).After(now), nil
+}
+
+// NextOpen returns when the provided window next opens after the
+// provided time.
+func NextOpen(w *ent.MaintenanceWindow, now time.Time) (time.Time, error) {
+	sched, err := ParseSchedule(w.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.Next(now), nil
+}
+
+// AppliesTo returns if the provided window applies to the provided
+// machine, either by its ID or because its labels match the selector of
+// the window.
+func AppliesTo(w *ent.MaintenanceWindow, m *ent.Machine) (bool, error) {
+	if slices.Contains(w.MachineIds, m.ID) {
+		return true, nil
+	}
+	if w.Selector == "" {
+		return false, nil
+	}
+
+	selector, err := labels.ParseSelector(w.Selector)
+	if err != nil {
+		return false, fmt.Errorf("invalid selector: %w", err)
+	}
+	return selector.Matches(m.Labels), nil
+}
+
+// Find returns the first window that is open at the provided time and
+// applies to the provided machine. If there is none, nil is returned.
+func Find(ctx context.Context, db *ent.Client, m *ent.Machine, now time.Time) (*ent.MaintenanceWindow, error) {
+	ws, err := db.MaintenanceWindow.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+
+	for _, w := range ws {
+		applies, err := AppliesTo(w, m)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window %q: %w", w.Name, err)
+		}
+		if !applies {
+			continue
+		}
+
+		open, err := IsOpen(w, now)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window %q: %w", w.Name, err)
+		}
+		if open {
+			return w, nil
+		}
+	}
+
+	return nil, nil
+}
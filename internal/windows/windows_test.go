@@ -0,0 +1,203 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package windows
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"git.rgst.io/homelab/klefki/internal/db/ent"
+	"git.rgst.io/homelab/klefki/internal/db/ent/enttest"
+
+	_ "github.com/ncruces/go-sqlite3/driver" // Used by ent.
+	_ "github.com/ncruces/go-sqlite3/embed"  // Also used by ent.
+)
+
+// sundays is a schedule opening at 02:00 UTC every Sunday.
+const sundays = "CRON_TZ=UTC 0 2 * * SUN"
+
+// sunday is a Sunday, the day [sundays] opens on.
+var sunday = time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		wantErr  bool
+	}{
+		{name: "standard", schedule: "0 2 * * SUN"},
+		{name: "time zone", schedule: "CRON_TZ=Europe/Berlin 30 3 1 * *"},
+		{name: "descriptor", schedule: "@daily"},
+		{name: "empty", schedule: "", wantErr: true},
+		{name: "too few fields", schedule: "0 2 *", wantErr: true},
+		{name: "seconds", schedule: "0 0 2 * * SUN", wantErr: true},
+		{name: "invalid time zone", schedule: "CRON_TZ=Nowhere/Special 0 2 * * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchedule(tt.schedule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSchedule(%q) error = %v, wantErr %v", tt.schedule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	w := &ent.MaintenanceWindow{Schedule: sundays, Duration: int64(time.Hour / time.Second)}
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{name: "before opening", now: sunday.Add(time.Hour + 59*time.Minute)},
+		{name: "opening", now: sunday.Add(2 * time.Hour), want: true},
+		{name: "open", now: sunday.Add(2*time.Hour + 30*time.Minute), want: true},
+		{name: "closing", now: sunday.Add(3 * time.Hour)},
+		{name: "after closing", now: sunday.Add(4 * time.Hour)},
+		{name: "other day", now: sunday.Add(24*time.Hour + 2*time.Hour)},
+		{name: "next week", now: sunday.AddDate(0, 0, 7).Add(2*time.Hour + time.Minute), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsOpen(w, tt.now)
+			if err != nil {
+				t.Fatalf("IsOpen() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsOpen(%s) = %v, want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := IsOpen(&ent.MaintenanceWindow{Schedule: "invalid", Duration: 60}, sunday); err == nil {
+		t.Error("IsOpen() with an invalid schedule error = nil, want an error")
+	}
+}
+
+func TestNextOpen(t *testing.T) {
+	w := &ent.MaintenanceWindow{Schedule: sundays, Duration: 3600}
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{name: "same day", now: sunday, want: sunday.Add(2 * time.Hour)},
+		{name: "while open", now: sunday.Add(2*time.Hour + time.Minute), want: sunday.AddDate(0, 0, 7).Add(2 * time.Hour)},
+		{name: "midweek", now: sunday.AddDate(0, 0, 3), want: sunday.AddDate(0, 0, 7).Add(2 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextOpen(w, tt.now)
+			if err != nil {
+				t.Fatalf("NextOpen() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("NextOpen(%s) = %s, want %s", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppliesTo(t *testing.T) {
+	m := &ent.Machine{ID: "nas1", Labels: map[string]string{"role": "storage", "zone": "a"}}
+	tests := []struct {
+		name    string
+		w       *ent.MaintenanceWindow
+		want    bool
+		wantErr bool
+	}{
+		{name: "by ID", w: &ent.MaintenanceWindow{MachineIds: []string{"web1", "nas1"}}, want: true},
+		{name: "other ID", w: &ent.MaintenanceWindow{MachineIds: []string{"web1"}}},
+		{name: "by label", w: &ent.MaintenanceWindow{Selector: "role=storage"}, want: true},
+		{name: "by all labels", w: &ent.MaintenanceWindow{Selector: "role=storage,zone=a"}, want: true},
+		{name: "one label differs", w: &ent.MaintenanceWindow{Selector: "role=storage,zone=b"}},
+		{name: "in set", w: &ent.MaintenanceWindow{Selector: "zone in (a,b)"}, want: true},
+		{name: "not in set", w: &ent.MaintenanceWindow{Selector: "zone notin (a,b)"}},
+		{name: "label set", w: &ent.MaintenanceWindow{Selector: "role"}, want: true},
+		{name: "label not set", w: &ent.MaintenanceWindow{Selector: "!rack"}, want: true},
+		{name: "missing label", w: &ent.MaintenanceWindow{Selector: "rack=1"}},
+		{
+			name: "other ID, but by label",
+			w:    &ent.MaintenanceWindow{MachineIds: []string{"web1"}, Selector: "zone=a"},
+			want: true,
+		},
+		{name: "invalid selector", w: &ent.MaintenanceWindow{Selector: "zone in a"}, wantErr: true},
+		{name: "no machines", w: &ent.MaintenanceWindow{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppliesTo(tt.w, m)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AppliesTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("AppliesTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "klefki.db"))
+	defer db.Close()
+
+	for _, w := range []struct {
+		name, schedule string
+		machineIDs     []string
+	}{
+		{name: "web", schedule: sundays, machineIDs: []string{"web1"}},
+		{name: "storage-closed", schedule: "CRON_TZ=UTC 0 4 * * SUN", machineIDs: []string{"nas1"}},
+		{name: "storage", schedule: sundays, machineIDs: []string{"nas1"}},
+	} {
+		if err := db.MaintenanceWindow.Create().SetName(w.name).SetSchedule(w.schedule).
+			SetDuration(3600).SetMachineIds(w.machineIDs).Exec(ctx); err != nil {
+			t.Fatalf("failed to create window: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		machine string
+		now     time.Time
+		want    string
+	}{
+		{name: "open", machine: "nas1", now: sunday.Add(2*time.Hour + time.Minute), want: "storage"},
+		{name: "closed", machine: "nas1", now: sunday.Add(time.Hour)},
+		{name: "not applying", machine: "db1", now: sunday.Add(2*time.Hour + time.Minute)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := Find(ctx, db, &ent.Machine{ID: tt.machine}, tt.now)
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			got := ""
+			if w != nil {
+				got = w.Name
+			}
+			if got != tt.want {
+				t.Errorf("Find() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -19,13 +19,20 @@
 package client
 
 import (
+	"crypto/ed25519"
 	"fmt"
+	"os"
+
+	"git.rgst.io/homelab/klefki/internal/machines"
 
 	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// DefaultKeyPath is the conventional path of a machine's private key.
+const DefaultKeyPath = "/etc/klefki/machine.key"
+
 // Dial creates a new [pbgrpcv1.KlefkiServiceClient] at the given
 // address with the provided options. Returned is the client and a
 // closer function that can be used to close the underlying transport.
@@ -40,3 +47,13 @@ func Dial(address string, opts ...grpc.DialOption) (pbgrpcv1.KlefkiServiceClient
 	}
 	return pbgrpcv1.NewKlefkiServiceClient(conn), conn.Close, nil
 }
+
+// ReadPrivateKey reads the private key of a machine from the provided
+// path, as written by klefkictl new.
+func ReadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	privKeyByt, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read machine key: %w", err)
+	}
+	return machines.DecodePrivateKey(privKeyByt)
+}
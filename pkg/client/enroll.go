@@ -0,0 +1,47 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/machines"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// Enroll enrolls the machine owning the provided private key with the
+// server using the provided enrollment token. The private key never
+// leaves the machine, only its public key is sent. If set, the machine
+// is bound to the provided hardware attributes, from [ReadHardware].
+func Enroll(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, pk ed25519.PrivateKey, token string,
+	hw *pbgrpcv1.HardwareAttributes) (*pbgrpcv1.EnrollResponse, error) {
+	req := &pbgrpcv1.EnrollRequest{}
+	req.SetToken(token)
+	if hw != nil {
+		req.SetHardware(hw)
+	}
+	machines.SignEnrollRequest(pk, req)
+
+	resp, err := kc.Enroll(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enroll: %w", err)
+	}
+	return resp, nil
+}
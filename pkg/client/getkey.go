@@ -0,0 +1,335 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.rgst.io/homelab/klefki/internal/chunks"
+	"git.rgst.io/homelab/klefki/internal/machines"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"git.rgst.io/homelab/klefki/internal/shamir"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUnverifiedResponse is returned when the response to a key request
+// wasn't signed by the server identity provided through
+// [WithServerIdentity].
+var ErrUnverifiedResponse = errors.New("response was not signed by the server")
+
+// ErrRefused is returned when the server refuses to ever release the
+// key to the machine, e.g., because it was disabled or has expired.
+var ErrRefused = errors.New("machine was refused by the server")
+
+// RequestOption configures a key request.
+type RequestOption func(*requestOptions)
+
+// requestOptions are the options of a key request, set through
+// [RequestOption]s.
+type requestOptions struct {
+	// req is the request, before it is signed.
+	req *pbgrpcv1.GetKeyRequest
+
+	// identity is the public key of the server that must have signed
+	// the response, if set.
+	identity ed25519.PublicKey
+
+	// identities are the public keys of the servers, by address, that
+	// must have signed the responses to [GetKeyFromServers].
+	identities map[string]ed25519.PublicKey
+
+	// maxKeySize is the maximum size, in bytes, of the key, or all of
+	// its shares, once encrypted.
+	maxKeySize int
+}
+
+// WithBootMeasurements reports the provided boot measurements, from
+// [MeasureBoot], with the key request.
+func WithBootMeasurements(bm *pbgrpcv1.BootMeasurements) RequestOption {
+	return func(o *requestOptions) {
+		o.req.SetBootMeasurements(bm)
+	}
+}
+
+// WithMachine identifies the machine the key is requested for by the
+// provided name, instead of the fingerprint of its private key. The
+// request is still signed by the private key.
+func WithMachine(name string) RequestOption {
+	return func(o *requestOptions) {
+		o.req.SetMachineId(name)
+	}
+}
+
+// WithSecret requests the secret of the machine with the provided
+// name, e.g., the key of one of its volumes, instead of its default
+// one.
+func WithSecret(name string) RequestOption {
+	return func(o *requestOptions) {
+		o.req.SetSecret(name)
+	}
+}
+
+// WithServerIdentity requires the response to the key request to be
+// signed by the server with the provided identity, as returned by the
+// GetIdentity RPC.
+func WithServerIdentity(identity ed25519.PublicKey) RequestOption {
+	return func(o *requestOptions) {
+		o.identity = identity
+	}
+}
+
+// WithMaxKeySize sets the maximum size, in bytes, of the key, or all
+// of its shares, once encrypted. Larger responses are rejected before
+// their signature can be checked. Defaults to [chunks.DefaultMaxKeySize].
+func WithMaxKeySize(size int) RequestOption {
+	return func(o *requestOptions) {
+		o.maxKeySize = size
+	}
+}
+
+// WithServerIdentities requires the responses to the key requests made
+// by [GetKeyFromServers] to be signed by the server with the identity
+// provided for its address.
+func WithServerIdentities(identities map[string]ed25519.PublicKey) RequestOption {
+	return func(o *requestOptions) {
+		o.identities = identities
+	}
+}
+
+// GetKey requests the key for the machine owning the provided private
+// key from the server and returns it decrypted. If the server returned
+// the key as Shamir shares, they are combined before being returned.
+func GetKey(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, pk ed25519.PrivateKey, opts ...RequestOption) ([]byte, error) {
+	resp, err := requestKey(ctx, kc, "", pk, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split keys are returned as shares that are combined here, so that
+	// the server never sees the whole key.
+	if encShares := resp.GetEncShares(); len(encShares) != 0 {
+		shares := make([][]byte, 0, len(encShares))
+		for _, encShare := range encShares {
+			share, err := machines.Decrypt(pk, encShare)
+			if err != nil {
+				return nil, err
+			}
+			shares = append(shares, share)
+		}
+
+		key, err := shamir.Combine(shares)
+		if err != nil {
+			return nil, fmt.Errorf("failed to combine key shares: %w", err)
+		}
+		return key, nil
+	}
+
+	return machines.Decrypt(pk, resp.GetEncKey())
+}
+
+// WaitForKey requests the key for the machine owning the provided
+// private key from the server every pollInterval, like [GetKey], until
+// it is released, a response fails verification, the server refuses
+// the machine or ctx is done.
+func WaitForKey(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, pk ed25519.PrivateKey,
+	pollInterval time.Duration, opts ...RequestOption) ([]byte, error) {
+	for {
+		key, err := GetKey(ctx, kc, pk, opts...)
+		if err == nil || errors.Is(err, ErrUnverifiedResponse) || errors.Is(err, ErrRefused) {
+			return key, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// GetKeyFromServers requests the key for the machine owning the
+// provided private key from every server at the provided addresses
+// concurrently. Each server holds one Shamir share of the key. Servers
+// that have not released their share yet are asked again every
+// pollInterval until threshold shares have been received, at which
+// point they are combined and returned, or ctx is done.
+func GetKeyFromServers(ctx context.Context, addresses []string, threshold int,
+	pk ed25519.PrivateKey, pollInterval time.Duration, opts ...RequestOption) ([]byte, error) {
+	if threshold < 2 || threshold > len(addresses) {
+		return nil, fmt.Errorf("threshold must be between 2 and the number of servers (%d), got %d", len(addresses), threshold)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		address string
+		share   []byte
+		err     error
+	}
+
+	results := make(chan result, len(addresses))
+	var wg sync.WaitGroup
+	for _, address := range addresses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			share, err := getShare(ctx, address, pk, pollInterval, opts)
+			results <- result{address, share, err}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	shares := make([][]byte, 0, threshold)
+	errs := make([]error, 0, len(addresses))
+	for res := range results {
+		if res.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", res.address, res.err))
+			continue
+		}
+
+		shares = append(shares, res.share)
+		if len(shares) == threshold {
+			cancel() // We have enough, stop asking the other servers.
+
+			key, err := shamir.Combine(shares)
+			if err != nil {
+				return nil, fmt.Errorf("failed to combine key shares: %w", err)
+			}
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("received %d/%d key shares: %w", len(shares), threshold, errors.Join(errs...))
+}
+
+// getShare asks the server at the provided address for its share of
+// the key every pollInterval until it is released or ctx is done.
+func getShare(ctx context.Context, address string, pk ed25519.PrivateKey,
+	pollInterval time.Duration, opts []RequestOption) ([]byte, error) {
+	kc, kcclose, err := Dial(address)
+	if err != nil {
+		return nil, err
+	}
+	defer kcclose() //nolint:errcheck // Why: Best effort
+
+	for {
+		resp, err := requestKey(ctx, kc, address, pk, opts)
+		if err == nil {
+			share, err := machines.Decrypt(pk, resp.GetEncKey())
+			if err != nil {
+				return nil, err
+			}
+
+			index, err := shamir.Index(share)
+			if err != nil {
+				return nil, err
+			}
+			if index != int(resp.GetShareIndex()) {
+				return nil, fmt.Errorf("server returned share %d, but expected share %d", index, resp.GetShareIndex())
+			}
+			return share, nil
+		}
+		if errors.Is(err, ErrUnverifiedResponse) || errors.Is(err, ErrRefused) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// requestKey makes a signed GetKey request for the machine owning the
+// provided private key. The address of the server is only used to look
+// up its identity, if known.
+func requestKey(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, address string,
+	pk ed25519.PrivateKey, opts []RequestOption) (*pbgrpcv1.GetKeyResponse, error) {
+	machineID, err := machines.Fingerprint(pk.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fingerprint for key: %w", err)
+	}
+
+	tsResp, err := kc.GetTime(ctx, &pbgrpcv1.GetTimeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server to get time: %w", err)
+	}
+
+	req := &pbgrpcv1.GetKeyRequest{}
+	req.SetMachineId(machineID)
+	req.SetNonce(uuid.New().String())
+	req.SetSignedAt(tsResp.GetTime())
+	o := &requestOptions{req: req, maxKeySize: chunks.DefaultMaxKeySize}
+	for _, opt := range opts {
+		opt(o)
+	}
+	machines.SignKeyRequest(pk, req)
+
+	resp, err := getKeyResponse(ctx, kc, req, o.maxKeySize)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return nil, fmt.Errorf("%w: %w", ErrRefused, err)
+		}
+		return nil, fmt.Errorf("failed to get key from server: %w", err)
+	}
+
+	identity := o.identity
+	if id, ok := o.identities[address]; ok {
+		identity = id
+	}
+	if identity != nil {
+		if err := machines.VerifyKeyResponse(identity, req.GetNonce(), resp); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnverifiedResponse, err)
+		}
+	}
+	return resp, nil
+}
+
+// getKeyResponse makes the provided GetKey request through the
+// GetKeyStream RPC, so that keys larger than the maximum size of a gRPC
+// message can be received, up to the provided maximum size of the key.
+// Servers that don't implement it are asked through the GetKey RPC
+// instead.
+func getKeyResponse(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient, req *pbgrpcv1.GetKeyRequest,
+	maxKeySize int) (*pbgrpcv1.GetKeyResponse, error) {
+	stream, err := kc.GetKeyStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbgrpcv1.GetKeyResponse{}
+	if err := chunks.Receive(stream.Recv, maxKeySize+chunks.EnvelopeSize, resp); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return kc.GetKey(ctx, req)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
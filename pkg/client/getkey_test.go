@@ -0,0 +1,50 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+)
+
+func TestGetKeyFromServersThreshold(t *testing.T) {
+	_, pk, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		addresses []string
+		threshold int
+	}{
+		{name: "single server", addresses: []string{"a:5300"}, threshold: 1},
+		{name: "threshold of one", addresses: []string{"a:5300", "b:5300"}, threshold: 1},
+		{name: "more than servers", addresses: []string{"a:5300", "b:5300"}, threshold: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Invalid thresholds are rejected before any server is asked.
+			if _, err := GetKeyFromServers(context.Background(), tt.addresses, tt.threshold, pk, time.Second); err == nil {
+				t.Fatal("GetKeyFromServers() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// Paths hardware attributes are read from.
+const (
+	productUUIDPath = "/sys/class/dmi/id/product_uuid"
+	boardSerialPath = "/sys/class/dmi/id/board_serial"
+	routesPath      = "/proc/net/route"
+)
+
+// ReadHardware reads the hardware attributes of the machine: its DMI
+// product UUID, the serial number of its mainboard and the MAC address
+// of its primary NIC. Reading the DMI attributes usually requires root.
+// Attributes that aren't available are left empty.
+func ReadHardware() (*pbgrpcv1.HardwareAttributes, error) {
+	hw := &pbgrpcv1.HardwareAttributes{}
+	hw.SetProductUuid(readAttribute(productUUIDPath))
+	hw.SetBoardSerial(readAttribute(boardSerialPath))
+
+	mac, err := primaryMAC()
+	if err != nil {
+		return nil, err
+	}
+	hw.SetMacAddress(mac)
+
+	if hw.GetProductUuid() == "" && hw.GetBoardSerial() == "" && hw.GetMacAddress() == "" {
+		return nil, fmt.Errorf("no hardware attributes could be read")
+	}
+	return hw, nil
+}
+
+// WithHardwareAttributes reports the provided hardware attributes, from
+// [ReadHardware], with the key request.
+func WithHardwareAttributes(hw *pbgrpcv1.HardwareAttributes) RequestOption {
+	return func(o *requestOptions) {
+		o.req.SetHardware(hw)
+	}
+}
+
+// readAttribute returns the trimmed contents of the file at the
+// provided path, or an empty string if it can't be read.
+func readAttribute(path string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// primaryMAC returns the MAC address of the interface of the default
+// route, falling back to the first interface with a MAC address.
+func primaryMAC() (string, error) {
+	if name := defaultRouteInterface(); name != "" {
+		iface, err := net.InterfaceByName(name)
+		if err == nil && len(iface.HardwareAddr) != 0 {
+			return iface.HardwareAddr.String(), nil
+		}
+	}
+
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return "", fmt.Errorf("failed to list network interfaces: %w", err)
+	}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback == 0 && len(iface.HardwareAddr) != 0 {
+			return iface.HardwareAddr.String(), nil
+		}
+	}
+	return "", nil
+}
+
+// defaultRouteInterface returns the name of the interface of the IPv4
+// default route, or an empty string if there is none.
+func defaultRouteInterface() string {
+	f, err := os.Open(routesPath)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// Iface Destination Gateway ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[1] == "00000000" {
+			return fields[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// Keyring is a Linux kernel keyring keys can be delivered to.
+type Keyring string
+
+const (
+	// UserKeyring is the keyring of the current user, shared by all of
+	// its processes. systemd-cryptsetup looks up cached passphrases
+	// here.
+	UserKeyring Keyring = "user"
+
+	// SessionKeyring is the keyring of the current session, only
+	// available to its processes.
+	SessionKeyring Keyring = "session"
+)
+
+// DefaultKeyDescription is the description keys are delivered with by
+// default. It's the name systemd uses for cached cryptsetup
+// passphrases, so that systemd-cryptsetup tries the key before asking.
+const DefaultKeyDescription = "cryptsetup"
+
+// DefaultKeyTimeout is how long keys stay in the keyring by default,
+// matching the timeout systemd uses for cached passphrases.
+const DefaultKeyTimeout = 150 * time.Second
+
+// ParseKeyring returns the [Keyring] with the provided name.
+func ParseKeyring(name string) (Keyring, error) {
+	switch k := Keyring(name); k {
+	case UserKeyring, SessionKeyring:
+		return k, nil
+	default:
+		return "", fmt.Errorf("unknown keyring %q, expected one of %s or %s", name, UserKeyring, SessionKeyring)
+	}
+}
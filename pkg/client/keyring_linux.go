@@ -0,0 +1,51 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// AddToKeyring adds the provided key to the provided kernel keyring as
+// a user key with the provided description, replacing any existing key
+// with it. If timeout isn't zero, the kernel removes the key once it
+// has passed. Returned is the serial number of the key.
+func AddToKeyring(key []byte, keyring Keyring, description string, timeout time.Duration) (int, error) {
+	ringID := unix.KEY_SPEC_USER_KEYRING
+	if keyring == SessionKeyring {
+		ringID = unix.KEY_SPEC_SESSION_KEYRING
+	}
+
+	id, err := unix.AddKey("user", description, key, ringID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add key to %s keyring: %w", keyring, err)
+	}
+
+	if timeout > 0 {
+		secs := int((timeout + time.Second - 1) / time.Second)
+		if _, err := unix.KeyctlInt(unix.KEYCTL_SET_TIMEOUT, id, secs, 0, 0); err != nil {
+			// Don't leave a key around that was meant to expire.
+			unix.KeyctlInt(unix.KEYCTL_UNLINK, id, ringID, 0, 0) //nolint:errcheck // Why: Best effort
+			return 0, fmt.Errorf("failed to set timeout of key: %w", err)
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+)
+
+// CmdlinePath is the path to the command line the running kernel was
+// booted with.
+const CmdlinePath = "/proc/cmdline"
+
+// MeasureBoot measures what the machine booted by hashing the provided
+// kernel and initramfs, and the kernel command line read from the
+// provided path (usually [CmdlinePath]). Empty paths are not measured.
+func MeasureBoot(kernelPath, initramfsPath, cmdlinePath string) (*pbgrpcv1.BootMeasurements, error) {
+	bm := &pbgrpcv1.BootMeasurements{}
+	if kernelPath != "" {
+		h, err := hashFile(kernelPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to measure kernel: %w", err)
+		}
+		bm.SetKernel(h)
+	}
+	if initramfsPath != "" {
+		h, err := hashFile(initramfsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to measure initramfs: %w", err)
+		}
+		bm.SetInitramfs(h)
+	}
+	if cmdlinePath != "" {
+		b, err := os.ReadFile(cmdlinePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to measure kernel command line: %w", err)
+		}
+		bm.SetCmdline(HashCmdline(string(b)))
+	}
+	return bm, nil
+}
+
+// HashCmdline returns the hex encoded SHA256 of the provided kernel
+// command line, ignoring surrounding whitespace.
+func HashCmdline(cmdline string) string {
+	h := sha256.Sum256([]byte(strings.TrimSpace(cmdline)))
+	return hex.EncodeToString(h[:])
+}
+
+// hashFile returns the hex encoded SHA256 of the file at the provided
+// path.
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
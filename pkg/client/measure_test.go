@@ -0,0 +1,55 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMeasureBoot(t *testing.T) {
+	dir := t.TempDir()
+	kernel := filepath.Join(dir, "vmlinuz")
+	cmdline := filepath.Join(dir, "cmdline")
+	if err := os.WriteFile(kernel, []byte("kernel"), 0o600); err != nil {
+		t.Fatalf("failed to write kernel: %v", err)
+	}
+	if err := os.WriteFile(cmdline, []byte("root=/dev/sda1 ro\n"), 0o600); err != nil {
+		t.Fatalf("failed to write cmdline: %v", err)
+	}
+
+	bm, err := MeasureBoot(kernel, "", cmdline)
+	if err != nil {
+		t.Fatalf("MeasureBoot() error = %v", err)
+	}
+
+	if got, want := bm.GetKernel(), "6923dd1bc0460082c5d55a831908c24a282860b7f1cd6c2b79cf1bc8857c639c"; got != want {
+		t.Errorf("kernel = %q, want %q", got, want)
+	}
+	if bm.HasInitramfs() {
+		t.Errorf("initramfs = %q, want it not measured", bm.GetInitramfs())
+	}
+	if got, want := bm.GetCmdline(), HashCmdline("root=/dev/sda1 ro"); got != want {
+		t.Errorf("cmdline = %q, want %q, ignoring the trailing newline", got, want)
+	}
+
+	if _, err := MeasureBoot(filepath.Join(dir, "missing"), "", ""); err == nil {
+		t.Error("MeasureBoot() of a missing kernel error = nil, want an error")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 klefki contributors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+//
+// SPDX-License-Identifier: AGPL-3.0
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+
+	"git.rgst.io/homelab/klefki/internal/machines"
+	pbgrpcv1 "git.rgst.io/homelab/klefki/internal/server/grpc/generated/go/rgst/klefki/v1"
+	"github.com/google/uuid"
+)
+
+// RotateKey replaces the key of the machine owning the provided private
+// key, pk, with newPK. The request is signed by both keys, proving the
+// machine holds them. Only the new public key is sent.
+func RotateKey(ctx context.Context, kc pbgrpcv1.KlefkiServiceClient,
+	pk, newPK ed25519.PrivateKey) (*pbgrpcv1.RotateMachineKeyResponse, error) {
+	machineID, err := machines.Fingerprint(pk.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fingerprint for key: %w", err)
+	}
+
+	tsResp, err := kc.GetTime(ctx, &pbgrpcv1.GetTimeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server to get time: %w", err)
+	}
+
+	req := &pbgrpcv1.RotateMachineKeyRequest{}
+	req.SetMachineId(machineID)
+	req.SetNonce(uuid.New().String())
+	req.SetSignedAt(tsResp.GetTime())
+	machines.SignRotateKeyRequest(pk, newPK, req)
+
+	resp, err := kc.RotateMachineKey(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate key: %w", err)
+	}
+	return resp, nil
+}